@@ -22,13 +22,14 @@ import (
 	"github.com/opensergo/opensergo-control-plane/pkg/controller"
 	"github.com/opensergo/opensergo-control-plane/pkg/model"
 	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	"github.com/opensergo/opensergo-control-plane/pkg/source"
 	transport "github.com/opensergo/opensergo-control-plane/pkg/transport/grpc"
 	"github.com/pkg/errors"
 )
 
 type ControlPlane struct {
-	operator *controller.KubernetesOperator
-	server   *transport.Server
+	source source.ConfigSource
+	server *transport.Server
 
 	protoDesc *trpb.ControlPlaneDesc
 
@@ -36,15 +37,31 @@ type ControlPlane struct {
 }
 
 func NewControlPlane() (*ControlPlane, error) {
-	cp := &ControlPlane{}
+	return NewControlPlaneWithOptions(controller.KubernetesOperatorOptions{})
+}
 
-	operator, err := controller.NewKubernetesOperator(cp.sendMessage)
+// NewControlPlaneWithOptions creates the control plane with the given options of the Kubernetes operator,
+// e.g. the clusters which OpenSergo rules are sourced from.
+func NewControlPlaneWithOptions(options controller.KubernetesOperatorOptions) (*ControlPlane, error) {
+	operator, err := controller.NewKubernetesOperatorWithOptions(options)
 	if err != nil {
 		return nil, err
 	}
+	return NewControlPlaneWithSource(operator)
+}
+
+// NewControlPlaneWithSource creates the control plane which pushes the rules from the given config source,
+// so that the control plane can also run without Kubernetes.
+func NewControlPlaneWithSource(configSource source.ConfigSource) (*ControlPlane, error) {
+	cp := &ControlPlane{source: configSource}
 
 	cp.server = transport.NewServer(uint32(10246), []model.SubscribeRequestHandler{cp.handleSubscribeRequest})
-	cp.operator = operator
+	configSource.SetEventHandler(cp.handleConfigEvent)
+	// Reflect the delivery status of rules in the source, e.g. in the status of CRDs.
+	if aware, ok := configSource.(source.DeliveryStatusAware); ok {
+		cp.server.DeliveryTracker().SetChangedHandler(aware.UpdateStatus)
+		aware.SetDeliveryStatusProvider(cp.server.DeliveryTracker().Status)
+	}
 
 	hostname, herr := os.Hostname()
 	if herr != nil {
@@ -57,8 +74,8 @@ func NewControlPlane() (*ControlPlane, error) {
 }
 
 func (c *ControlPlane) Start() error {
-	// Run the Kubernetes operator
-	err := c.operator.Run()
+	// Run the config source
+	err := c.source.Run()
 	if err != nil {
 		return err
 	}
@@ -71,6 +88,24 @@ func (c *ControlPlane) Start() error {
 	return nil
 }
 
+// handleConfigEvent pushes the latest rules of the target in the event to the connected instances.
+func (c *ControlPlane) handleConfigEvent(event source.ConfigEvent) {
+	target := event.Target
+	if _, exists := c.server.ConnectionManager().Get(target.Namespace, target.AppName, target.Kind); !exists {
+		return
+	}
+	status := &trpb.Status{
+		Code:    transport.Success,
+		Message: "Get and send rule success",
+		Details: nil,
+	}
+	err := c.sendMessage(target.Namespace, target.AppName, target.Kind, event.DataWithVersion, status, "")
+	if err != nil {
+		log.Printf("Failed to push rules of %s event, namespace=%s, app=%s, kind=%s, err=%s\n",
+			event.Type, target.Namespace, target.AppName, target.Kind, err.Error())
+	}
+}
+
 func (c *ControlPlane) sendMessage(namespace, app, kind string, dataWithVersion *trpb.DataWithVersion, status *trpb.Status, respId string) error {
 	connections, exists := c.server.ConnectionManager().Get(namespace, app, kind)
 	if !exists || connections == nil {
@@ -86,6 +121,7 @@ func (c *ControlPlane) sendMessage(namespace, app, kind string, dataWithVersion
 			// TODO: should not short-break here. Handle partial failure here.
 			return err
 		}
+		c.server.DeliveryTracker().RecordPush(namespace, app, kind, connection.Identifier(), dataWithVersion.GetVersion())
 	}
 	return nil
 }
@@ -117,7 +153,7 @@ func (c *ControlPlane) handleSubscribeRequest(clientIdentifier model.ClientIdent
 	//	}
 	// }
 	for _, kind := range request.Target.Kinds {
-		crdWatcher, err := c.operator.RegisterWatcher(model.SubscribeTarget{
+		dataWithVersion, err := c.source.Subscribe(model.SubscribeTarget{
 			Namespace: request.Target.Namespace,
 			AppName:   request.Target.App,
 			Kind:      kind,
@@ -136,25 +172,19 @@ func (c *ControlPlane) handleSubscribeRequest(clientIdentifier model.ClientIdent
 			continue
 		}
 		_ = c.server.ConnectionManager().Add(request.Target.Namespace, request.Target.App, kind, transport.NewConnection(clientIdentifier, stream))
-		// send if the watcher cache is not empty
-		rules, version := crdWatcher.GetRules(model.NamespacedApp{
-			Namespace: request.Target.Namespace,
-			App:       request.Target.App,
-		})
-		if len(rules) > 0 {
+		// send if the source has rules of the target
+		if len(dataWithVersion.Data) > 0 {
 			status := &trpb.Status{
 				Code:    transport.Success,
 				Message: "Get and send rule success",
 				Details: nil,
 			}
-			dataWithVersion := &trpb.DataWithVersion{
-				Data:    rules,
-				Version: version,
-			}
 			err = c.sendMessageToStream(stream, request.Target.Namespace, request.Target.App, kind, dataWithVersion, status, request.RequestId)
 			if err != nil {
 				// TODO: log here
 				log.Printf("sendMessageToStream failed, err=%s\n", err.Error())
+			} else {
+				c.server.DeliveryTracker().RecordPush(request.Target.Namespace, request.Target.App, kind, clientIdentifier, dataWithVersion.Version)
 			}
 		}
 	}
@@ -5,20 +5,38 @@ go 1.14
 require (
 	github.com/alibaba/sentinel-golang v1.0.3
 	github.com/cncf/xds/go v0.0.0-20220314180256-7f1daf1720fc
+	github.com/coreos/go-semver v0.3.1 // indirect
+	github.com/coreos/go-systemd/v22 v22.4.0 // indirect
 	github.com/envoyproxy/go-control-plane v0.10.3-0.20221109183938-2935a23e638f
 	github.com/envoyproxy/protoc-gen-validate v0.6.7
+	github.com/fsnotify/fsnotify v1.4.9
+	github.com/go-git/go-billy/v5 v5.3.1
+	github.com/go-git/go-git/v5 v5.4.2
 	github.com/go-logr/logr v0.4.0
 	github.com/golang/protobuf v1.5.2
 	github.com/json-iterator/go v1.1.12 // indirect
 	github.com/kr/pretty v0.3.0 // indirect
 	github.com/pkg/errors v0.9.1
+	github.com/prometheus/client_golang v1.12.0 // indirect
 	github.com/rogpeppe/go-internal v1.8.0 // indirect
+	go.etcd.io/bbolt v1.3.5
+	go.etcd.io/etcd/api/v3 v3.5.9
+	go.etcd.io/etcd/client/v3 v3.5.9
 	go.uber.org/atomic v1.7.0
+	golang.org/x/crypto v0.0.0-20220214200702-86341886e292 // indirect
 	google.golang.org/genproto v0.0.0-20220329172620-7be39ac1afc7
 	google.golang.org/grpc v1.51.0
 	google.golang.org/protobuf v1.28.1
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
+	k8s.io/api v0.21.4
 	k8s.io/apimachinery v0.21.4
 	k8s.io/client-go v0.21.4
 	sigs.k8s.io/controller-runtime v0.9.7
+	sigs.k8s.io/yaml v1.2.0
+)
+
+exclude (
+	github.com/coreos/go-systemd v0.0.0-20190321100706-95778dfbb74e
+	go.etcd.io/etcd v0.0.0-20191023171146-3cf2f69b5738
+	go.etcd.io/etcd v0.5.0-alpha.5.0.20200910180754-dd1b699fc489
 )
@@ -22,6 +22,12 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Translated",type=string,JSONPath=`.status.conditions[?(@.type=="Translated")].status`
+// +kubebuilder:printcolumn:name="Delivered",type=string,JSONPath=`.status.conditions[?(@.type=="Delivered")].status`
+// +kubebuilder:printcolumn:name="Acked",type=integer,JSONPath=`.status.ackedInstances`
+// +kubebuilder:printcolumn:name="Connected",type=integer,JSONPath=`.status.connectedInstances`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 type CircuitBreakerStrategy struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -35,14 +41,20 @@ type CircuitBreakerStrategy struct {
 // CircuitBreakerStrategySpec defines the spec of CircuitBreakerStrategy.
 type CircuitBreakerStrategySpec struct {
 	// +kubebuilder:validation:Type=string
-	// +kubebuilder:validation:Enum=SlowRequestRatio;ErrorRequestRatio
+	// +kubebuilder:validation:Enum=SlowRequestRatio;ErrorRequestRatio;ErrorRequestCount
 	// +kubebuilder:validation:Required
 	Strategy string `json:"strategy"`
 
+	// TriggerRatio is required by the SlowRequestRatio and ErrorRequestRatio strategies.
 	// +kubebuilder:validation:Type=string
-	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Pattern=^([1-9]\d?|100|0)%$
-	TriggerRatio string `json:"triggerRatio"`
+	TriggerRatio string `json:"triggerRatio,omitempty"`
+
+	// TriggerCount is the threshold of the error amount in the stat duration, which is required by the ErrorRequestCount strategy.
+	// +kubebuilder:validation:Type=integer
+	// +kubebuilder:validation:Format=int64
+	// +kubebuilder:validation:Minimum=1
+	TriggerCount int64 `json:"triggerCount,omitempty"`
 
 	// +kubebuilder:validation:Type=string
 	// +kubebuilder:validation:Required
@@ -72,13 +84,31 @@ type SlowConditions struct {
 	MaxAllowedRt string `json:"maxAllowedRt"`
 }
 
+// ErrorConditions describes which completed requests are counted as errors.
+// The conditions are ORed, and all errors are counted if no condition is set.
 type ErrorConditions struct {
+	// HttpStatusCodes are the HTTP status codes of the responses counted as errors.
+	HttpStatusCodes []HttpStatusCodeRange `json:"httpStatusCodes,omitempty"`
+
+	// GrpcCodes are the names of the canonical gRPC status codes counted as errors, e.g. UNAVAILABLE.
+	GrpcCodes []string `json:"grpcCodes,omitempty"`
+
+	// ErrorTypes are the names of the exception classes or error types counted as errors, e.g. java.io.IOException.
+	ErrorTypes []string `json:"errorTypes,omitempty"`
 }
 
+// HttpStatusCodeRange is an HTTP status code, e.g. 503, or an inclusive range of status codes, e.g. 500-599.
+// +kubebuilder:validation:Type=string
+// +kubebuilder:validation:Pattern=^[1-5]\d\d(-[1-5]\d\d)?$
+type HttpStatusCodeRange string
+
 // CircuitBreakerStrategyStatus defines the observed state of CircuitBreakerStrategy.
 type CircuitBreakerStrategyStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	RuleStatus `json:",inline"`
+}
+
+func (in *CircuitBreakerStrategy) GetRuleStatus() *RuleStatus {
+	return &in.Status.RuleStatus
 }
 
 // +kubebuilder:object:root=true
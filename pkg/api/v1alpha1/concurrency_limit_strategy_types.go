@@ -22,6 +22,12 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Translated",type=string,JSONPath=`.status.conditions[?(@.type=="Translated")].status`
+// +kubebuilder:printcolumn:name="Delivered",type=string,JSONPath=`.status.conditions[?(@.type=="Delivered")].status`
+// +kubebuilder:printcolumn:name="Acked",type=integer,JSONPath=`.status.ackedInstances`
+// +kubebuilder:printcolumn:name="Connected",type=integer,JSONPath=`.status.connectedInstances`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 type ConcurrencyLimitStrategy struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -35,22 +41,68 @@ type ConcurrencyLimitStrategy struct {
 // ConcurrencyLimitStrategySpec defines the spec of ConcurrencyLimitStrategy.
 type ConcurrencyLimitStrategySpec struct {
 
+	// MaxConcurrencyThreshold is the static max concurrency, which is required unless the adaptive limit is set.
 	// +kubebuilder:validation:Type=integer
 	// +kubebuilder:validation:Format=int64
 	// +kubebuilder:validation:Minimum=0
-	// +kubebuilder:validation:Required
-	MaxConcurrencyThreshold int64 `json:"maxConcurrency"`
+	MaxConcurrencyThreshold int64 `json:"maxConcurrency,omitempty"`
 
+	// LimitMode is the mode of concurrency limiting, Local or Global. Defaults to Local.
 	// +kubebuilder:validation:Type=string
 	// +kubebuilder:validation:Enum=Local;Global
 	// +kubebuilder:validation:Required
 	LimitMode string `json:"limitMode"`
+
+	// Adaptive discovers the max concurrency dynamically from the latency instead of the static maxConcurrency.
+	Adaptive *AdaptiveConcurrencyLimit `json:"adaptive,omitempty"`
+}
+
+const (
+	GradientAdaptiveAlgorithm string = "Gradient"
+	VegasAdaptiveAlgorithm    string = "Vegas"
+)
+
+// AdaptiveConcurrencyLimit describes how the SDKs discover the max concurrency from the latency.
+type AdaptiveConcurrencyLimit struct {
+	// Algorithm is the algorithm of the adaptive limit, Gradient or Vegas. Defaults to Gradient.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Enum=Gradient;Vegas
+	Algorithm string `json:"algorithm,omitempty"`
+
+	// MinConcurrency is the lower bound of the limit.
+	// +kubebuilder:validation:Type=integer
+	// +kubebuilder:validation:Format=int64
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Required
+	MinConcurrency int64 `json:"minConcurrency"`
+
+	// MaxConcurrency is the upper bound of the limit, which must not be less than MinConcurrency.
+	// +kubebuilder:validation:Type=integer
+	// +kubebuilder:validation:Format=int64
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Required
+	MaxConcurrency int64 `json:"maxConcurrency"`
+
+	// Smoothing is the factor of the exponential smoothing of the limit in (0, 1], e.g. 0.2.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=^(0(\.\d+)?|1(\.0+)?)$
+	Smoothing string `json:"smoothing"`
+
+	// ProbeInterval is the interval to probe for the latency without load.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=^[1-9]\d*(s|ms|m|min|minute|h|d)$
+	ProbeInterval string `json:"probeInterval"`
 }
 
 // ConcurrencyLimitStrategyStatus defines the observed state of ConcurrencyLimitStrategy.
 type ConcurrencyLimitStrategyStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	RuleStatus `json:",inline"`
+}
+
+func (in *ConcurrencyLimitStrategy) GetRuleStatus() *RuleStatus {
+	return &in.Status.RuleStatus
 }
 
 // +kubebuilder:object:root=true
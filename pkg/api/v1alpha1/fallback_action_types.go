@@ -0,0 +1,105 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Translated",type=string,JSONPath=`.status.conditions[?(@.type=="Translated")].status`
+// +kubebuilder:printcolumn:name="Delivered",type=string,JSONPath=`.status.conditions[?(@.type=="Delivered")].status`
+// +kubebuilder:printcolumn:name="Acked",type=integer,JSONPath=`.status.ackedInstances`
+// +kubebuilder:printcolumn:name="Connected",type=integer,JSONPath=`.status.connectedInstances`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+type FallbackAction struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec FallbackActionSpec `json:"spec,omitempty"`
+
+	Status FallbackActionStatus `json:"status,omitempty"`
+}
+
+// FallbackActionSpec defines the spec of FallbackAction, which describes what the requests blocked
+// by the fault-tolerance strategies return. Exactly one of the responses must be set.
+type FallbackActionSpec struct {
+	HttpResponse *FallbackHttpResponse `json:"httpResponse,omitempty"`
+
+	GrpcResponse *FallbackGrpcResponse `json:"grpcResponse,omitempty"`
+
+	// FallbackResource redirects the blocked requests to another resource.
+	FallbackResource *FallbackResourceRef `json:"fallbackResource,omitempty"`
+}
+
+type FallbackHttpResponse struct {
+	// +kubebuilder:validation:Type=integer
+	// +kubebuilder:validation:Format=int32
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	// +kubebuilder:validation:Required
+	StatusCode int32 `json:"statusCode"`
+
+	Headers map[string]string `json:"headers,omitempty"`
+
+	Body string `json:"body,omitempty"`
+}
+
+type FallbackGrpcResponse struct {
+	// Code is the name of the canonical gRPC status code.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=OK;CANCELLED;UNKNOWN;INVALID_ARGUMENT;DEADLINE_EXCEEDED;NOT_FOUND;ALREADY_EXISTS;PERMISSION_DENIED;RESOURCE_EXHAUSTED;FAILED_PRECONDITION;ABORTED;OUT_OF_RANGE;UNIMPLEMENTED;INTERNAL;UNAVAILABLE;DATA_LOSS;UNAUTHENTICATED
+	Code string `json:"code"`
+
+	Message string `json:"message,omitempty"`
+}
+
+type FallbackResourceRef struct {
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Required
+	TargetResourceName string `json:"targetResourceName"`
+}
+
+// FallbackActionStatus defines the observed state of FallbackAction.
+type FallbackActionStatus struct {
+	RuleStatus `json:",inline"`
+}
+
+func (in *FallbackAction) GetRuleStatus() *RuleStatus {
+	return &in.Status.RuleStatus
+}
+
+// +kubebuilder:object:root=true
+
+// FallbackActionList contains a list of FallbackAction.
+type FallbackActionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FallbackAction `json:"items"`
+}
+
+// +kubebuilder:rbac:groups=fault-tolerance.opensergo.io,resources=FallbackAction,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=fault-tolerance.opensergo.io,resources=FallbackAction/status,verbs=get;update;patch
+
+func init() {
+	SchemeBuilder.Register(&FallbackAction{}, &FallbackActionList{})
+}
@@ -22,6 +22,12 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Translated",type=string,JSONPath=`.status.conditions[?(@.type=="Translated")].status`
+// +kubebuilder:printcolumn:name="Delivered",type=string,JSONPath=`.status.conditions[?(@.type=="Delivered")].status`
+// +kubebuilder:printcolumn:name="Acked",type=integer,JSONPath=`.status.ackedInstances`
+// +kubebuilder:printcolumn:name="Connected",type=integer,JSONPath=`.status.connectedInstances`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 type FaultToleranceRule struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -44,19 +50,100 @@ type FaultToleranceRuleSpec struct {
 	// +kubebuilder:validation:Type=array
 	// +kubebuilder:validation:Required
 	Strategies []FaultToleranceStrategyRef `json:"strategies"`
+
+	// Action describes the response of the requests blocked by the strategies.
+	Action *FaultToleranceActionRef `json:"action,omitempty"`
 }
 
+const (
+	ExactMatchType string = "Exact"
+	GlobMatchType  string = "Glob"
+	RegexMatchType string = "Regex"
+)
+
 type FaultToleranceTargetRef struct {
+	// TargetResourceName is the name of the resource, or a pattern of the names of the resources
+	// according to MatchType, e.g. GET:/api/v1/orders/* as a glob pattern.
 	// +kubebuilder:validation:Type=string
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=1024
 	// +kubebuilder:validation:Required
 	TargetResourceName string `json:"targetResourceName"`
+
+	// MatchType is how TargetResourceName matches the names of the resources: Exact (default),
+	// Glob (* matches any sequence of characters except /, ** matches any sequence of characters
+	// and ? matches any single character except /) or Regex (RE2 style, matching the whole name).
+	// +kubebuilder:validation:Enum=Exact;Glob;Regex
+	MatchType string `json:"matchType,omitempty"`
+
+	// Priority decides which targets apply when a resource is matched by the targets of multiple rules
+	// with the same sources. Only the targets of the highest priority apply, and at the same priority,
+	// exact targets take precedence over patterns.
+	Priority int32 `json:"priority,omitempty"`
+
+	// Sources scopes the rule to the requests matching any of the sources.
+	// The rule applies to all requests of the target if no source is given.
+	Sources []FaultToleranceSource `json:"sources,omitempty"`
+}
+
+// FaultToleranceSource matches the requests by the caller and the request attributes.
+// A request matches the source only if all the given matchers match.
+type FaultToleranceSource struct {
+	// App matches the app of the caller.
+	App *StringMatch `json:"app,omitempty"`
+
+	// Namespace matches the namespace of the caller.
+	Namespace *StringMatch `json:"namespace,omitempty"`
+
+	// Method matches the method of the request, e.g. GET of HTTP requests.
+	Method *StringMatch `json:"method,omitempty"`
+
+	// Headers matches the headers of the request by header name.
+	Headers map[string]StringMatch `json:"headers,omitempty"`
+
+	// QueryParams matches the query parameters of the request by parameter name.
+	QueryParams map[string]StringMatch `json:"queryParams,omitempty"`
+}
+
+// StringMatch describes how to match a string, with the same semantics as the StringMatch of TrafficRouter.
+// Exactly one of the fields must be set, and the match is case-sensitive.
+type StringMatch struct {
+	Exact string `json:"exact,omitempty"`
+
+	Prefix string `json:"prefix,omitempty"`
+
+	// Regex is an RE2 style regular expression (https://github.com/google/re2/wiki/Syntax),
+	// which must match the whole string.
+	Regex string `json:"regex,omitempty"`
+}
+
+func (m *StringMatch) GetExact() string {
+	if m != nil {
+		return m.Exact
+	}
+	return ""
+}
+
+func (m *StringMatch) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *StringMatch) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
 }
 
 const (
 	RateLimitStrategyKind        string = "RateLimitStrategy"
 	ConcurrencyLimitStrategyKind string = "ConcurrencyLimitStrategy"
+	ParamFlowStrategyKind        string = "ParamFlowStrategy"
+	RetryStrategyKind            string = "RetryStrategy"
+	TimeoutStrategyKind          string = "TimeoutStrategy"
 )
 
 type FaultToleranceStrategyRef struct {
@@ -68,14 +155,34 @@ type FaultToleranceStrategyRef struct {
 	// +kubebuilder:validation:Type=string
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Enum=RateLimitStrategy;ConcurrencyLimitStrategy
+	// +kubebuilder:validation:Enum=RateLimitStrategy;ConcurrencyLimitStrategy;ParamFlowStrategy;RetryStrategy;TimeoutStrategy
+	Kind string `json:"kind"`
+}
+
+const (
+	FallbackActionKind string = "FallbackAction"
+)
+
+type FaultToleranceActionRef struct {
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=FallbackAction
 	Kind string `json:"kind"`
 }
 
 // FaultToleranceRuleStatus defines the observed state of FaultToleranceRule.
 type FaultToleranceRuleStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	RuleStatus `json:",inline"`
+}
+
+func (in *FaultToleranceRule) GetRuleStatus() *RuleStatus {
+	return &in.Status.RuleStatus
 }
 
 // +kubebuilder:object:root=true
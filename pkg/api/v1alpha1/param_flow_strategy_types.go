@@ -0,0 +1,123 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Translated",type=string,JSONPath=`.status.conditions[?(@.type=="Translated")].status`
+// +kubebuilder:printcolumn:name="Delivered",type=string,JSONPath=`.status.conditions[?(@.type=="Delivered")].status`
+// +kubebuilder:printcolumn:name="Acked",type=integer,JSONPath=`.status.ackedInstances`
+// +kubebuilder:printcolumn:name="Connected",type=integer,JSONPath=`.status.connectedInstances`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+type ParamFlowStrategy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ParamFlowStrategySpec `json:"spec,omitempty"`
+
+	Status ParamFlowStrategyStatus `json:"status,omitempty"`
+}
+
+const (
+	HeaderParamSource     string = "Header"
+	QueryParamParamSource string = "QueryParam"
+	ArgIndexParamSource   string = "ArgIndex"
+)
+
+// ParamFlowStrategySpec defines the spec of ParamFlowStrategy, which limits the request amount
+// per value of a request parameter, e.g. per user ID in a header.
+type ParamFlowStrategySpec struct {
+	// ParamSource is where the parameter is read from: Header, QueryParam, or ArgIndex for the arguments of RPC invocations.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Enum=Header;QueryParam;ArgIndex
+	// +kubebuilder:validation:Required
+	ParamSource string `json:"paramSource"`
+
+	// ParamKey is the name of the header or the query parameter, which is required by the Header and QueryParam sources.
+	// +kubebuilder:validation:Type=string
+	ParamKey string `json:"paramKey,omitempty"`
+
+	// ParamIndex is the index of the argument, which is required by the ArgIndex source.
+	// +kubebuilder:validation:Type=integer
+	// +kubebuilder:validation:Format=int32
+	// +kubebuilder:validation:Minimum=0
+	ParamIndex *int32 `json:"paramIndex,omitempty"`
+
+	// LimitMode is the mode of rate limiting, Local or Global. Defaults to Local.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Enum=Local;Global
+	// +kubebuilder:validation:Required
+	LimitMode string `json:"limitMode"`
+
+	// Threshold is the threshold of the request amount of each value of the parameter.
+	// +kubebuilder:validation:Type=integer
+	// +kubebuilder:validation:Format=int64
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Required
+	Threshold int64 `json:"threshold"`
+
+	// +kubebuilder:validation:Type=integer
+	// +kubebuilder:validation:Format=int32
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Required
+	StatDurationSeconds int32 `json:"statDurationSeconds"`
+
+	// Exceptions override the threshold of specific values of the parameter.
+	Exceptions []ParamException `json:"exceptions,omitempty"`
+}
+
+type ParamException struct {
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Required
+	Value string `json:"value"`
+
+	// +kubebuilder:validation:Type=integer
+	// +kubebuilder:validation:Format=int64
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Required
+	Threshold int64 `json:"threshold"`
+}
+
+// ParamFlowStrategyStatus defines the observed state of ParamFlowStrategy.
+type ParamFlowStrategyStatus struct {
+	RuleStatus `json:",inline"`
+}
+
+func (in *ParamFlowStrategy) GetRuleStatus() *RuleStatus {
+	return &in.Status.RuleStatus
+}
+
+// +kubebuilder:object:root=true
+
+// ParamFlowStrategyList contains a list of ParamFlowStrategy.
+type ParamFlowStrategyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ParamFlowStrategy `json:"items"`
+}
+
+// +kubebuilder:rbac:groups=fault-tolerance.opensergo.io,resources=ParamFlowStrategy,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=fault-tolerance.opensergo.io,resources=ParamFlowStrategy/status,verbs=get;update;patch
+
+func init() {
+	SchemeBuilder.Register(&ParamFlowStrategy{}, &ParamFlowStrategyList{})
+}
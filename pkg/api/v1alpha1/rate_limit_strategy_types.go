@@ -22,6 +22,12 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Translated",type=string,JSONPath=`.status.conditions[?(@.type=="Translated")].status`
+// +kubebuilder:printcolumn:name="Delivered",type=string,JSONPath=`.status.conditions[?(@.type=="Delivered")].status`
+// +kubebuilder:printcolumn:name="Acked",type=integer,JSONPath=`.status.ackedInstances`
+// +kubebuilder:printcolumn:name="Connected",type=integer,JSONPath=`.status.connectedInstances`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 type RateLimitStrategy struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -37,15 +43,24 @@ const (
 
 	LocalLimitMode  string = "Local"
 	GlobalLimitMode string = "Global"
+
+	RejectControlBehavior         string = "Reject"
+	WarmUpControlBehavior         string = "WarmUp"
+	QueueingControlBehavior       string = "Queueing"
+	WarmUpQueueingControlBehavior string = "WarmUpQueueing"
+
+	DefaultColdFactor int32 = 3
 )
 
 // RateLimitStrategySpec defines the spec of RateLimitStrategy.
 type RateLimitStrategySpec struct {
+	// MetricType is the metric of rate limiting. Defaults to RequestAmount.
 	// +kubebuilder:validation:Type=string
 	// +kubebuilder:validation:Enum=RequestAmount
 	// +kubebuilder:validation:Required
 	MetricType string `json:"metricType"`
 
+	// LimitMode is the mode of rate limiting, Local or Global. Defaults to Local.
 	// +kubebuilder:validation:Type=string
 	// +kubebuilder:validation:Enum=Local;Global
 	// +kubebuilder:validation:Required
@@ -62,12 +77,44 @@ type RateLimitStrategySpec struct {
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Required
 	StatDurationSeconds int32 `json:"statDurationSeconds"`
+
+	// ControlBehavior is how the requests exceeding the threshold are handled:
+	// Reject, WarmUp, Queueing (uniform intervals), or WarmUpQueueing. Defaults to Reject.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Enum=Reject;WarmUp;Queueing;WarmUpQueueing
+	ControlBehavior string `json:"controlBehavior,omitempty"`
+
+	// WarmUp is required by the WarmUp and WarmUpQueueing behaviors.
+	WarmUp *RateLimitWarmUp `json:"warmUp,omitempty"`
+
+	// MaxQueueingTime is the max time a request waits in the queue, which is required by the Queueing and WarmUpQueueing behaviors.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=^[1-9]\d*(s|ms|m|min|minute|h|d)$
+	MaxQueueingTime string `json:"maxQueueingTime,omitempty"`
+}
+
+// RateLimitWarmUp raises the threshold gradually from threshold / coldFactor to the threshold in the warm-up period,
+// so that the instances which have just started are not overwhelmed.
+type RateLimitWarmUp struct {
+	// ColdFactor is the factor of the initial threshold. Defaults to 3.
+	// +kubebuilder:validation:Type=integer
+	// +kubebuilder:validation:Format=int32
+	// +kubebuilder:validation:Minimum=2
+	ColdFactor int32 `json:"coldFactor,omitempty"`
+
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=^[1-9]\d*(s|ms|m|min|minute|h|d)$
+	WarmUpPeriod string `json:"warmUpPeriod"`
 }
 
 // RateLimitStrategyStatus defines the observed state of RateLimitStrategy.
 type RateLimitStrategyStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	RuleStatus `json:",inline"`
+}
+
+func (in *RateLimitStrategy) GetRuleStatus() *RuleStatus {
+	return &in.Status.RuleStatus
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,129 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Translated",type=string,JSONPath=`.status.conditions[?(@.type=="Translated")].status`
+// +kubebuilder:printcolumn:name="Delivered",type=string,JSONPath=`.status.conditions[?(@.type=="Delivered")].status`
+// +kubebuilder:printcolumn:name="Acked",type=integer,JSONPath=`.status.ackedInstances`
+// +kubebuilder:printcolumn:name="Connected",type=integer,JSONPath=`.status.connectedInstances`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// RetryStrategy retries the failed requests of the outbound resources, which are the targets of the FaultToleranceRules referencing it.
+type RetryStrategy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec RetryStrategySpec `json:"spec,omitempty"`
+
+	Status RetryStrategyStatus `json:"status,omitempty"`
+}
+
+// RetryStrategySpec defines the spec of RetryStrategy.
+type RetryStrategySpec struct {
+	// MaxAttempts is the max attempts of a request, including the first attempt.
+	// +kubebuilder:validation:Type=integer
+	// +kubebuilder:validation:Format=int32
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Required
+	MaxAttempts int32 `json:"maxAttempts"`
+
+	// PerTryTimeout is the timeout of each attempt. No timeout if absent.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=^[1-9]\d*(s|ms|m|min|minute|h|d)$
+	PerTryTimeout string `json:"perTryTimeout,omitempty"`
+
+	// Backoff is the backoff between the attempts. The attempts are made immediately if absent.
+	Backoff *RetryBackoff `json:"backoff,omitempty"`
+
+	// RetryOn describes which failed requests are retried. All failed requests are retried if absent.
+	RetryOn *RetryConditions `json:"retryOn,omitempty"`
+
+	// Budget limits the retries to a ratio of the requests. No limit if absent.
+	Budget *RetryBudget `json:"budget,omitempty"`
+}
+
+// RetryBackoff is the exponential backoff between the attempts.
+type RetryBackoff struct {
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=^[1-9]\d*(s|ms|m|min|minute|h|d)$
+	BaseInterval string `json:"baseInterval"`
+
+	// MaxInterval is the upper bound of the interval, which must not be less than BaseInterval.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=^[1-9]\d*(s|ms|m|min|minute|h|d)$
+	MaxInterval string `json:"maxInterval,omitempty"`
+
+	// Multiplier is the factor of the interval of each attempt, e.g. 1.5. Defaults to 2.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=^\d+(\.\d+)?$
+	Multiplier string `json:"multiplier,omitempty"`
+}
+
+// RetryConditions describes which failed requests are retried. The conditions are ORed.
+type RetryConditions struct {
+	ErrorConditions `json:",inline"`
+
+	// Timeout retries the attempts which time out.
+	Timeout bool `json:"timeout,omitempty"`
+}
+
+// RetryBudget limits the retries to a ratio of the requests, so that retries never amplify an outage.
+type RetryBudget struct {
+	// Ratio is the max ratio of the retries to the requests, e.g. 20%.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=^([1-9]\d?|100|0)%$
+	Ratio string `json:"ratio"`
+
+	// MinRetriesPerSecond is the retries which are always allowed per second regardless of the ratio.
+	// +kubebuilder:validation:Type=integer
+	// +kubebuilder:validation:Format=int32
+	// +kubebuilder:validation:Minimum=0
+	MinRetriesPerSecond int32 `json:"minRetriesPerSecond,omitempty"`
+}
+
+// RetryStrategyStatus defines the observed state of RetryStrategy.
+type RetryStrategyStatus struct {
+	RuleStatus `json:",inline"`
+}
+
+func (in *RetryStrategy) GetRuleStatus() *RuleStatus {
+	return &in.Status.RuleStatus
+}
+
+// +kubebuilder:object:root=true
+
+// RetryStrategyList contains a list of RetryStrategy.
+type RetryStrategyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RetryStrategy `json:"items"`
+}
+
+// +kubebuilder:rbac:groups=fault-tolerance.opensergo.io,resources=RetryStrategy,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=fault-tolerance.opensergo.io,resources=RetryStrategy/status,verbs=get;update;patch
+
+func init() {
+	SchemeBuilder.Register(&RetryStrategy{}, &RetryStrategyList{})
+}
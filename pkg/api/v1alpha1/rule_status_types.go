@@ -0,0 +1,67 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// RuleConditionTranslated indicates whether the CRD has been translated to the rule delivered to clients.
+	RuleConditionTranslated string = "Translated"
+	// RuleConditionDelivered indicates whether all connected instances have ACKed the current version of the rule.
+	RuleConditionDelivered string = "Delivered"
+	// RuleConditionRejected indicates whether any connected instance has NACKed the current version of the rule.
+	RuleConditionRejected string = "Rejected"
+	// RuleConditionResolvedRefs indicates whether all references of the rule (e.g. strategies of a FaultToleranceRule) exist.
+	RuleConditionResolvedRefs string = "ResolvedRefs"
+	// RuleConditionOverlapped indicates whether any pattern target of a FaultToleranceRule shadows or duplicates other targets.
+	RuleConditionOverlapped string = "Overlapped"
+)
+
+// RuleStatus defines the observed state of an OpenSergo rule, which is shared by all OpenSergo CRDs.
+type RuleStatus struct {
+	// ObservedGeneration is the latest generation of the CRD observed by the control plane.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest observations of the rule, e.g. Translated, Delivered and Rejected.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Version is the version of the rules of the app which have been pushed to the connected instances.
+	// +optional
+	Version int64 `json:"version,omitempty"`
+
+	// ConnectedInstances is the number of connected instances which subscribe the rule.
+	// +optional
+	ConnectedInstances int32 `json:"connectedInstances,omitempty"`
+
+	// AckedInstances is the number of connected instances which have ACKed the current version.
+	// +optional
+	AckedInstances int32 `json:"ackedInstances,omitempty"`
+
+	// LastNackMessage is the message of the last NACK of the current version from connected instances.
+	// +optional
+	LastNackMessage string `json:"lastNackMessage,omitempty"`
+}
+
+// RuleStatusHolder is implemented by all OpenSergo CRDs, which gives access to the RuleStatus.
+// +kubebuilder:object:generate=false
+type RuleStatusHolder interface {
+	GetRuleStatus() *RuleStatus
+}
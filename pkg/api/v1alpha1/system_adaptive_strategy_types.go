@@ -0,0 +1,96 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Translated",type=string,JSONPath=`.status.conditions[?(@.type=="Translated")].status`
+// +kubebuilder:printcolumn:name="Delivered",type=string,JSONPath=`.status.conditions[?(@.type=="Delivered")].status`
+// +kubebuilder:printcolumn:name="Acked",type=integer,JSONPath=`.status.ackedInstances`
+// +kubebuilder:printcolumn:name="Connected",type=integer,JSONPath=`.status.connectedInstances`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// SystemAdaptiveStrategy protects each instance of an app from overload regardless of the resources.
+// Unlike other strategies, it is not referenced by FaultToleranceRules, and applies to the whole app of its `app` label.
+type SystemAdaptiveStrategy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SystemAdaptiveStrategySpec `json:"spec,omitempty"`
+
+	Status SystemAdaptiveStrategyStatus `json:"status,omitempty"`
+}
+
+// SystemAdaptiveStrategySpec defines the spec of SystemAdaptiveStrategy. The inbound requests are blocked
+// once any of the thresholds is exceeded, and at least one threshold must be set.
+type SystemAdaptiveStrategySpec struct {
+	// MaxCpuUsage is the threshold of the CPU usage of the instance, e.g. 80%.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=^([1-9]\d?|100|0)%$
+	MaxCpuUsage string `json:"maxCpuUsage,omitempty"`
+
+	// MaxSystemLoad is the threshold of the system load (load1) of the instance, e.g. 8 or 2.5.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=^\d+(\.\d+)?$
+	MaxSystemLoad string `json:"maxSystemLoad,omitempty"`
+
+	// MaxAvgRt is the threshold of the average RT of the inbound requests, e.g. 200ms.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=^[1-9]\d*(s|ms|m|min|minute|h|d)$
+	MaxAvgRt string `json:"maxAvgRt,omitempty"`
+
+	// MaxInboundQps is the threshold of the QPS of the inbound requests.
+	// +kubebuilder:validation:Type=integer
+	// +kubebuilder:validation:Format=int64
+	// +kubebuilder:validation:Minimum=1
+	MaxInboundQps int64 `json:"maxInboundQps,omitempty"`
+
+	// MaxConcurrency is the threshold of the inbound requests (or threads) being processed concurrently.
+	// +kubebuilder:validation:Type=integer
+	// +kubebuilder:validation:Format=int64
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrency int64 `json:"maxConcurrency,omitempty"`
+}
+
+// SystemAdaptiveStrategyStatus defines the observed state of SystemAdaptiveStrategy.
+type SystemAdaptiveStrategyStatus struct {
+	RuleStatus `json:",inline"`
+}
+
+func (in *SystemAdaptiveStrategy) GetRuleStatus() *RuleStatus {
+	return &in.Status.RuleStatus
+}
+
+// +kubebuilder:object:root=true
+
+// SystemAdaptiveStrategyList contains a list of SystemAdaptiveStrategy.
+type SystemAdaptiveStrategyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SystemAdaptiveStrategy `json:"items"`
+}
+
+// +kubebuilder:rbac:groups=fault-tolerance.opensergo.io,resources=SystemAdaptiveStrategy,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=fault-tolerance.opensergo.io,resources=SystemAdaptiveStrategy/status,verbs=get;update;patch
+
+func init() {
+	SchemeBuilder.Register(&SystemAdaptiveStrategy{}, &SystemAdaptiveStrategyList{})
+}
@@ -22,6 +22,12 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Translated",type=string,JSONPath=`.status.conditions[?(@.type=="Translated")].status`
+// +kubebuilder:printcolumn:name="Delivered",type=string,JSONPath=`.status.conditions[?(@.type=="Delivered")].status`
+// +kubebuilder:printcolumn:name="Acked",type=integer,JSONPath=`.status.ackedInstances`
+// +kubebuilder:printcolumn:name="Connected",type=integer,JSONPath=`.status.connectedInstances`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 type ThrottlingStrategy struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -47,8 +53,11 @@ type ThrottlingStrategySpec struct {
 
 // ThrottlingStrategyStatus defines the observed state of ThrottlingStrategy.
 type ThrottlingStrategyStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	RuleStatus `json:",inline"`
+}
+
+func (in *ThrottlingStrategy) GetRuleStatus() *RuleStatus {
+	return &in.Status.RuleStatus
 }
 
 // +kubebuilder:object:root=true
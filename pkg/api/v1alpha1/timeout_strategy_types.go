@@ -0,0 +1,73 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Translated",type=string,JSONPath=`.status.conditions[?(@.type=="Translated")].status`
+// +kubebuilder:printcolumn:name="Delivered",type=string,JSONPath=`.status.conditions[?(@.type=="Delivered")].status`
+// +kubebuilder:printcolumn:name="Acked",type=integer,JSONPath=`.status.ackedInstances`
+// +kubebuilder:printcolumn:name="Connected",type=integer,JSONPath=`.status.connectedInstances`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// TimeoutStrategy limits the time of the requests of the outbound resources, which are the targets of the FaultToleranceRules referencing it.
+type TimeoutStrategy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TimeoutStrategySpec `json:"spec,omitempty"`
+
+	Status TimeoutStrategyStatus `json:"status,omitempty"`
+}
+
+// TimeoutStrategySpec defines the spec of TimeoutStrategy.
+type TimeoutStrategySpec struct {
+	// Timeout is the timeout of a request including all retries.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=^[1-9]\d*(s|ms|m|min|minute|h|d)$
+	Timeout string `json:"timeout"`
+}
+
+// TimeoutStrategyStatus defines the observed state of TimeoutStrategy.
+type TimeoutStrategyStatus struct {
+	RuleStatus `json:",inline"`
+}
+
+func (in *TimeoutStrategy) GetRuleStatus() *RuleStatus {
+	return &in.Status.RuleStatus
+}
+
+// +kubebuilder:object:root=true
+
+// TimeoutStrategyList contains a list of TimeoutStrategy.
+type TimeoutStrategyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TimeoutStrategy `json:"items"`
+}
+
+// +kubebuilder:rbac:groups=fault-tolerance.opensergo.io,resources=TimeoutStrategy,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=fault-tolerance.opensergo.io,resources=TimeoutStrategy/status,verbs=get;update;patch
+
+func init() {
+	SchemeBuilder.Register(&TimeoutStrategy{}, &TimeoutStrategyList{})
+}
@@ -15,10 +15,12 @@
 package traffic
 
 import (
+	"github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 type TrafficRouter struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -38,8 +40,11 @@ type TrafficRouterList struct {
 
 // HttpRequestMatchRuleStatus defines the observed state of HttpRequestMatchRule.
 type TrafficRouterStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	v1alpha1.RuleStatus `json:",inline"`
+}
+
+func (in *TrafficRouter) GetRuleStatus() *v1alpha1.RuleStatus {
+	return &in.Status.RuleStatus
 }
 
 func init() {
@@ -29,7 +29,7 @@ func (in *TrafficRouter) DeepCopyInto(out *TrafficRouter) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficRouterRule.
@@ -81,3 +81,19 @@ func (in *TrafficRouterList) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficRouterStatus) DeepCopyInto(out *TrafficRouterStatus) {
+	*out = *in
+	in.RuleStatus.DeepCopyInto(&out.RuleStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficRouterStatus.
+func (in *TrafficRouterStatus) DeepCopy() *TrafficRouterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficRouterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
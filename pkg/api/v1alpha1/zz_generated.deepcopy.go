@@ -20,16 +20,32 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveConcurrencyLimit) DeepCopyInto(out *AdaptiveConcurrencyLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveConcurrencyLimit.
+func (in *AdaptiveConcurrencyLimit) DeepCopy() *AdaptiveConcurrencyLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveConcurrencyLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircuitBreakerStrategy) DeepCopyInto(out *CircuitBreakerStrategy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreakerStrategy.
@@ -86,7 +102,7 @@ func (in *CircuitBreakerStrategyList) DeepCopyObject() runtime.Object {
 func (in *CircuitBreakerStrategySpec) DeepCopyInto(out *CircuitBreakerStrategySpec) {
 	*out = *in
 	out.SlowConditions = in.SlowConditions
-	out.ErrorConditions = in.ErrorConditions
+	in.ErrorConditions.DeepCopyInto(&out.ErrorConditions)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreakerStrategySpec.
@@ -102,6 +118,7 @@ func (in *CircuitBreakerStrategySpec) DeepCopy() *CircuitBreakerStrategySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircuitBreakerStrategyStatus) DeepCopyInto(out *CircuitBreakerStrategyStatus) {
 	*out = *in
+	in.RuleStatus.DeepCopyInto(&out.RuleStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreakerStrategyStatus.
@@ -119,8 +136,8 @@ func (in *ConcurrencyLimitStrategy) DeepCopyInto(out *ConcurrencyLimitStrategy)
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConcurrencyLimitStrategy.
@@ -176,6 +193,11 @@ func (in *ConcurrencyLimitStrategyList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConcurrencyLimitStrategySpec) DeepCopyInto(out *ConcurrencyLimitStrategySpec) {
 	*out = *in
+	if in.Adaptive != nil {
+		in, out := &in.Adaptive, &out.Adaptive
+		*out = new(AdaptiveConcurrencyLimit)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConcurrencyLimitStrategySpec.
@@ -191,6 +213,7 @@ func (in *ConcurrencyLimitStrategySpec) DeepCopy() *ConcurrencyLimitStrategySpec
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConcurrencyLimitStrategyStatus) DeepCopyInto(out *ConcurrencyLimitStrategyStatus) {
 	*out = *in
+	in.RuleStatus.DeepCopyInto(&out.RuleStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConcurrencyLimitStrategyStatus.
@@ -206,6 +229,21 @@ func (in *ConcurrencyLimitStrategyStatus) DeepCopy() *ConcurrencyLimitStrategySt
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ErrorConditions) DeepCopyInto(out *ErrorConditions) {
 	*out = *in
+	if in.HttpStatusCodes != nil {
+		in, out := &in.HttpStatusCodes, &out.HttpStatusCodes
+		*out = make([]HttpStatusCodeRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.GrpcCodes != nil {
+		in, out := &in.GrpcCodes, &out.GrpcCodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ErrorTypes != nil {
+		in, out := &in.ErrorTypes, &out.ErrorTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorConditions.
@@ -218,13 +256,185 @@ func (in *ErrorConditions) DeepCopy() *ErrorConditions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FallbackAction) DeepCopyInto(out *FallbackAction) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FallbackAction.
+func (in *FallbackAction) DeepCopy() *FallbackAction {
+	if in == nil {
+		return nil
+	}
+	out := new(FallbackAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FallbackAction) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FallbackActionList) DeepCopyInto(out *FallbackActionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FallbackAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FallbackActionList.
+func (in *FallbackActionList) DeepCopy() *FallbackActionList {
+	if in == nil {
+		return nil
+	}
+	out := new(FallbackActionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FallbackActionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FallbackActionSpec) DeepCopyInto(out *FallbackActionSpec) {
+	*out = *in
+	if in.HttpResponse != nil {
+		in, out := &in.HttpResponse, &out.HttpResponse
+		*out = new(FallbackHttpResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GrpcResponse != nil {
+		in, out := &in.GrpcResponse, &out.GrpcResponse
+		*out = new(FallbackGrpcResponse)
+		**out = **in
+	}
+	if in.FallbackResource != nil {
+		in, out := &in.FallbackResource, &out.FallbackResource
+		*out = new(FallbackResourceRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FallbackActionSpec.
+func (in *FallbackActionSpec) DeepCopy() *FallbackActionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FallbackActionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FallbackActionStatus) DeepCopyInto(out *FallbackActionStatus) {
+	*out = *in
+	in.RuleStatus.DeepCopyInto(&out.RuleStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FallbackActionStatus.
+func (in *FallbackActionStatus) DeepCopy() *FallbackActionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FallbackActionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FallbackGrpcResponse) DeepCopyInto(out *FallbackGrpcResponse) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FallbackGrpcResponse.
+func (in *FallbackGrpcResponse) DeepCopy() *FallbackGrpcResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(FallbackGrpcResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FallbackHttpResponse) DeepCopyInto(out *FallbackHttpResponse) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FallbackHttpResponse.
+func (in *FallbackHttpResponse) DeepCopy() *FallbackHttpResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(FallbackHttpResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FallbackResourceRef) DeepCopyInto(out *FallbackResourceRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FallbackResourceRef.
+func (in *FallbackResourceRef) DeepCopy() *FallbackResourceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(FallbackResourceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultToleranceActionRef) DeepCopyInto(out *FaultToleranceActionRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultToleranceActionRef.
+func (in *FaultToleranceActionRef) DeepCopy() *FaultToleranceActionRef {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultToleranceActionRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FaultToleranceRule) DeepCopyInto(out *FaultToleranceRule) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultToleranceRule.
@@ -283,13 +493,20 @@ func (in *FaultToleranceRuleSpec) DeepCopyInto(out *FaultToleranceRuleSpec) {
 	if in.Targets != nil {
 		in, out := &in.Targets, &out.Targets
 		*out = make([]FaultToleranceTargetRef, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Strategies != nil {
 		in, out := &in.Strategies, &out.Strategies
 		*out = make([]FaultToleranceStrategyRef, len(*in))
 		copy(*out, *in)
 	}
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(FaultToleranceActionRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultToleranceRuleSpec.
@@ -305,6 +522,7 @@ func (in *FaultToleranceRuleSpec) DeepCopy() *FaultToleranceRuleSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FaultToleranceRuleStatus) DeepCopyInto(out *FaultToleranceRuleStatus) {
 	*out = *in
+	in.RuleStatus.DeepCopyInto(&out.RuleStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultToleranceRuleStatus.
@@ -317,6 +535,50 @@ func (in *FaultToleranceRuleStatus) DeepCopy() *FaultToleranceRuleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultToleranceSource) DeepCopyInto(out *FaultToleranceSource) {
+	*out = *in
+	if in.App != nil {
+		in, out := &in.App, &out.App
+		*out = new(StringMatch)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(StringMatch)
+		**out = **in
+	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(StringMatch)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]StringMatch, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.QueryParams != nil {
+		in, out := &in.QueryParams, &out.QueryParams
+		*out = make(map[string]StringMatch, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultToleranceSource.
+func (in *FaultToleranceSource) DeepCopy() *FaultToleranceSource {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultToleranceSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FaultToleranceStrategyRef) DeepCopyInto(out *FaultToleranceStrategyRef) {
 	*out = *in
@@ -335,6 +597,13 @@ func (in *FaultToleranceStrategyRef) DeepCopy() *FaultToleranceStrategyRef {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FaultToleranceTargetRef) DeepCopyInto(out *FaultToleranceTargetRef) {
 	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]FaultToleranceSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultToleranceTargetRef.
@@ -348,26 +617,41 @@ func (in *FaultToleranceTargetRef) DeepCopy() *FaultToleranceTargetRef {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RateLimitStrategy) DeepCopyInto(out *RateLimitStrategy) {
+func (in *ParamException) DeepCopyInto(out *ParamException) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParamException.
+func (in *ParamException) DeepCopy() *ParamException {
+	if in == nil {
+		return nil
+	}
+	out := new(ParamException)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParamFlowStrategy) DeepCopyInto(out *ParamFlowStrategy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitStrategy.
-func (in *RateLimitStrategy) DeepCopy() *RateLimitStrategy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParamFlowStrategy.
+func (in *ParamFlowStrategy) DeepCopy() *ParamFlowStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(RateLimitStrategy)
+	out := new(ParamFlowStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RateLimitStrategy) DeepCopyObject() runtime.Object {
+func (in *ParamFlowStrategy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -375,31 +659,31 @@ func (in *RateLimitStrategy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RateLimitStrategyList) DeepCopyInto(out *RateLimitStrategyList) {
+func (in *ParamFlowStrategyList) DeepCopyInto(out *ParamFlowStrategyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RateLimitStrategy, len(*in))
+		*out = make([]ParamFlowStrategy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitStrategyList.
-func (in *RateLimitStrategyList) DeepCopy() *RateLimitStrategyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParamFlowStrategyList.
+func (in *ParamFlowStrategyList) DeepCopy() *ParamFlowStrategyList {
 	if in == nil {
 		return nil
 	}
-	out := new(RateLimitStrategyList)
+	out := new(ParamFlowStrategyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RateLimitStrategyList) DeepCopyObject() runtime.Object {
+func (in *ParamFlowStrategyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -407,71 +691,67 @@ func (in *RateLimitStrategyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RateLimitStrategySpec) DeepCopyInto(out *RateLimitStrategySpec) {
+func (in *ParamFlowStrategySpec) DeepCopyInto(out *ParamFlowStrategySpec) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitStrategySpec.
-func (in *RateLimitStrategySpec) DeepCopy() *RateLimitStrategySpec {
-	if in == nil {
-		return nil
+	if in.ParamIndex != nil {
+		in, out := &in.ParamIndex, &out.ParamIndex
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Exceptions != nil {
+		in, out := &in.Exceptions, &out.Exceptions
+		*out = make([]ParamException, len(*in))
+		copy(*out, *in)
 	}
-	out := new(RateLimitStrategySpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RateLimitStrategyStatus) DeepCopyInto(out *RateLimitStrategyStatus) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitStrategyStatus.
-func (in *RateLimitStrategyStatus) DeepCopy() *RateLimitStrategyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParamFlowStrategySpec.
+func (in *ParamFlowStrategySpec) DeepCopy() *ParamFlowStrategySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RateLimitStrategyStatus)
+	out := new(ParamFlowStrategySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SlowConditions) DeepCopyInto(out *SlowConditions) {
+func (in *ParamFlowStrategyStatus) DeepCopyInto(out *ParamFlowStrategyStatus) {
 	*out = *in
+	in.RuleStatus.DeepCopyInto(&out.RuleStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlowConditions.
-func (in *SlowConditions) DeepCopy() *SlowConditions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParamFlowStrategyStatus.
+func (in *ParamFlowStrategyStatus) DeepCopy() *ParamFlowStrategyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SlowConditions)
+	out := new(ParamFlowStrategyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ThrottlingStrategy) DeepCopyInto(out *ThrottlingStrategy) {
+func (in *RateLimitStrategy) DeepCopyInto(out *RateLimitStrategy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThrottlingStrategy.
-func (in *ThrottlingStrategy) DeepCopy() *ThrottlingStrategy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitStrategy.
+func (in *RateLimitStrategy) DeepCopy() *RateLimitStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(ThrottlingStrategy)
+	out := new(RateLimitStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ThrottlingStrategy) DeepCopyObject() runtime.Object {
+func (in *RateLimitStrategy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -479,31 +759,31 @@ func (in *ThrottlingStrategy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ThrottlingStrategyList) DeepCopyInto(out *ThrottlingStrategyList) {
+func (in *RateLimitStrategyList) DeepCopyInto(out *RateLimitStrategyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ThrottlingStrategy, len(*in))
+		*out = make([]RateLimitStrategy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThrottlingStrategyList.
-func (in *ThrottlingStrategyList) DeepCopy() *ThrottlingStrategyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitStrategyList.
+func (in *RateLimitStrategyList) DeepCopy() *RateLimitStrategyList {
 	if in == nil {
 		return nil
 	}
-	out := new(ThrottlingStrategyList)
+	out := new(RateLimitStrategyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ThrottlingStrategyList) DeepCopyObject() runtime.Object {
+func (in *RateLimitStrategyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -511,31 +791,525 @@ func (in *ThrottlingStrategyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ThrottlingStrategySpec) DeepCopyInto(out *ThrottlingStrategySpec) {
+func (in *RateLimitStrategySpec) DeepCopyInto(out *RateLimitStrategySpec) {
 	*out = *in
+	if in.WarmUp != nil {
+		in, out := &in.WarmUp, &out.WarmUp
+		*out = new(RateLimitWarmUp)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThrottlingStrategySpec.
-func (in *ThrottlingStrategySpec) DeepCopy() *ThrottlingStrategySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitStrategySpec.
+func (in *RateLimitStrategySpec) DeepCopy() *RateLimitStrategySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ThrottlingStrategySpec)
+	out := new(RateLimitStrategySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ThrottlingStrategyStatus) DeepCopyInto(out *ThrottlingStrategyStatus) {
+func (in *RateLimitStrategyStatus) DeepCopyInto(out *RateLimitStrategyStatus) {
 	*out = *in
+	in.RuleStatus.DeepCopyInto(&out.RuleStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThrottlingStrategyStatus.
-func (in *ThrottlingStrategyStatus) DeepCopy() *ThrottlingStrategyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitStrategyStatus.
+func (in *RateLimitStrategyStatus) DeepCopy() *RateLimitStrategyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ThrottlingStrategyStatus)
+	out := new(RateLimitStrategyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitWarmUp) DeepCopyInto(out *RateLimitWarmUp) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitWarmUp.
+func (in *RateLimitWarmUp) DeepCopy() *RateLimitWarmUp {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitWarmUp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBackoff) DeepCopyInto(out *RetryBackoff) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBackoff.
+func (in *RetryBackoff) DeepCopy() *RetryBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBudget) DeepCopyInto(out *RetryBudget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBudget.
+func (in *RetryBudget) DeepCopy() *RetryBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryConditions) DeepCopyInto(out *RetryConditions) {
+	*out = *in
+	in.ErrorConditions.DeepCopyInto(&out.ErrorConditions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryConditions.
+func (in *RetryConditions) DeepCopy() *RetryConditions {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryConditions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryStrategy.
+func (in *RetryStrategy) DeepCopy() *RetryStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RetryStrategy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryStrategyList) DeepCopyInto(out *RetryStrategyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RetryStrategy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryStrategyList.
+func (in *RetryStrategyList) DeepCopy() *RetryStrategyList {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryStrategyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RetryStrategyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryStrategySpec) DeepCopyInto(out *RetryStrategySpec) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(RetryBackoff)
+		**out = **in
+	}
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = new(RetryConditions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(RetryBudget)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryStrategySpec.
+func (in *RetryStrategySpec) DeepCopy() *RetryStrategySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryStrategySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryStrategyStatus) DeepCopyInto(out *RetryStrategyStatus) {
+	*out = *in
+	in.RuleStatus.DeepCopyInto(&out.RuleStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryStrategyStatus.
+func (in *RetryStrategyStatus) DeepCopy() *RetryStrategyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryStrategyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleStatus) DeepCopyInto(out *RuleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleStatus.
+func (in *RuleStatus) DeepCopy() *RuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlowConditions) DeepCopyInto(out *SlowConditions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlowConditions.
+func (in *SlowConditions) DeepCopy() *SlowConditions {
+	if in == nil {
+		return nil
+	}
+	out := new(SlowConditions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StringMatch) DeepCopyInto(out *StringMatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StringMatch.
+func (in *StringMatch) DeepCopy() *StringMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(StringMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemAdaptiveStrategy) DeepCopyInto(out *SystemAdaptiveStrategy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemAdaptiveStrategy.
+func (in *SystemAdaptiveStrategy) DeepCopy() *SystemAdaptiveStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemAdaptiveStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SystemAdaptiveStrategy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemAdaptiveStrategyList) DeepCopyInto(out *SystemAdaptiveStrategyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SystemAdaptiveStrategy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemAdaptiveStrategyList.
+func (in *SystemAdaptiveStrategyList) DeepCopy() *SystemAdaptiveStrategyList {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemAdaptiveStrategyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SystemAdaptiveStrategyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemAdaptiveStrategySpec) DeepCopyInto(out *SystemAdaptiveStrategySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemAdaptiveStrategySpec.
+func (in *SystemAdaptiveStrategySpec) DeepCopy() *SystemAdaptiveStrategySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemAdaptiveStrategySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemAdaptiveStrategyStatus) DeepCopyInto(out *SystemAdaptiveStrategyStatus) {
+	*out = *in
+	in.RuleStatus.DeepCopyInto(&out.RuleStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemAdaptiveStrategyStatus.
+func (in *SystemAdaptiveStrategyStatus) DeepCopy() *SystemAdaptiveStrategyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemAdaptiveStrategyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThrottlingStrategy) DeepCopyInto(out *ThrottlingStrategy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThrottlingStrategy.
+func (in *ThrottlingStrategy) DeepCopy() *ThrottlingStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ThrottlingStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ThrottlingStrategy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThrottlingStrategyList) DeepCopyInto(out *ThrottlingStrategyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ThrottlingStrategy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThrottlingStrategyList.
+func (in *ThrottlingStrategyList) DeepCopy() *ThrottlingStrategyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ThrottlingStrategyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ThrottlingStrategyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThrottlingStrategySpec) DeepCopyInto(out *ThrottlingStrategySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThrottlingStrategySpec.
+func (in *ThrottlingStrategySpec) DeepCopy() *ThrottlingStrategySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThrottlingStrategySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThrottlingStrategyStatus) DeepCopyInto(out *ThrottlingStrategyStatus) {
+	*out = *in
+	in.RuleStatus.DeepCopyInto(&out.RuleStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThrottlingStrategyStatus.
+func (in *ThrottlingStrategyStatus) DeepCopy() *ThrottlingStrategyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ThrottlingStrategyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeoutStrategy) DeepCopyInto(out *TimeoutStrategy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeoutStrategy.
+func (in *TimeoutStrategy) DeepCopy() *TimeoutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeoutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TimeoutStrategy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeoutStrategyList) DeepCopyInto(out *TimeoutStrategyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TimeoutStrategy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeoutStrategyList.
+func (in *TimeoutStrategyList) DeepCopy() *TimeoutStrategyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeoutStrategyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TimeoutStrategyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeoutStrategySpec) DeepCopyInto(out *TimeoutStrategySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeoutStrategySpec.
+func (in *TimeoutStrategySpec) DeepCopy() *TimeoutStrategySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeoutStrategySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeoutStrategyStatus) DeepCopyInto(out *TimeoutStrategyStatus) {
+	*out = *in
+	in.RuleStatus.DeepCopyInto(&out.RuleStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeoutStrategyStatus.
+func (in *TimeoutStrategyStatus) DeepCopy() *TimeoutStrategyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeoutStrategyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
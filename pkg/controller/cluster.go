@@ -0,0 +1,209 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MergePolicy decides how the rules of a cluster are merged with the rules of other clusters.
+type MergePolicy string
+
+const (
+	// MergePolicyUnion merges the rules of the cluster with the rules of all other clusters.
+	// If several clusters have a rule with the same name, the rule of the primary cluster
+	// (or the cluster registered first) wins.
+	MergePolicyUnion MergePolicy = "Union"
+	// MergePolicyPrimaryWins uses the rules of the cluster only when the primary cluster
+	// has no rule for the same (namespace, app, kind).
+	MergePolicyPrimaryWins MergePolicy = "PrimaryWins"
+)
+
+const (
+	// DefaultClusterName is the name of the cluster built from the in-cluster config or the default kubeconfig.
+	DefaultClusterName = "default"
+
+	// ClusterSecretLabel is the label that marks a Secret as an OpenSergo cluster secret.
+	ClusterSecretLabel = "opensergo.io/cluster"
+	// ClusterSecretKubeConfigKey is the data key of the kubeconfig in a cluster secret.
+	ClusterSecretKubeConfigKey = "kubeconfig"
+	// ClusterSecretMergePolicyAnnotation is the annotation of a cluster secret that carries the MergePolicy.
+	ClusterSecretMergePolicyAnnotation = "opensergo.io/merge-policy"
+)
+
+// ClusterConfig describes a Kubernetes cluster which OpenSergo rules are sourced from.
+type ClusterConfig struct {
+	// Name is the unique name of the cluster, which is attached to the rules as provenance.
+	Name string
+	// Primary marks the primary cluster. If no cluster is marked, the first one is the primary cluster.
+	Primary bool
+	// MergePolicy decides how the rules of the cluster are merged. Defaults to MergePolicyUnion.
+	MergePolicy MergePolicy
+
+	// RestConfig is used to connect to the cluster if present, e.g. the config of an envtest environment.
+	RestConfig *rest.Config
+	// KubeConfig is the content of a kubeconfig file, used if RestConfig is nil.
+	KubeConfig []byte
+	// KubeConfigPath is the path of a kubeconfig file, used if both RestConfig and KubeConfig are empty.
+	// If all of them are empty, the default config of controller-runtime will be used.
+	KubeConfigPath string
+}
+
+func (c *ClusterConfig) restConfig() (*rest.Config, error) {
+	if c.RestConfig != nil {
+		return c.RestConfig, nil
+	}
+	if len(c.KubeConfig) > 0 {
+		return clientcmd.RESTConfigFromKubeConfig(c.KubeConfig)
+	}
+	if c.KubeConfigPath != "" {
+		return clientcmd.BuildConfigFromFlags("", c.KubeConfigPath)
+	}
+	return ctrl.GetConfig()
+}
+
+// Cluster represents a Kubernetes cluster where OpenSergo CRDs are watched.
+type Cluster struct {
+	name        string
+	primary     bool
+	mergePolicy MergePolicy
+	manager     ctrl.Manager
+	recorder    record.EventRecorder
+}
+
+func (c *Cluster) Name() string {
+	return c.name
+}
+
+func (c *Cluster) IsPrimary() bool {
+	return c.primary
+}
+
+func (c *Cluster) MergePolicy() MergePolicy {
+	return c.mergePolicy
+}
+
+func (c *Cluster) Manager() ctrl.Manager {
+	return c.manager
+}
+
+// EventRecorder returns the recorder of the Kubernetes events on the CRDs of the cluster.
+func (c *Cluster) EventRecorder() record.EventRecorder {
+	return c.recorder
+}
+
+// newClusters creates a manager for each of the given cluster configs. The primary cluster is always
+// placed first in the returned list, and other clusters keep the given order.
+// The manager of the primary cluster is created with primaryOptions, and others are created with options.
+func newClusters(configs []ClusterConfig, options, primaryOptions ctrl.Options) ([]*Cluster, error) {
+	if len(configs) == 0 {
+		configs = []ClusterConfig{{Name: DefaultClusterName, Primary: true}}
+	}
+	primaryIndex := 0
+	for i := range configs {
+		if configs[i].Primary {
+			primaryIndex = i
+			break
+		}
+	}
+
+	clusters := make([]*Cluster, 0, len(configs))
+	names := make(map[string]bool, len(configs))
+	for i := range configs {
+		conf := &configs[i]
+		if conf.Name == "" {
+			return nil, errors.Errorf("the name of cluster #%d is empty", i)
+		}
+		if names[conf.Name] {
+			return nil, errors.Errorf("duplicate cluster name: %s", conf.Name)
+		}
+		names[conf.Name] = true
+
+		mergePolicy := conf.MergePolicy
+		switch mergePolicy {
+		case "":
+			mergePolicy = MergePolicyUnion
+		case MergePolicyUnion, MergePolicyPrimaryWins:
+		default:
+			return nil, errors.Errorf("unknown merge policy of cluster %s: %s", conf.Name, mergePolicy)
+		}
+
+		k8sConfig, err := conf.restConfig()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load the config of cluster %s", conf.Name)
+		}
+		mgrOptions := options
+		if i == primaryIndex {
+			mgrOptions = primaryOptions
+		}
+		mgr, err := ctrl.NewManager(k8sConfig, mgrOptions)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to create manager for cluster %s", conf.Name)
+		}
+		c := &Cluster{
+			name:        conf.Name,
+			primary:     i == primaryIndex,
+			mergePolicy: mergePolicy,
+			manager:     mgr,
+			recorder:    mgr.GetEventRecorderFor(EventRecorderName),
+		}
+		if c.primary {
+			clusters = append([]*Cluster{c}, clusters...)
+		} else {
+			clusters = append(clusters, c)
+		}
+	}
+	return clusters, nil
+}
+
+// ClusterConfigsFromSecrets builds cluster configs from the cluster secrets in the given namespace.
+// A cluster secret is labeled with ClusterSecretLabel, whose value is the name of the cluster,
+// and carries the kubeconfig under the ClusterSecretKubeConfigKey data key.
+func ClusterConfigsFromSecrets(ctx context.Context, reader client.Reader, namespace string) ([]ClusterConfig, error) {
+	selector, err := labels.Parse(ClusterSecretLabel)
+	if err != nil {
+		return nil, err
+	}
+	secrets := &corev1.SecretList{}
+	if err = reader.List(ctx, secrets, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	configs := make([]ClusterConfig, 0, len(secrets.Items))
+	for _, secret := range secrets.Items {
+		kubeConfig, exists := secret.Data[ClusterSecretKubeConfigKey]
+		if !exists {
+			return nil, errors.Errorf("cluster secret %s/%s has no %s key", secret.Namespace, secret.Name, ClusterSecretKubeConfigKey)
+		}
+		name := secret.Labels[ClusterSecretLabel]
+		if name == "" {
+			name = secret.Name
+		}
+		configs = append(configs, ClusterConfig{
+			Name:        name,
+			MergePolicy: MergePolicy(secret.Annotations[ClusterSecretMergePolicyAnnotation]),
+			KubeConfig:  kubeConfig,
+		})
+	}
+	return configs, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestClusterSecret(namespace, name string, labels, annotations map[string]string, data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Data: data,
+	}
+}
+
+func TestClusterConfigsFromSecrets(t *testing.T) {
+	kubeConfig := []byte("kubeconfig-content")
+	tests := []struct {
+		name    string
+		secrets []client.Object
+		want    []ClusterConfig
+		wantErr bool
+	}{
+		{
+			name: "no cluster secrets",
+			secrets: []client.Object{
+				newTestClusterSecret("opensergo-system", "other", nil, nil,
+					map[string][]byte{ClusterSecretKubeConfigKey: kubeConfig}),
+			},
+			want: []ClusterConfig{},
+		},
+		{
+			name: "cluster name from label and merge policy from annotation",
+			secrets: []client.Object{
+				newTestClusterSecret("opensergo-system", "secret-a",
+					map[string]string{ClusterSecretLabel: "cluster-a"},
+					map[string]string{ClusterSecretMergePolicyAnnotation: string(MergePolicyPrimaryWins)},
+					map[string][]byte{ClusterSecretKubeConfigKey: kubeConfig}),
+			},
+			want: []ClusterConfig{
+				{Name: "cluster-a", MergePolicy: MergePolicyPrimaryWins, KubeConfig: kubeConfig},
+			},
+		},
+		{
+			name: "cluster name falls back to the secret name",
+			secrets: []client.Object{
+				newTestClusterSecret("opensergo-system", "secret-b",
+					map[string]string{ClusterSecretLabel: ""}, nil,
+					map[string][]byte{ClusterSecretKubeConfigKey: kubeConfig}),
+			},
+			want: []ClusterConfig{
+				{Name: "secret-b", KubeConfig: kubeConfig},
+			},
+		},
+		{
+			name: "secrets in other namespaces are ignored",
+			secrets: []client.Object{
+				newTestClusterSecret("other", "secret-a",
+					map[string]string{ClusterSecretLabel: "cluster-a"}, nil,
+					map[string][]byte{ClusterSecretKubeConfigKey: kubeConfig}),
+			},
+			want: []ClusterConfig{},
+		},
+		{
+			name: "missing kubeconfig",
+			secrets: []client.Object{
+				newTestClusterSecret("opensergo-system", "secret-a",
+					map[string]string{ClusterSecretLabel: "cluster-a"}, nil,
+					map[string][]byte{"config": kubeConfig}),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.secrets...).Build()
+			got, err := ClusterConfigsFromSecrets(context.Background(), reader, "opensergo-system")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ClusterConfigsFromSecrets() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ClusterConfigsFromSecrets() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8sApiError "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// ConfigMapRuleLabel is the label that marks a ConfigMap as a holder of OpenSergo manifests.
+	ConfigMapRuleLabel = "opensergo.io/rules"
+	// DefaultConfigMapSelector is the default label selector of the ConfigMaps of OpenSergo manifests.
+	DefaultConfigMapSelector = ConfigMapRuleLabel + "=true"
+)
+
+// configMapReconciler decodes the OpenSergo objects in the labeled ConfigMaps of the primary cluster.
+// Each data key of a ConfigMap holds one or more manifests, which are the same manifests applied to
+// Kubernetes, and the objects are cached and pushed in the same way as CRDs.
+type configMapReconciler struct {
+	operator *KubernetesOperator
+	reader   client.Reader
+}
+
+func (c *configMapReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := setupLog.WithValues("configMapNamespace", req.Namespace, "configMapName", req.Name)
+	configMap := &corev1.ConfigMap{}
+	if err := c.reader.Get(ctx, req.NamespacedName, configMap); err != nil {
+		if !k8sApiError.IsNotFound(err) {
+			logger.Error(err, "Failed to get the ConfigMap of OpenSergo rules")
+			return ctrl.Result{}, nil
+		}
+		// The ConfigMap has been deleted, or the label no longer matches.
+		c.operator.setConfigMapObjects(req.NamespacedName, nil)
+		return ctrl.Result{}, nil
+	}
+
+	objs, err := decodeConfigMap(configMap)
+	if err != nil {
+		logger.Error(err, "Failed to decode the ConfigMap of OpenSergo rules, the objects previously decoded from it are kept")
+		if primary := c.operator.clusters[0]; primary.recorder != nil && c.operator.IsLeader() {
+			primary.recorder.Event(configMap, corev1.EventTypeWarning, EventReasonInvalidRule, err.Error())
+		}
+		return ctrl.Result{}, nil
+	}
+	logger.Info("OpenSergo rules in ConfigMap received", "objects", len(objs))
+	c.operator.setConfigMapObjects(req.NamespacedName, objs)
+	return ctrl.Result{}, nil
+}
+
+// decodeConfigMap decodes the OpenSergo objects in all data keys of the ConfigMap in the order of keys.
+// The objects without namespace belong to the namespace of the ConfigMap, and the objects of other
+// namespaces are rejected.
+func decodeConfigMap(configMap *corev1.ConfigMap) ([]client.Object, error) {
+	keys := make([]string, 0, len(configMap.Data))
+	for key := range configMap.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var objs []client.Object
+	names := make(map[string]bool)
+	for _, key := range keys {
+		decoded, err := DecodeManifests(strings.NewReader(configMap.Data[key]))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid manifests in key %s", key)
+		}
+		for _, obj := range decoded {
+			if obj.GetNamespace() == "" {
+				obj.SetNamespace(configMap.Namespace)
+			} else if obj.GetNamespace() != configMap.Namespace {
+				return nil, errors.Errorf("the namespace %s of %s in key %s does not match the ConfigMap",
+					obj.GetNamespace(), obj.GetName(), key)
+			}
+			name := KindOf(obj) + "/" + obj.GetName()
+			if names[name] {
+				return nil, errors.Errorf("duplicate object %s in key %s", name, key)
+			}
+			names[name] = true
+			objs = append(objs, obj)
+		}
+	}
+	return objs, nil
+}
+
+// setupConfigMapWatch watches the ConfigMaps matching the selector in the given namespaces of the primary cluster.
+// The ConfigMaps are listed and watched in the given namespaces only, so that no cluster-wide permission is required.
+func (k *KubernetesOperator) setupConfigMapWatch(namespaces []string, selector string) error {
+	if selector == "" {
+		selector = DefaultConfigMapSelector
+	}
+	labelSelector, err := labels.Parse(selector)
+	if err != nil {
+		return errors.Wrapf(err, "invalid ConfigMap selector %s", selector)
+	}
+	mgr := k.clusters[0].manager
+	configMapCache, err := cache.MultiNamespacedCacheBuilder(namespaces)(mgr.GetConfig(), cache.Options{
+		Scheme: mgr.GetScheme(),
+		Mapper: mgr.GetRESTMapper(),
+		SelectorsByObject: cache.SelectorsByObject{
+			&corev1.ConfigMap{}: {Label: labelSelector},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if err = mgr.Add(&nonLeaderElectionRunnable{Runnable: configMapCache}); err != nil {
+		return err
+	}
+
+	c, err := controller.NewUnmanaged("opensergo-configmap", mgr, controller.Options{
+		Reconciler: &configMapReconciler{
+			operator: k,
+			reader:   configMapCache,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	err = c.Watch(source.NewKindWithCache(&corev1.ConfigMap{}, configMapCache), &revisionRecordingHandler{revision: k.configMapRevision})
+	if err != nil {
+		return err
+	}
+	return mgr.Add(&nonLeaderElectionController{Controller: c})
+}
+
+// setConfigMapObjects replaces the objects decoded from the ConfigMap, and pushes the rules of the
+// (namespace, app)s which have changed. Empty objects mean the ConfigMap has been removed.
+func (k *KubernetesOperator) setConfigMapObjects(configMap types.NamespacedName, objs []client.Object) {
+	type change struct {
+		watcher *CRDWatcher
+		n       model.NamespacedApp
+	}
+	var changes []change
+
+	k.controllerMux.Lock()
+	if len(objs) > 0 {
+		k.configMapObjects[configMap] = objs
+	} else {
+		delete(k.configMapObjects, configMap)
+	}
+	for kind, watcher := range k.controllers {
+		for _, n := range watcher.setConfigMapObjects(k.configMapObjectsOfKind(kind)) {
+			changes = append(changes, change{watcher: watcher, n: n})
+		}
+	}
+	k.controllerMux.Unlock()
+
+	for _, c := range changes {
+		if !c.watcher.HasAnySubscribedOfApp(c.n) {
+			continue
+		}
+		if err := c.watcher.pushRules(c.n); err != nil {
+			setupLog.Error(err, "Failed to send rules", "kind", c.watcher.kind, "namespace", c.n.Namespace, "app", c.n.App)
+		}
+	}
+}
+
+// configMapObjectsOfKind returns the objects of the kind decoded from all ConfigMaps, in the order of
+// the namespaces and names of the ConfigMaps. The lock must be held.
+func (k *KubernetesOperator) configMapObjectsOfKind(kind CRDKind) []client.Object {
+	configMaps := make([]types.NamespacedName, 0, len(k.configMapObjects))
+	for configMap := range k.configMapObjects {
+		configMaps = append(configMaps, configMap)
+	}
+	sort.Slice(configMaps, func(i, j int) bool {
+		return configMaps[i].String() < configMaps[j].String()
+	})
+
+	var objs []client.Object
+	for _, configMap := range configMaps {
+		for _, obj := range k.configMapObjects[configMap] {
+			if KindOf(obj) == kind {
+				objs = append(objs, obj)
+			}
+		}
+	}
+	return objs
+}
+
+// nonLeaderElectionRunnable wraps a runnable that runs regardless of leader election.
+type nonLeaderElectionRunnable struct {
+	manager.Runnable
+}
+
+func (r *nonLeaderElectionRunnable) NeedLeaderElection() bool {
+	return false
+}
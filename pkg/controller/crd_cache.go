@@ -61,6 +61,18 @@ func (c *CRDCache) SetByNamespacedName(n types.NamespacedName, object client.Obj
 	c.crdEntityMap[n] = object
 }
 
+// objects returns a snapshot of all cached objects: (namespace, name) -> CRD.
+func (c *CRDCache) objects() map[types.NamespacedName]client.Object {
+	c.updateMux.RLock()
+	defer c.updateMux.RUnlock()
+
+	objs := make(map[types.NamespacedName]client.Object, len(c.crdEntityMap))
+	for n, obj := range c.crdEntityMap {
+		objs[n] = obj
+	}
+	return objs
+}
+
 func (c *CRDCache) DeleteByNamespacedName(n types.NamespacedName) {
 	c.updateMux.Lock()
 	defer c.updateMux.Unlock()
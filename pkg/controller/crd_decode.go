@@ -0,0 +1,99 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var manifestDecoder = serializer.NewCodecFactory(scheme).UniversalDeserializer()
+
+// DecodeObject decodes an OpenSergo object of the registered kinds from a YAML or JSON manifest,
+// which is the same manifest applied to Kubernetes. The defaults of the object are filled in,
+// as the defaulting webhook does in Kubernetes.
+func DecodeObject(data []byte) (client.Object, error) {
+	runtimeObj, gvk, err := manifestDecoder.Decode(data, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	kind := gvk.Group + "/" + gvk.Version + "/" + gvk.Kind
+	crdMetadata, supported := GetCrdMetadata(kind)
+	if !supported {
+		return nil, errors.New("CRD not supported: " + kind)
+	}
+	obj, ok := runtimeObj.(client.Object)
+	if !ok {
+		return nil, errors.Errorf("unexpected object type: %T", runtimeObj)
+	}
+	obj.GetObjectKind().SetGroupVersionKind(*gvk)
+	if obj.GetName() == "" {
+		return nil, errors.Errorf("the name of %s is empty", gvk.Kind)
+	}
+	if crdMetadata.Defaulter() != nil {
+		crdMetadata.Defaulter().Default(obj)
+	}
+	return obj, nil
+}
+
+// KindOf returns the kind of the decoded object in the form of group/version/Kind.
+func KindOf(obj runtime.Object) CRDKind {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	return gvk.Group + "/" + gvk.Version + "/" + gvk.Kind
+}
+
+// DecodeManifests decodes the OpenSergo objects in the manifests, which may consist of multiple YAML documents.
+// The documents which consist of only blank lines and comments are skipped.
+func DecodeManifests(r io.Reader) ([]client.Object, error) {
+	var objs []client.Object
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	for index := 0; ; index++ {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read document %d", index)
+		}
+		if isEmptyDocument(doc) {
+			continue
+		}
+		obj, err := DecodeObject(doc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode document %d", index)
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// isEmptyDocument checks whether the document consists of only blank lines and comments.
+// The document at the beginning of the manifest keeps the leading separator, which is not content either.
+func isEmptyDocument(doc []byte) bool {
+	for _, line := range bytes.Split(doc, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 && line[0] != '#' && !bytes.Equal(line, []byte("---")) {
+			return false
+		}
+	}
+	return true
+}
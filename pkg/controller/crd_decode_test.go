@@ -0,0 +1,44 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+)
+
+// The file, etcd and git sources decode objects with DecodeObject, so the objects are defaulted
+// as the defaulting webhook does before they are translated.
+func TestDecodeObjectDefaults(t *testing.T) {
+	obj, err := DecodeObject([]byte(`apiVersion: fault-tolerance.opensergo.io/v1alpha1
+kind: TimeoutStrategy
+metadata:
+  name: timeout-a
+  labels:
+    app: foo-app
+spec:
+  timeout: 1000ms
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if KindOf(obj) != TimeoutStrategyKind {
+		t.Errorf("kind = %s, want %s", KindOf(obj), TimeoutStrategyKind)
+	}
+	if got := obj.(*crdv1alpha1.TimeoutStrategy).Spec.Timeout; got != "1s" {
+		t.Errorf("timeout = %q, want the defaulted 1s", got)
+	}
+}
@@ -0,0 +1,405 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8sApiError "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// StrategyDeletionPolicy decides what happens when a strategy referenced by FaultToleranceRules is deleted.
+type StrategyDeletionPolicy string
+
+const (
+	// StrategyDeletionPolicyWarn allows the deletion, and the dependent rules report the dangling references.
+	StrategyDeletionPolicyWarn StrategyDeletionPolicy = "Warn"
+	// StrategyDeletionPolicyBlock blocks the deletion with a finalizer until no rule references the strategy.
+	StrategyDeletionPolicyBlock StrategyDeletionPolicy = "Block"
+)
+
+// StrategyProtectionFinalizer is the finalizer added to the strategies referenced by FaultToleranceRules
+// when the deletion policy is StrategyDeletionPolicyBlock.
+const StrategyProtectionFinalizer = "opensergo.io/strategy-protection"
+
+// objectRef refers to a CRD object in a cluster.
+type objectRef struct {
+	cluster   string
+	namespace string
+	kind      CRDKind
+	name      string
+}
+
+// DependencyGraph records the strategies referenced by each FaultToleranceRule, so that the rules
+// can be re-evaluated when the strategies change.
+type DependencyGraph struct {
+	// dependencies represents a map: rule -> referenced strategies
+	dependencies map[objectRef][]objectRef
+	// dependents represents a map: strategy -> rules which reference the strategy
+	dependents map[objectRef]map[objectRef]bool
+
+	updateMux sync.RWMutex
+}
+
+func NewDependencyGraph() *DependencyGraph {
+	return &DependencyGraph{
+		dependencies: make(map[objectRef][]objectRef),
+		dependents:   make(map[objectRef]map[objectRef]bool),
+	}
+}
+
+// SetDependencies replaces the referenced strategies of the rule.
+func (g *DependencyGraph) SetDependencies(rule objectRef, strategies []objectRef) {
+	g.updateMux.Lock()
+	defer g.updateMux.Unlock()
+
+	g.removeInternal(rule)
+	if len(strategies) == 0 {
+		return
+	}
+	g.dependencies[rule] = strategies
+	for _, strategy := range strategies {
+		if g.dependents[strategy] == nil {
+			g.dependents[strategy] = make(map[objectRef]bool)
+		}
+		g.dependents[strategy][rule] = true
+	}
+}
+
+// RemoveDependencies removes the rule from the graph, e.g. when the rule is deleted.
+func (g *DependencyGraph) RemoveDependencies(rule objectRef) {
+	g.updateMux.Lock()
+	defer g.updateMux.Unlock()
+
+	g.removeInternal(rule)
+}
+
+func (g *DependencyGraph) removeInternal(rule objectRef) {
+	// Guarded in the outer function
+	for _, strategy := range g.dependencies[rule] {
+		delete(g.dependents[strategy], rule)
+		if len(g.dependents[strategy]) == 0 {
+			delete(g.dependents, strategy)
+		}
+	}
+	delete(g.dependencies, rule)
+}
+
+// Dependents returns the rules which reference the strategy.
+func (g *DependencyGraph) Dependents(strategy objectRef) []objectRef {
+	g.updateMux.RLock()
+	defer g.updateMux.RUnlock()
+
+	rules := make([]objectRef, 0, len(g.dependents[strategy]))
+	for rule := range g.dependents[strategy] {
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// Dependencies returns the strategies referenced by the rule.
+func (g *DependencyGraph) Dependencies(rule objectRef) []objectRef {
+	g.updateMux.RLock()
+	defer g.updateMux.RUnlock()
+
+	return append([]objectRef(nil), g.dependencies[rule]...)
+}
+
+// resolveStrategyKind resolves the kind of a strategy reference, which is either a short kind
+// (e.g. RateLimitStrategy) in the fault-tolerance group or a full kind, to a registered kind.
+func resolveStrategyKind(kind string) (CRDKind, bool) {
+	fullKind := kind
+	if !strings.Contains(kind, "/") {
+		gvk := crdv1alpha1.GroupVersion.WithKind(kind)
+		fullKind = gvk.Group + "/" + gvk.Version + "/" + gvk.Kind
+	}
+	_, exists := GetCrdMetadata(fullKind)
+	return fullKind, exists
+}
+
+// strategyKinds returns all registered kinds of strategies which can be referenced by FaultToleranceRules.
+func strategyKinds() []CRDKind {
+	var kinds []CRDKind
+	for _, kind := range RegisteredKinds() {
+		if isStrategyKind(kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// isStrategyKind checks whether the kind is registered as a strategy which can be referenced by FaultToleranceRules.
+func isStrategyKind(kind CRDKind) bool {
+	crdMetadata, exists := GetCrdMetadata(kind)
+	return exists && crdMetadata.IsStrategy()
+}
+
+// isActionKind checks whether the kind is registered as an action which can be referenced by FaultToleranceRules.
+func isActionKind(kind CRDKind) bool {
+	crdMetadata, exists := GetCrdMetadata(kind)
+	return exists && crdMetadata.IsAction()
+}
+
+// actionKinds returns all registered kinds of actions which can be referenced by FaultToleranceRules.
+func actionKinds() []CRDKind {
+	var kinds []CRDKind
+	for _, kind := range RegisteredKinds() {
+		if isActionKind(kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// referencedKinds returns all kinds which can be referenced by FaultToleranceRules, i.e. the strategies and the actions.
+func referencedKinds() []CRDKind {
+	return append(strategyKinds(), actionKinds()...)
+}
+
+func shortKind(kind CRDKind) string {
+	return kind[strings.LastIndex(kind, "/")+1:]
+}
+
+// referencesOf returns the strategies and the action referenced by the FaultToleranceRule.
+func referencesOf(cluster string, rule *crdv1alpha1.FaultToleranceRule) []objectRef {
+	refs := make([]objectRef, 0, len(rule.Spec.Strategies)+1)
+	for _, strategy := range rule.Spec.Strategies {
+		kind, _ := resolveStrategyKind(strategy.Kind)
+		refs = append(refs, objectRef{
+			cluster:   cluster,
+			namespace: rule.Namespace,
+			kind:      kind,
+			name:      strategy.Name,
+		})
+	}
+	if action := rule.Spec.Action; action != nil {
+		kind, _ := resolveStrategyKind(action.Kind)
+		refs = append(refs, objectRef{
+			cluster:   cluster,
+			namespace: rule.Namespace,
+			kind:      kind,
+			name:      action.Name,
+		})
+	}
+	return refs
+}
+
+// updateDependencies updates the dependency graph with the latest FaultToleranceRule.
+func (r *CRDWatcher) updateDependencies(ctx context.Context, cluster *Cluster, reader client.Reader, req ctrl.Request) {
+	ruleRef := objectRef{cluster: cluster.name, namespace: req.Namespace, kind: r.kind, name: req.Name}
+	rule := &crdv1alpha1.FaultToleranceRule{}
+	if err := reader.Get(ctx, req.NamespacedName, rule); err != nil {
+		if k8sApiError.IsNotFound(err) {
+			r.dependencies.RemoveDependencies(ruleRef)
+		}
+		return
+	}
+	r.dependencies.SetDependencies(ruleRef, referencesOf(cluster.name, rule))
+}
+
+// dependentRulesOf maps a strategy to the requests of the FaultToleranceRules which reference it.
+func (r *CRDWatcher) dependentRulesOf(cluster *Cluster, kind CRDKind) handler.MapFunc {
+	return func(object client.Object) []reconcile.Request {
+		rules := r.dependencies.Dependents(objectRef{
+			cluster:   cluster.name,
+			namespace: object.GetNamespace(),
+			kind:      kind,
+			name:      object.GetName(),
+		})
+		requests := make([]reconcile.Request, 0, len(rules))
+		for _, rule := range rules {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: rule.namespace, Name: rule.name}})
+		}
+		return requests
+	}
+}
+
+// watchStrategies makes the controller of FaultToleranceRules watch all kinds of strategies and actions,
+// so that the dependent rules are re-pushed when a strategy or an action changes.
+func (r *CRDWatcher) watchStrategies(c controller.Controller, cluster *Cluster) error {
+	for _, kind := range referencedKinds() {
+		crdMetadata, _ := GetCrdMetadata(kind)
+		err := c.Watch(&source.Kind{Type: crdMetadata.Generator()()}, handler.EnqueueRequestsFromMapFunc(r.dependentRulesOf(cluster, kind)),
+			predicate.GenerationChangedPredicate{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// danglingReferences returns the strategy and action references of the FaultToleranceRule which cannot be resolved.
+func (r *CRDWatcher) danglingReferences(ctx context.Context, cluster *Cluster, object client.Object) ([]string, error) {
+	rule, ok := object.(*crdv1alpha1.FaultToleranceRule)
+	if !ok {
+		return nil, nil
+	}
+	refs := append([]crdv1alpha1.FaultToleranceStrategyRef(nil), rule.Spec.Strategies...)
+	if action := rule.Spec.Action; action != nil {
+		refs = append(refs, crdv1alpha1.FaultToleranceStrategyRef{Name: action.Name, Kind: action.Kind})
+	}
+	var dangling []string
+	for _, ref := range refs {
+		kind, exists := resolveStrategyKind(ref.Kind)
+		if !exists {
+			dangling = append(dangling, fmt.Sprintf("%s/%s (unknown kind)", ref.Kind, ref.Name))
+			continue
+		}
+		crdMetadata, _ := GetCrdMetadata(kind)
+		obj := crdMetadata.Generator()()
+		err := cluster.manager.GetClient().Get(ctx, types.NamespacedName{Namespace: rule.Namespace, Name: ref.Name}, obj)
+		if err != nil {
+			if !k8sApiError.IsNotFound(err) {
+				return nil, err
+			}
+			dangling = append(dangling, fmt.Sprintf("%s/%s (not found)", ref.Kind, ref.Name))
+			continue
+		}
+		if obj.GetDeletionTimestamp() != nil {
+			dangling = append(dangling, fmt.Sprintf("%s/%s (being deleted)", ref.Kind, ref.Name))
+		}
+	}
+	return dangling, nil
+}
+
+// strategyProtectionReconciler keeps the StrategyProtectionFinalizer on the strategies of a cluster
+// which are referenced by FaultToleranceRules. It is run by the leader only.
+type strategyProtectionReconciler struct {
+	cluster   *Cluster
+	kind      CRDKind
+	generator CRDGenerator
+}
+
+func (p *strategyProtectionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	c := p.cluster.manager.GetClient()
+	strategy := p.generator()
+	if err := c.Get(ctx, req.NamespacedName, strategy); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	dependents, err := p.dependentRules(ctx, strategy)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	base := strategy.DeepCopyObject().(client.Object)
+	if strategy.GetDeletionTimestamp() == nil {
+		if len(dependents) > 0 {
+			controllerutil.AddFinalizer(strategy, StrategyProtectionFinalizer)
+		} else {
+			controllerutil.RemoveFinalizer(strategy, StrategyProtectionFinalizer)
+		}
+	} else if controllerutil.ContainsFinalizer(strategy, StrategyProtectionFinalizer) {
+		if len(dependents) > 0 {
+			if p.cluster.recorder != nil {
+				p.cluster.recorder.Eventf(strategy, corev1.EventTypeWarning, EventReasonDeletionBlocked,
+					"the strategy is referenced by FaultToleranceRule %s", strings.Join(dependents, ", "))
+			}
+			return ctrl.Result{}, nil
+		}
+		controllerutil.RemoveFinalizer(strategy, StrategyProtectionFinalizer)
+	}
+	if len(base.GetFinalizers()) == len(strategy.GetFinalizers()) {
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{}, client.IgnoreNotFound(c.Patch(ctx, strategy, client.MergeFrom(base)))
+}
+
+// dependentRules lists the names of the FaultToleranceRules which reference the strategy.
+// The rules are listed from the cluster rather than the dependency graph, as the graph
+// only consists of the rules which have been reconciled.
+func (p *strategyProtectionReconciler) dependentRules(ctx context.Context, strategy client.Object) ([]string, error) {
+	rules := &crdv1alpha1.FaultToleranceRuleList{}
+	if err := p.cluster.manager.GetClient().List(ctx, rules, client.InNamespace(strategy.GetNamespace())); err != nil {
+		return nil, err
+	}
+	var dependents []string
+	for i := range rules.Items {
+		rule := &rules.Items[i]
+		if rule.DeletionTimestamp != nil {
+			continue
+		}
+		for _, ref := range referencesOf(p.cluster.name, rule) {
+			if ref.kind == p.kind && ref.name == strategy.GetName() {
+				dependents = append(dependents, rule.Name)
+				break
+			}
+		}
+	}
+	return dependents, nil
+}
+
+// referencedStrategiesOf maps a FaultToleranceRule to the requests of the strategies of the kind it references.
+func (p *strategyProtectionReconciler) referencedStrategiesOf(object client.Object) []reconcile.Request {
+	rule, ok := object.(*crdv1alpha1.FaultToleranceRule)
+	if !ok {
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, ref := range referencesOf(p.cluster.name, rule) {
+		if ref.kind == p.kind {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: ref.namespace, Name: ref.name}})
+		}
+	}
+	return requests
+}
+
+// setupStrategyProtection registers the strategy protection controllers of all clusters to the manager
+// of the primary cluster, so that the finalizers are only maintained by the leader. The actions referenced
+// by FaultToleranceRules are protected in the same way as the strategies.
+func setupStrategyProtection(clusters []*Cluster) error {
+	primary := clusters[0].manager
+	for _, cluster := range clusters {
+		for _, kind := range referencedKinds() {
+			crdMetadata, _ := GetCrdMetadata(kind)
+			p := &strategyProtectionReconciler{
+				cluster:   cluster,
+				kind:      kind,
+				generator: crdMetadata.Generator(),
+			}
+			name := strings.ToLower(shortKind(kind)) + "-protection-" + cluster.name
+			c, err := controller.New(name, primary, controller.Options{Reconciler: p})
+			if err != nil {
+				return errors.Wrapf(err, "failed to create the protection controller of %s", kind)
+			}
+			cache := cluster.manager.GetCache()
+			if err = c.Watch(source.NewKindWithCache(crdMetadata.Generator()(), cache), &handler.EnqueueRequestForObject{}); err != nil {
+				return err
+			}
+			err = c.Watch(source.NewKindWithCache(&crdv1alpha1.FaultToleranceRule{}, cache), handler.EnqueueRequestsFromMapFunc(p.referencedStrategiesOf))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testCustomStrategyKind = "example.opensergo.io/v1alpha1/CustomStrategy"
+
+func init() {
+	// A strategy of another group, as registered by a downstream project.
+	if err := RegisterKind(KindPlugin{
+		Kind:          testCustomStrategyKind,
+		Generator:     func() client.Object { return nil },
+		ListGenerator: func() client.ObjectList { return nil },
+		Translator:    &rateLimitStrategyTranslator{},
+		Strategy:      true,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+func TestStrategyAndActionKinds(t *testing.T) {
+	tests := []struct {
+		kind     CRDKind
+		strategy bool
+		action   bool
+	}{
+		{kind: FaultToleranceRuleKind},
+		{kind: RateLimitStrategyKind, strategy: true},
+		{kind: ThrottlingStrategyKind, strategy: true},
+		{kind: ConcurrencyLimitStrategyKind, strategy: true},
+		{kind: CircuitBreakerStrategyKind, strategy: true},
+		{kind: ParamFlowStrategyKind, strategy: true},
+		{kind: SystemAdaptiveStrategyKind},
+		{kind: RetryStrategyKind, strategy: true},
+		{kind: TimeoutStrategyKind, strategy: true},
+		{kind: FallbackActionKind, action: true},
+		{kind: TrafficRouterKind},
+		{kind: testCustomStrategyKind, strategy: true},
+		{kind: "fault-tolerance.opensergo.io/v1alpha1/UnknownStrategy"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			if got := isStrategyKind(tt.kind); got != tt.strategy {
+				t.Errorf("isStrategyKind() = %v, want %v", got, tt.strategy)
+			}
+			if got := isActionKind(tt.kind); got != tt.action {
+				t.Errorf("isActionKind() = %v, want %v", got, tt.action)
+			}
+		})
+	}
+
+	kinds := make(map[CRDKind]bool)
+	for _, kind := range referencedKinds() {
+		kinds[kind] = true
+	}
+	for _, tt := range tests {
+		if kinds[tt.kind] != (tt.strategy || tt.action) {
+			t.Errorf("referencedKinds() contains %s: %v, want %v", tt.kind, kinds[tt.kind], tt.strategy || tt.action)
+		}
+	}
+}
+
+func TestRegisterKindRejectsStrategyAction(t *testing.T) {
+	err := RegisterKind(KindPlugin{
+		Kind:          "example.opensergo.io/v1alpha1/Invalid",
+		Generator:     func() client.Object { return nil },
+		ListGenerator: func() client.ObjectList { return nil },
+		Translator:    &rateLimitStrategyTranslator{},
+		Strategy:      true,
+		Action:        true,
+	})
+	if err == nil {
+		t.Error("RegisterKind() succeeded for a kind which is both a strategy and an action")
+	}
+}
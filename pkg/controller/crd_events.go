@@ -0,0 +1,168 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EventRecorderName is the name of the event source of the OpenSergo control plane.
+const EventRecorderName = "opensergo-control-plane"
+
+const (
+	EventReasonInvalidRule       = "InvalidRule"
+	EventReasonTranslationFailed = "TranslationFailed"
+	EventReasonRuleRejected      = "RuleRejected"
+	EventReasonRuleDelivered     = "RuleDelivered"
+	EventReasonDanglingReference = "DanglingReference"
+	EventReasonDeletionBlocked   = "DeletionBlocked"
+	EventReasonTargetsOverlapped = "TargetsOverlapped"
+)
+
+// DefaultNackEventInterval is the minimum interval of the NACK events of the same object.
+const DefaultNackEventInterval = time.Minute
+
+// eventRateLimiter limits the events of each object to at most one event per interval.
+type eventRateLimiter struct {
+	interval time.Duration
+	// lastEventTime represents a map: object UID -> the time of the last event
+	lastEventTime map[types.UID]time.Time
+
+	mux sync.Mutex
+}
+
+func newEventRateLimiter(interval time.Duration) *eventRateLimiter {
+	return &eventRateLimiter{
+		interval:      interval,
+		lastEventTime: make(map[types.UID]time.Time),
+	}
+}
+
+func (l *eventRateLimiter) Allow(uid types.UID) bool {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	now := time.Now()
+	if last, exists := l.lastEventTime[uid]; exists && now.Sub(last) < l.interval {
+		return false
+	}
+	l.lastEventTime[uid] = now
+	return true
+}
+
+func (l *eventRateLimiter) Forget(uid types.UID) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	delete(l.lastEventTime, uid)
+}
+
+// recordEvents records the Kubernetes events of the object according to the change of its status.
+// It is only called by the leader, together with the status update.
+func (r *CRDWatcher) recordEvents(cluster *Cluster, obj client.Object, prev, status *crdv1alpha1.RuleStatus,
+	validationErrs field.ErrorList, translateErr error, delivery model.DeliveryStatus) {
+	recorder := cluster.recorder
+	if recorder == nil {
+		return
+	}
+	// Translation problems are reported once per generation.
+	if prev.ObservedGeneration != status.ObservedGeneration {
+		if len(validationErrs) > 0 {
+			recorder.Event(obj, corev1.EventTypeWarning, EventReasonInvalidRule, validationErrs.ToAggregate().Error())
+		}
+		if translateErr != nil {
+			recorder.Event(obj, corev1.EventTypeWarning, EventReasonTranslationFailed, translateErr.Error())
+		}
+	}
+
+	// Dangling references are reported when they are found.
+	if resolved := meta.FindStatusCondition(status.Conditions, crdv1alpha1.RuleConditionResolvedRefs); resolved != nil &&
+		resolved.Status == metav1.ConditionFalse && !meta.IsStatusConditionFalse(prev.Conditions, crdv1alpha1.RuleConditionResolvedRefs) {
+		recorder.Event(obj, corev1.EventTypeWarning, EventReasonDanglingReference, resolved.Message)
+	}
+
+	// Overlapped targets are reported when they are found or change.
+	if overlapped := meta.FindStatusCondition(status.Conditions, crdv1alpha1.RuleConditionOverlapped); overlapped != nil &&
+		overlapped.Status == metav1.ConditionTrue {
+		if prevOverlapped := meta.FindStatusCondition(prev.Conditions, crdv1alpha1.RuleConditionOverlapped); prevOverlapped == nil ||
+			prevOverlapped.Status != metav1.ConditionTrue || prevOverlapped.Message != overlapped.Message {
+			recorder.Event(obj, corev1.EventTypeWarning, EventReasonTargetsOverlapped, overlapped.Message)
+		}
+	}
+
+	// NACKs of all instances are aggregated into a single event, which is rate-limited per object.
+	if delivery.NackedInstances > 0 {
+		if r.nackEventLimiter.Allow(obj.GetUID()) {
+			recorder.Eventf(obj, corev1.EventTypeWarning, EventReasonRuleRejected,
+				"%d of %d connected instances rejected version %d of the rule: %s",
+				delivery.NackedInstances, delivery.ConnectedInstances, delivery.Version, delivery.LastNackMessage)
+		}
+	} else {
+		r.nackEventLimiter.Forget(obj.GetUID())
+	}
+
+	// The first successful delivery of each generation is recorded.
+	if meta.IsStatusConditionTrue(status.Conditions, crdv1alpha1.RuleConditionDelivered) &&
+		r.deliveredGenerations.markDelivered(obj.GetUID(), status.ObservedGeneration) {
+		recorder.Eventf(obj, corev1.EventTypeNormal, EventReasonRuleDelivered,
+			"generation %d of the rule has been ACKed by all %d connected instances", status.ObservedGeneration, delivery.ConnectedInstances)
+	}
+}
+
+// deliveredGenerations records the latest delivered generation of each object.
+type deliveredGenerations struct {
+	generations map[types.UID]int64
+
+	mux sync.Mutex
+}
+
+func newDeliveredGenerations() *deliveredGenerations {
+	return &deliveredGenerations{generations: make(map[types.UID]int64)}
+}
+
+// markDelivered marks the generation of the object as delivered, and returns true if it is the first time.
+func (d *deliveredGenerations) markDelivered(uid types.UID, generation int64) bool {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	if d.generations[uid] == generation {
+		return false
+	}
+	d.generations[uid] = generation
+	return true
+}
+
+func (d *deliveredGenerations) forget(uid types.UID) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	delete(d.generations, uid)
+}
+
+// forgetEvents removes the event states of the object, e.g. when the object has been deleted.
+func (r *CRDWatcher) forgetEvents(uid types.UID) {
+	r.nackEventLimiter.Forget(uid)
+	r.deliveredGenerations.forget(uid)
+}
@@ -15,8 +15,14 @@
 package controller
 
 import (
+	"sync"
+
 	"github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
 	"github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1/traffic"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -25,10 +31,35 @@ type CRDKind = string
 // CRDGenerator represents a generator function of an OpenSergo CRD.
 type CRDGenerator = func() client.Object
 
+// CRDListGenerator represents a generator function of the list type of an OpenSergo CRD.
+type CRDListGenerator = func() client.ObjectList
+
+// SchemeRegistration registers the Go types of a CRD to the given scheme.
+type SchemeRegistration = func(s *runtime.Scheme) error
+
+// Translator translates an OpenSergo CRD object to the proto message delivered to clients.
+type Translator interface {
+	// Translate converts the CRD object to the proto message.
+	Translate(object client.Object) (proto.Message, error)
+	// Validate checks the CRD object semantically and returns the field-level errors.
+	Validate(object client.Object) field.ErrorList
+}
+
+// Defaulter fills in the defaults of an OpenSergo CRD object and normalizes its fields to the canonical form.
+type Defaulter interface {
+	// Default mutates the CRD object in place. Fields which cannot be normalized are left as-is.
+	Default(object client.Object)
+}
+
 type CRDMetadata struct {
 	kind CRDKind
 
-	generator CRDGenerator
+	generator     CRDGenerator
+	listGenerator CRDListGenerator
+	translator    Translator
+	defaulter     Defaulter
+	strategy      bool
+	action        bool
 }
 
 func (m *CRDMetadata) Kind() CRDKind {
@@ -39,6 +70,29 @@ func (m *CRDMetadata) Generator() CRDGenerator {
 	return m.generator
 }
 
+func (m *CRDMetadata) ListGenerator() CRDListGenerator {
+	return m.listGenerator
+}
+
+func (m *CRDMetadata) Translator() Translator {
+	return m.translator
+}
+
+// Defaulter returns the defaulter of the CRD, which may be nil.
+func (m *CRDMetadata) Defaulter() Defaulter {
+	return m.defaulter
+}
+
+// IsStrategy checks whether the CRD is a strategy which can be referenced by FaultToleranceRules.
+func (m *CRDMetadata) IsStrategy() bool {
+	return m.strategy
+}
+
+// IsAction checks whether the CRD is an action which can be referenced by FaultToleranceRules.
+func (m *CRDMetadata) IsAction() bool {
+	return m.action
+}
+
 func NewCRDMetadata(kind CRDKind, generator CRDGenerator) *CRDMetadata {
 	return &CRDMetadata{
 		kind:      kind,
@@ -46,41 +100,247 @@ func NewCRDMetadata(kind CRDKind, generator CRDGenerator) *CRDMetadata {
 	}
 }
 
+// KindPlugin describes a kind of OpenSergo CRD, which can be registered to the control plane
+// via RegisterKind, so that downstream projects can add their own kinds.
+type KindPlugin struct {
+	// Kind is the unique kind in the form of group/version/Kind, e.g. fault-tolerance.opensergo.io/v1alpha1/RateLimitStrategy.
+	Kind CRDKind
+	// Generator generates an empty object of the CRD.
+	Generator CRDGenerator
+	// ListGenerator generates an empty list of the CRD.
+	ListGenerator CRDListGenerator
+	// AddToScheme registers the Go types of the CRD to the scheme of the operator.
+	AddToScheme SchemeRegistration
+	// Translator translates the CRD to the proto message delivered to clients.
+	Translator Translator
+	// Defaulter fills in the defaults of the CRD, which is optional.
+	// If absent, the Translator is used if it implements Defaulter.
+	Defaulter Defaulter
+	// Strategy marks the kind as a strategy which can be referenced by FaultToleranceRules.
+	Strategy bool
+	// Action marks the kind as an action which can be referenced by FaultToleranceRules.
+	Action bool
+}
+
 const (
 	FaultToleranceRuleKind       = "fault-tolerance.opensergo.io/v1alpha1/FaultToleranceRule"
 	RateLimitStrategyKind        = "fault-tolerance.opensergo.io/v1alpha1/RateLimitStrategy"
 	ThrottlingStrategyKind       = "fault-tolerance.opensergo.io/v1alpha1/ThrottlingStrategy"
 	ConcurrencyLimitStrategyKind = "fault-tolerance.opensergo.io/v1alpha1/ConcurrencyLimitStrategy"
 	CircuitBreakerStrategyKind   = "fault-tolerance.opensergo.io/v1alpha1/CircuitBreakerStrategy"
+	ParamFlowStrategyKind        = "fault-tolerance.opensergo.io/v1alpha1/ParamFlowStrategy"
+	SystemAdaptiveStrategyKind   = "fault-tolerance.opensergo.io/v1alpha1/SystemAdaptiveStrategy"
+	RetryStrategyKind            = "fault-tolerance.opensergo.io/v1alpha1/RetryStrategy"
+	TimeoutStrategyKind          = "fault-tolerance.opensergo.io/v1alpha1/TimeoutStrategy"
+	FallbackActionKind           = "fault-tolerance.opensergo.io/v1alpha1/FallbackAction"
 	TrafficRouterKind            = "traffic.opensergo.io/v1alpha1/TrafficRouter"
 )
 
 var (
 	// crdMetadataMap is the universal registry for all OpenSergo CRDs.
-	crdMetadataMap = map[CRDKind]*CRDMetadata{
-		FaultToleranceRuleKind: NewCRDMetadata(FaultToleranceRuleKind, func() client.Object {
-			return &v1alpha1.FaultToleranceRule{}
-		}),
-		RateLimitStrategyKind: NewCRDMetadata(RateLimitStrategyKind, func() client.Object {
-			return &v1alpha1.RateLimitStrategy{}
-		}),
-		ThrottlingStrategyKind: NewCRDMetadata(ThrottlingStrategyKind, func() client.Object {
-			return &v1alpha1.ThrottlingStrategy{}
-		}),
-		ConcurrencyLimitStrategyKind: NewCRDMetadata(ConcurrencyLimitStrategyKind, func() client.Object {
-			return &v1alpha1.ConcurrencyLimitStrategy{}
-		}),
-		CircuitBreakerStrategyKind: NewCRDMetadata(CircuitBreakerStrategyKind, func() client.Object {
-			return &v1alpha1.CircuitBreakerStrategy{}
-		}),
-		TrafficRouterKind: NewCRDMetadata(TrafficRouterKind, func() client.Object {
-			return &traffic.TrafficRouter{}
-		}),
-	}
+	crdMetadataMap = make(map[CRDKind]*CRDMetadata)
+	crdMetadataMux sync.RWMutex
 )
 
+func init() {
+	builtinKinds := []KindPlugin{
+		{
+			Kind: FaultToleranceRuleKind,
+			Generator: func() client.Object {
+				return &v1alpha1.FaultToleranceRule{}
+			},
+			ListGenerator: func() client.ObjectList {
+				return &v1alpha1.FaultToleranceRuleList{}
+			},
+			AddToScheme: v1alpha1.AddToScheme,
+			Translator:  &faultToleranceRuleTranslator{},
+		},
+		{
+			Kind: RateLimitStrategyKind,
+			Generator: func() client.Object {
+				return &v1alpha1.RateLimitStrategy{}
+			},
+			ListGenerator: func() client.ObjectList {
+				return &v1alpha1.RateLimitStrategyList{}
+			},
+			AddToScheme: v1alpha1.AddToScheme,
+			Translator:  &rateLimitStrategyTranslator{},
+			Strategy:    true,
+		},
+		{
+			Kind: ThrottlingStrategyKind,
+			Generator: func() client.Object {
+				return &v1alpha1.ThrottlingStrategy{}
+			},
+			ListGenerator: func() client.ObjectList {
+				return &v1alpha1.ThrottlingStrategyList{}
+			},
+			AddToScheme: v1alpha1.AddToScheme,
+			Translator:  &throttlingStrategyTranslator{},
+			Strategy:    true,
+		},
+		{
+			Kind: ConcurrencyLimitStrategyKind,
+			Generator: func() client.Object {
+				return &v1alpha1.ConcurrencyLimitStrategy{}
+			},
+			ListGenerator: func() client.ObjectList {
+				return &v1alpha1.ConcurrencyLimitStrategyList{}
+			},
+			AddToScheme: v1alpha1.AddToScheme,
+			Translator:  &concurrencyLimitStrategyTranslator{},
+			Strategy:    true,
+		},
+		{
+			Kind: CircuitBreakerStrategyKind,
+			Generator: func() client.Object {
+				return &v1alpha1.CircuitBreakerStrategy{}
+			},
+			ListGenerator: func() client.ObjectList {
+				return &v1alpha1.CircuitBreakerStrategyList{}
+			},
+			AddToScheme: v1alpha1.AddToScheme,
+			Translator:  &circuitBreakerStrategyTranslator{},
+			Strategy:    true,
+		},
+		{
+			Kind: ParamFlowStrategyKind,
+			Generator: func() client.Object {
+				return &v1alpha1.ParamFlowStrategy{}
+			},
+			ListGenerator: func() client.ObjectList {
+				return &v1alpha1.ParamFlowStrategyList{}
+			},
+			AddToScheme: v1alpha1.AddToScheme,
+			Translator:  &paramFlowStrategyTranslator{},
+			Strategy:    true,
+		},
+		{
+			Kind: SystemAdaptiveStrategyKind,
+			Generator: func() client.Object {
+				return &v1alpha1.SystemAdaptiveStrategy{}
+			},
+			ListGenerator: func() client.ObjectList {
+				return &v1alpha1.SystemAdaptiveStrategyList{}
+			},
+			AddToScheme: v1alpha1.AddToScheme,
+			Translator:  &systemAdaptiveStrategyTranslator{},
+		},
+		{
+			Kind: RetryStrategyKind,
+			Generator: func() client.Object {
+				return &v1alpha1.RetryStrategy{}
+			},
+			ListGenerator: func() client.ObjectList {
+				return &v1alpha1.RetryStrategyList{}
+			},
+			AddToScheme: v1alpha1.AddToScheme,
+			Translator:  &retryStrategyTranslator{},
+			Strategy:    true,
+		},
+		{
+			Kind: TimeoutStrategyKind,
+			Generator: func() client.Object {
+				return &v1alpha1.TimeoutStrategy{}
+			},
+			ListGenerator: func() client.ObjectList {
+				return &v1alpha1.TimeoutStrategyList{}
+			},
+			AddToScheme: v1alpha1.AddToScheme,
+			Translator:  &timeoutStrategyTranslator{},
+			Strategy:    true,
+		},
+		{
+			Kind: FallbackActionKind,
+			Generator: func() client.Object {
+				return &v1alpha1.FallbackAction{}
+			},
+			ListGenerator: func() client.ObjectList {
+				return &v1alpha1.FallbackActionList{}
+			},
+			AddToScheme: v1alpha1.AddToScheme,
+			Translator:  &fallbackActionTranslator{},
+			Action:      true,
+		},
+		{
+			Kind: TrafficRouterKind,
+			Generator: func() client.Object {
+				return &traffic.TrafficRouter{}
+			},
+			ListGenerator: func() client.ObjectList {
+				return &traffic.TrafficRouterList{}
+			},
+			AddToScheme: traffic.AddToScheme,
+			Translator:  &trafficRouterTranslator{},
+		},
+	}
+	for _, plugin := range builtinKinds {
+		if err := RegisterKind(plugin); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterKind registers a kind of OpenSergo CRD to the universal registry.
+// The Go types of the CRD are registered to the scheme of the operator as well,
+// so the kind should be registered before the operator is created.
+func RegisterKind(plugin KindPlugin) error {
+	if plugin.Kind == "" {
+		return errors.New("empty kind of CRD")
+	}
+	if plugin.Generator == nil || plugin.ListGenerator == nil {
+		return errors.New("nil generator of CRD: " + plugin.Kind)
+	}
+	if plugin.Translator == nil {
+		return errors.New("nil translator of CRD: " + plugin.Kind)
+	}
+	if plugin.Strategy && plugin.Action {
+		return errors.New("CRD cannot be both a strategy and an action: " + plugin.Kind)
+	}
+
+	crdMetadataMux.Lock()
+	defer crdMetadataMux.Unlock()
+
+	if _, exists := crdMetadataMap[plugin.Kind]; exists {
+		return errors.New("CRD has been registered: " + plugin.Kind)
+	}
+	if plugin.AddToScheme != nil {
+		if err := plugin.AddToScheme(scheme); err != nil {
+			return errors.Wrap(err, "failed to register scheme of CRD: "+plugin.Kind)
+		}
+	}
+	defaulter := plugin.Defaulter
+	if defaulter == nil {
+		defaulter, _ = plugin.Translator.(Defaulter)
+	}
+	crdMetadataMap[plugin.Kind] = &CRDMetadata{
+		kind:          plugin.Kind,
+		generator:     plugin.Generator,
+		listGenerator: plugin.ListGenerator,
+		translator:    plugin.Translator,
+		defaulter:     defaulter,
+		strategy:      plugin.Strategy,
+		action:        plugin.Action,
+	}
+	return nil
+}
+
 func GetCrdMetadata(kind CRDKind) (*CRDMetadata, bool) {
-	// TODO: should we put a lock here?
+	crdMetadataMux.RLock()
+	defer crdMetadataMux.RUnlock()
+
 	data, exists := crdMetadataMap[kind]
 	return data, exists
 }
+
+// RegisteredKinds returns all registered kinds of OpenSergo CRD.
+func RegisteredKinds() []CRDKind {
+	crdMetadataMux.RLock()
+	defer crdMetadataMux.RUnlock()
+
+	kinds := make([]CRDKind, 0, len(crdMetadataMap))
+	for kind := range crdMetadataMap {
+		kinds = append(kinds, kind)
+	}
+	return kinds
+}
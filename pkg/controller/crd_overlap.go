@@ -0,0 +1,114 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+// maxReportedOverlaps is the maximum number of overlaps described in the status of a rule,
+// which keeps the condition message short when a pattern matches hundreds of resources.
+const maxReportedOverlaps = 20
+
+// compiledTarget is a target of a FaultToleranceRule with the compiled resource name or pattern.
+type compiledTarget struct {
+	rule      string
+	index     int
+	target    *crdv1alpha1.FaultToleranceTargetRef
+	matchType pb.FaultToleranceRule_MatchType
+	pattern   *regexp.Regexp
+}
+
+func (t *compiledTarget) isPattern() bool {
+	return t.matchType != pb.FaultToleranceRule_MATCH_EXACT
+}
+
+func (t *compiledTarget) String() string {
+	return fmt.Sprintf("targets[%d] (%s %q)", t.index, t.target.MatchType, t.target.TargetResourceName)
+}
+
+// targetOverlaps finds the pattern targets of the FaultToleranceRules of an app which shadow or duplicate
+// other targets with the same sources, and returns a map: rule name -> descriptions of the overlaps.
+// A pattern shadows a concrete resource of another rule if it matches the resource with a higher priority,
+// and it duplicates a concrete resource of the same rule, or an identical pattern of the same priority.
+func targetOverlaps(objs []clusterObject) map[string][]string {
+	var targets []*compiledTarget
+	for _, obj := range objs {
+		rule, ok := obj.object.(*crdv1alpha1.FaultToleranceRule)
+		if !ok {
+			continue
+		}
+		for i := range rule.Spec.Targets {
+			target := &rule.Spec.Targets[i]
+			matchType, pattern, err := compileTarget(fmt.Sprintf("spec.targets[%d]", i), target)
+			if err != nil {
+				// Invalid targets are reported by the validation instead.
+				continue
+			}
+			targets = append(targets, &compiledTarget{rule: rule.Name, index: i, target: target, matchType: matchType, pattern: pattern})
+		}
+	}
+	sort.SliceStable(targets, func(i, j int) bool {
+		return targets[i].rule < targets[j].rule
+	})
+
+	overlaps := make(map[string][]string)
+	for _, p := range targets {
+		if !p.isPattern() {
+			continue
+		}
+		for _, o := range targets {
+			if o == p || !equality.Semantic.DeepEqual(p.target.Sources, o.target.Sources) {
+				continue
+			}
+			var overlap string
+			switch {
+			case !o.isPattern() && p.pattern.MatchString(o.target.TargetResourceName):
+				if o.rule == p.rule {
+					overlap = fmt.Sprintf("%s duplicates the concrete resource %q of the same rule", p, o.target.TargetResourceName)
+				} else if p.target.Priority > o.target.Priority {
+					overlap = fmt.Sprintf("%s shadows the concrete resource %q of FaultToleranceRule %s", p, o.target.TargetResourceName, o.rule)
+				}
+			case o.isPattern() && o.matchType == p.matchType && o.target.TargetResourceName == p.target.TargetResourceName &&
+				o.target.Priority == p.target.Priority:
+				// Identical patterns of the same rule are reported once.
+				if o.rule != p.rule {
+					overlap = fmt.Sprintf("%s duplicates targets[%d] of FaultToleranceRule %s", p, o.index, o.rule)
+				} else if o.index < p.index {
+					overlap = fmt.Sprintf("%s duplicates targets[%d] of the same rule", p, o.index)
+				}
+			}
+			if overlap != "" {
+				overlaps[p.rule] = append(overlaps[p.rule], overlap)
+			}
+		}
+	}
+	return overlaps
+}
+
+// overlapMessage describes the overlaps of the targets of a rule in the message of the Overlapped condition.
+func overlapMessage(overlaps []string) string {
+	if len(overlaps) <= maxReportedOverlaps {
+		return strings.Join(overlaps, "; ")
+	}
+	return fmt.Sprintf("%s; and %d more", strings.Join(overlaps[:maxReportedOverlaps], "; "), len(overlaps)-maxReportedOverlaps)
+}
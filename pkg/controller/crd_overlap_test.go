@@ -0,0 +1,250 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+)
+
+func newTestRuleObject(name string, targets ...crdv1alpha1.FaultToleranceTargetRef) clusterObject {
+	return clusterObject{object: &crdv1alpha1.FaultToleranceRule{
+		ObjectMeta: newTestObjectMeta(name),
+		Spec: crdv1alpha1.FaultToleranceRuleSpec{
+			Targets:    targets,
+			Strategies: []crdv1alpha1.FaultToleranceStrategyRef{{Name: "rls", Kind: crdv1alpha1.RateLimitStrategyKind}},
+		},
+	}}
+}
+
+func exactTarget(name string, priority int32) crdv1alpha1.FaultToleranceTargetRef {
+	return crdv1alpha1.FaultToleranceTargetRef{TargetResourceName: name, Priority: priority}
+}
+
+func patternTarget(matchType, pattern string, priority int32) crdv1alpha1.FaultToleranceTargetRef {
+	return crdv1alpha1.FaultToleranceTargetRef{TargetResourceName: pattern, MatchType: matchType, Priority: priority}
+}
+
+func TestTargetOverlaps(t *testing.T) {
+	vipSources := []crdv1alpha1.FaultToleranceSource{{Headers: map[string]crdv1alpha1.StringMatch{"X-User": {Exact: "vip"}}}}
+	vipTarget := patternTarget(crdv1alpha1.GlobMatchType, "GET:/api/*", 1)
+	vipTarget.Sources = vipSources
+
+	tests := []struct {
+		name string
+		objs []clusterObject
+		want map[string][]string
+	}{
+		{
+			name: "glob shadows a concrete resource of a lower priority",
+			objs: []clusterObject{
+				newTestRuleObject("a", patternTarget(crdv1alpha1.GlobMatchType, "GET:/api/*", 1)),
+				newTestRuleObject("b", exactTarget("GET:/api/orders", 0), exactTarget("GET:/api/orders/1", 0)),
+			},
+			want: map[string][]string{
+				"a": {`targets[0] (Glob "GET:/api/*") shadows the concrete resource "GET:/api/orders" of FaultToleranceRule b`},
+			},
+		},
+		{
+			name: "regex shadows a concrete resource of a lower priority",
+			objs: []clusterObject{
+				newTestRuleObject("a", exactTarget("POST:/api/orders", -1)),
+				newTestRuleObject("b", patternTarget(crdv1alpha1.RegexMatchType, "(GET|POST):/api/.+", 0)),
+			},
+			want: map[string][]string{
+				"b": {`targets[0] (Regex "(GET|POST):/api/.+") shadows the concrete resource "POST:/api/orders" of FaultToleranceRule a`},
+			},
+		},
+		{
+			name: "concrete resource takes precedence at the same priority",
+			objs: []clusterObject{
+				newTestRuleObject("a", patternTarget(crdv1alpha1.GlobMatchType, "GET:/api/*", 0)),
+				newTestRuleObject("b", exactTarget("GET:/api/orders", 0)),
+			},
+			want: map[string][]string{},
+		},
+		{
+			name: "concrete resource of a higher priority",
+			objs: []clusterObject{
+				newTestRuleObject("a", patternTarget(crdv1alpha1.GlobMatchType, "GET:/api/*", 0)),
+				newTestRuleObject("b", exactTarget("GET:/api/orders", 1)),
+			},
+			want: map[string][]string{},
+		},
+		{
+			name: "glob metacharacters are literal",
+			objs: []clusterObject{
+				newTestRuleObject("a", patternTarget(crdv1alpha1.GlobMatchType, "GET:/a.b/(v1)/?", 1)),
+				newTestRuleObject("b", exactTarget("GET:/aXb/v1/1", 0), exactTarget("GET:/a.b/(v1)/1", 0)),
+			},
+			want: map[string][]string{
+				"a": {`targets[0] (Glob "GET:/a.b/(v1)/?") shadows the concrete resource "GET:/a.b/(v1)/1" of FaultToleranceRule b`},
+			},
+		},
+		{
+			name: "pattern duplicates a concrete resource of the same rule",
+			objs: []clusterObject{
+				newTestRuleObject("a", exactTarget("GET:/api/orders", 0), patternTarget(crdv1alpha1.GlobMatchType, "GET:/api/**", 0)),
+			},
+			want: map[string][]string{
+				"a": {`targets[1] (Glob "GET:/api/**") duplicates the concrete resource "GET:/api/orders" of the same rule`},
+			},
+		},
+		{
+			name: "different sources",
+			objs: []clusterObject{
+				newTestRuleObject("a", vipTarget),
+				newTestRuleObject("b", exactTarget("GET:/api/orders", 0)),
+			},
+			want: map[string][]string{},
+		},
+		{
+			name: "same sources",
+			objs: []clusterObject{
+				newTestRuleObject("a", vipTarget),
+				newTestRuleObject("b", crdv1alpha1.FaultToleranceTargetRef{TargetResourceName: "GET:/api/orders", Sources: vipSources}),
+			},
+			want: map[string][]string{
+				"a": {`targets[0] (Glob "GET:/api/*") shadows the concrete resource "GET:/api/orders" of FaultToleranceRule b`},
+			},
+		},
+		{
+			name: "equal-priority identical patterns of different rules",
+			objs: []clusterObject{
+				newTestRuleObject("b", patternTarget(crdv1alpha1.GlobMatchType, "GET:/api/*", 1)),
+				newTestRuleObject("a", exactTarget("GET:/other", 0), patternTarget(crdv1alpha1.GlobMatchType, "GET:/api/*", 1)),
+			},
+			want: map[string][]string{
+				"a": {`targets[1] (Glob "GET:/api/*") duplicates targets[0] of FaultToleranceRule b`},
+				"b": {`targets[0] (Glob "GET:/api/*") duplicates targets[1] of FaultToleranceRule a`},
+			},
+		},
+		{
+			name: "equal-priority identical patterns of the same rule are reported once per pair",
+			objs: []clusterObject{
+				newTestRuleObject("a",
+					patternTarget(crdv1alpha1.RegexMatchType, "GET:/api/.*", 0),
+					patternTarget(crdv1alpha1.RegexMatchType, "GET:/api/.*", 0),
+					patternTarget(crdv1alpha1.RegexMatchType, "GET:/api/.*", 0),
+				),
+			},
+			want: map[string][]string{
+				"a": {
+					`targets[1] (Regex "GET:/api/.*") duplicates targets[0] of the same rule`,
+					`targets[2] (Regex "GET:/api/.*") duplicates targets[0] of the same rule`,
+					`targets[2] (Regex "GET:/api/.*") duplicates targets[1] of the same rule`,
+				},
+			},
+		},
+		{
+			name: "identical patterns of different priorities",
+			objs: []clusterObject{
+				newTestRuleObject("a", patternTarget(crdv1alpha1.GlobMatchType, "GET:/api/*", 1)),
+				newTestRuleObject("b", patternTarget(crdv1alpha1.GlobMatchType, "GET:/api/*", 0)),
+			},
+			want: map[string][]string{},
+		},
+		{
+			name: "identical patterns of different match types",
+			objs: []clusterObject{
+				newTestRuleObject("a", patternTarget(crdv1alpha1.GlobMatchType, "GET:/api/*", 0)),
+				newTestRuleObject("b", patternTarget(crdv1alpha1.RegexMatchType, "GET:/api/*", 0)),
+			},
+			want: map[string][]string{},
+		},
+		{
+			name: "invalid targets and other kinds are skipped",
+			objs: []clusterObject{
+				newTestRuleObject("a", patternTarget(crdv1alpha1.RegexMatchType, "GET:/(api", 1), patternTarget("Prefix", "GET:/", 1)),
+				newTestRuleObject("b", exactTarget("GET:/(api", 0), exactTarget("GET:/", 0)),
+				{object: &crdv1alpha1.RateLimitStrategy{ObjectMeta: newTestObjectMeta("rls")}},
+			},
+			want: map[string][]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := targetOverlaps(tt.objs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("targetOverlaps() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOverlapMessage(t *testing.T) {
+	overlapsOf := func(n int) []string {
+		overlaps := make([]string, n)
+		for i := range overlaps {
+			overlaps[i] = fmt.Sprintf("overlap %d", i)
+		}
+		return overlaps
+	}
+	tests := []struct {
+		name     string
+		overlaps []string
+		want     string
+	}{
+		{name: "no overlap", want: ""},
+		{name: "one overlap", overlaps: overlapsOf(1), want: "overlap 0"},
+		{
+			name:     "maximum number of overlaps",
+			overlaps: overlapsOf(maxReportedOverlaps),
+			want:     strings.Join(overlapsOf(maxReportedOverlaps), "; "),
+		},
+		{
+			name:     "one more than the maximum",
+			overlaps: overlapsOf(maxReportedOverlaps + 1),
+			want:     strings.Join(overlapsOf(maxReportedOverlaps), "; ") + "; and 1 more",
+		},
+		{
+			name:     "hundreds of overlaps",
+			overlaps: overlapsOf(300),
+			want:     strings.Join(overlapsOf(maxReportedOverlaps), "; ") + fmt.Sprintf("; and %d more", 300-maxReportedOverlaps),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := overlapMessage(tt.overlaps); got != tt.want {
+				t.Errorf("overlapMessage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestOverlapMessageOfPattern checks the truncation of the overlaps of a pattern matching many concrete resources.
+func TestOverlapMessageOfPattern(t *testing.T) {
+	var targets []crdv1alpha1.FaultToleranceTargetRef
+	for i := 0; i < maxReportedOverlaps+5; i++ {
+		targets = append(targets, exactTarget(fmt.Sprintf("GET:/api/orders/%d", i), 0))
+	}
+	overlaps := targetOverlaps([]clusterObject{
+		newTestRuleObject("a", patternTarget(crdv1alpha1.GlobMatchType, "GET:/api/orders/*", 1)),
+		newTestRuleObject("b", targets...),
+	})
+	if len(overlaps["a"]) != maxReportedOverlaps+5 {
+		t.Fatalf("overlaps = %q, want %d overlaps", overlaps["a"], maxReportedOverlaps+5)
+	}
+	message := overlapMessage(overlaps["a"])
+	if got := strings.Count(message, "shadows the concrete resource"); got != maxReportedOverlaps {
+		t.Errorf("message describes %d overlaps, want %d: %s", got, maxReportedOverlaps, message)
+	}
+	if !strings.HasSuffix(message, "; and 5 more") {
+		t.Errorf("message = %s, want the suffix %q", message, "; and 5 more")
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8sApiError "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	ReasonTranslated        = "Translated"
+	ReasonTranslationFailed = "TranslationFailed"
+	ReasonValidationFailed  = "ValidationFailed"
+	ReasonShadowed          = "Shadowed"
+	ReasonNoInstances       = "NoInstances"
+	ReasonPending           = "Pending"
+	ReasonAcked             = "Acked"
+	ReasonNacked            = "Nacked"
+	ReasonNoNack            = "NoNack"
+	ReasonResolved          = "Resolved"
+	ReasonDanglingRefs      = "DanglingReferences"
+	ReasonTargetsOverlapped = "TargetsOverlapped"
+	ReasonNoOverlap         = "NoOverlap"
+)
+
+// statusKey represents the rules of a (namespace, app, kind) whose status should be updated.
+type statusKey struct {
+	model.NamespacedApp
+	kind model.SubscribeKind
+}
+
+// updateStatus updates the status of all cached CRDs of the given (namespace, app) in all clusters
+// according to the translation result and the delivery status of the rules.
+func (r *CRDWatcher) updateStatus(ctx context.Context, n model.NamespacedApp, delivery model.DeliveryStatus) error {
+	merged, _ := r.mergedObjects(n)
+	delivered := make(map[string]bool, len(merged))
+	for _, obj := range merged {
+		delivered[obj.cluster+"/"+obj.object.GetName()] = true
+	}
+	// The overlaps of targets are only analyzed among the delivered rules, which are the ones applied by the SDKs.
+	var overlaps map[string][]string
+	if r.kind == FaultToleranceRuleKind {
+		overlaps = targetOverlaps(merged)
+	}
+
+	for _, cluster := range r.clusters {
+		objs, _ := r.crdCaches[cluster.name].GetByNamespaceApp(n)
+		for _, obj := range objs {
+			if obj == nil {
+				continue
+			}
+			isDelivered := delivered[cluster.name+"/"+obj.GetName()]
+			var objOverlaps []string
+			if isDelivered {
+				objOverlaps = overlaps[obj.GetName()]
+			}
+			err := r.updateObjectStatus(ctx, cluster, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()},
+				isDelivered, objOverlaps, delivery)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *CRDWatcher) updateObjectStatus(ctx context.Context, cluster *Cluster, name types.NamespacedName, delivered bool,
+	overlaps []string, delivery model.DeliveryStatus) error {
+	c := cluster.manager.GetClient()
+	// Always get the latest object, as the status of the cached object may be outdated.
+	obj := r.crdGenerator()
+	if err := c.Get(ctx, name, obj); err != nil {
+		if k8sApiError.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	holder, ok := obj.(crdv1alpha1.RuleStatusHolder)
+	if !ok {
+		// The status of the CRD is not maintained by the control plane.
+		return nil
+	}
+	base := obj.DeepCopyObject().(client.Object)
+	status := holder.GetRuleStatus()
+	prev := status.DeepCopy()
+
+	generation := obj.GetGeneration()
+	status.ObservedGeneration = generation
+	// The spec is validated and translated once, and the invalid rule is told apart from the untranslatable one.
+	var validationErrs field.ErrorList
+	_, translateErr := TranslateObject(r.kind, obj)
+	var invalidErr *InvalidObjectError
+	if errors.As(translateErr, &invalidErr) {
+		validationErrs, translateErr = invalidErr.Errs, nil
+	}
+	if len(validationErrs) > 0 {
+		setCondition(status, crdv1alpha1.RuleConditionTranslated, metav1.ConditionFalse, ReasonValidationFailed,
+			validationErrs.ToAggregate().Error(), generation)
+	} else if translateErr != nil {
+		setCondition(status, crdv1alpha1.RuleConditionTranslated, metav1.ConditionFalse, ReasonTranslationFailed, translateErr.Error(), generation)
+	} else {
+		setCondition(status, crdv1alpha1.RuleConditionTranslated, metav1.ConditionTrue, ReasonTranslated, "", generation)
+	}
+	switch {
+	case len(validationErrs) > 0:
+		setCondition(status, crdv1alpha1.RuleConditionDelivered, metav1.ConditionFalse, ReasonValidationFailed, "the rule is invalid", generation)
+		delivery = model.DeliveryStatus{}
+	case translateErr != nil:
+		setCondition(status, crdv1alpha1.RuleConditionDelivered, metav1.ConditionFalse, ReasonTranslationFailed, "the rule cannot be translated", generation)
+		delivery = model.DeliveryStatus{}
+	case !delivered:
+		setCondition(status, crdv1alpha1.RuleConditionDelivered, metav1.ConditionFalse, ReasonShadowed,
+			"the rule is shadowed by a rule with the same name or by the rules of the primary cluster", generation)
+		delivery = model.DeliveryStatus{}
+	case delivery.ConnectedInstances == 0:
+		setCondition(status, crdv1alpha1.RuleConditionDelivered, metav1.ConditionFalse, ReasonNoInstances, "no connected instance subscribes the rule", generation)
+	case delivery.AckedInstances < delivery.ConnectedInstances:
+		setCondition(status, crdv1alpha1.RuleConditionDelivered, metav1.ConditionFalse, ReasonPending,
+			fmt.Sprintf("%d of %d connected instances have ACKed the rule", delivery.AckedInstances, delivery.ConnectedInstances), generation)
+	default:
+		setCondition(status, crdv1alpha1.RuleConditionDelivered, metav1.ConditionTrue, ReasonAcked, "all connected instances have ACKed the rule", generation)
+	}
+	if delivery.NackedInstances > 0 {
+		setCondition(status, crdv1alpha1.RuleConditionRejected, metav1.ConditionTrue, ReasonNacked, delivery.LastNackMessage, generation)
+	} else {
+		setCondition(status, crdv1alpha1.RuleConditionRejected, metav1.ConditionFalse, ReasonNoNack, "", generation)
+	}
+	if r.dependencies != nil {
+		dangling, err := r.danglingReferences(ctx, cluster, obj)
+		if err != nil {
+			return err
+		}
+		if len(dangling) > 0 {
+			setCondition(status, crdv1alpha1.RuleConditionResolvedRefs, metav1.ConditionFalse, ReasonDanglingRefs,
+				"unresolved strategies: "+strings.Join(dangling, ", "), generation)
+		} else {
+			setCondition(status, crdv1alpha1.RuleConditionResolvedRefs, metav1.ConditionTrue, ReasonResolved, "", generation)
+		}
+	}
+	if r.kind == FaultToleranceRuleKind {
+		if len(overlaps) > 0 {
+			setCondition(status, crdv1alpha1.RuleConditionOverlapped, metav1.ConditionTrue, ReasonTargetsOverlapped, overlapMessage(overlaps), generation)
+		} else {
+			setCondition(status, crdv1alpha1.RuleConditionOverlapped, metav1.ConditionFalse, ReasonNoOverlap, "", generation)
+		}
+	}
+	status.Version = delivery.Version
+	status.ConnectedInstances = delivery.ConnectedInstances
+	status.AckedInstances = delivery.AckedInstances
+	status.LastNackMessage = delivery.LastNackMessage
+
+	if !equality.Semantic.DeepEqual(prev, status) {
+		if err := c.Status().Patch(ctx, obj, client.MergeFrom(base)); err != nil {
+			if k8sApiError.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+	}
+	r.recordEvents(cluster, obj, prev, status, validationErrs, translateErr, delivery)
+	return nil
+}
+
+func setCondition(status *crdv1alpha1.RuleStatus, conditionType string, conditionStatus metav1.ConditionStatus, reason, message string, generation int64) {
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
@@ -0,0 +1,168 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeManager is a manager which only provides the client.
+type fakeManager struct {
+	ctrl.Manager
+	client client.Client
+}
+
+func (m *fakeManager) GetClient() client.Client {
+	return m.client
+}
+
+// newTestCluster creates a cluster whose client is a fake client holding the given objects.
+func newTestCluster(name string, primary bool, objs ...client.Object) *Cluster {
+	return &Cluster{
+		name:        name,
+		primary:     primary,
+		mergePolicy: MergePolicyUnion,
+		manager:     &fakeManager{client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()},
+	}
+}
+
+func getRuleStatus(t *testing.T, cluster *Cluster, obj client.Object) *crdv1alpha1.RuleStatus {
+	t.Helper()
+	latest := obj.DeepCopyObject().(client.Object)
+	if err := cluster.manager.GetClient().Get(context.Background(), types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, latest); err != nil {
+		t.Fatal(err)
+	}
+	return latest.(crdv1alpha1.RuleStatusHolder).GetRuleStatus()
+}
+
+func assertCondition(t *testing.T, status *crdv1alpha1.RuleStatus, conditionType string, conditionStatus metav1.ConditionStatus, reason string) {
+	t.Helper()
+	condition := meta.FindStatusCondition(status.Conditions, conditionType)
+	if condition == nil {
+		t.Fatalf("condition %s is absent", conditionType)
+	}
+	if condition.Status != conditionStatus || condition.Reason != reason {
+		t.Errorf("condition %s = %s/%s, want %s/%s", conditionType, condition.Status, condition.Reason, conditionStatus, reason)
+	}
+}
+
+func TestCRDWatcherExcludesInvalidRules(t *testing.T) {
+	valid := newTestRateLimitStrategy("valid", 10)
+	invalid := newTestRateLimitStrategy("invalid", -1)
+	cluster := newTestCluster("primary", true, valid, invalid)
+	w := newTestWatcher(t, RateLimitStrategyKind, cluster)
+	setCachedObjects(w, "primary", valid, invalid)
+
+	data := w.GetDataWithVersion(testNamespacedApp)
+	if got := provenancesOf(data); len(got) != 1 || got[0] != "primary/valid" {
+		t.Errorf("provenances = %v, want [primary/valid]", got)
+	}
+
+	delivery := model.DeliveryStatus{Version: data.GetVersion(), ConnectedInstances: 2, AckedInstances: 2}
+	if err := w.updateStatus(context.Background(), testNamespacedApp, delivery); err != nil {
+		t.Fatal(err)
+	}
+	validStatus := getRuleStatus(t, cluster, valid)
+	assertCondition(t, validStatus, crdv1alpha1.RuleConditionTranslated, metav1.ConditionTrue, ReasonTranslated)
+	assertCondition(t, validStatus, crdv1alpha1.RuleConditionDelivered, metav1.ConditionTrue, ReasonAcked)
+	if validStatus.AckedInstances != 2 {
+		t.Errorf("acked instances = %d, want 2", validStatus.AckedInstances)
+	}
+
+	invalidStatus := getRuleStatus(t, cluster, invalid)
+	assertCondition(t, invalidStatus, crdv1alpha1.RuleConditionTranslated, metav1.ConditionFalse, ReasonValidationFailed)
+	assertCondition(t, invalidStatus, crdv1alpha1.RuleConditionDelivered, metav1.ConditionFalse, ReasonValidationFailed)
+	if msg := meta.FindStatusCondition(invalidStatus.Conditions, crdv1alpha1.RuleConditionTranslated).Message; !strings.Contains(msg, "spec.threshold") {
+		t.Errorf("message = %q, want the validation error of spec.threshold", msg)
+	}
+	if invalidStatus.ConnectedInstances != 0 {
+		t.Errorf("connected instances = %d, want 0", invalidStatus.ConnectedInstances)
+	}
+}
+
+func TestCRDWatcherDeliveryConditions(t *testing.T) {
+	tests := []struct {
+		name            string
+		delivery        model.DeliveryStatus
+		delivered       metav1.ConditionStatus
+		deliveredReason string
+		rejected        metav1.ConditionStatus
+		rejectedReason  string
+	}{
+		{
+			name:            "no instances",
+			delivery:        model.DeliveryStatus{Version: 1},
+			delivered:       metav1.ConditionFalse,
+			deliveredReason: ReasonNoInstances,
+			rejected:        metav1.ConditionFalse,
+			rejectedReason:  ReasonNoNack,
+		},
+		{
+			name:            "pending instances of several replicas",
+			delivery:        aggregateDeliveryStatus([]model.DeliveryStatus{{Version: 1, ConnectedInstances: 2, AckedInstances: 2}, {Version: 2, ConnectedInstances: 1, AckedInstances: 1}}),
+			delivered:       metav1.ConditionFalse,
+			deliveredReason: ReasonPending,
+			rejected:        metav1.ConditionFalse,
+			rejectedReason:  ReasonNoNack,
+		},
+		{
+			name:            "acked by the instances of all replicas",
+			delivery:        aggregateDeliveryStatus([]model.DeliveryStatus{{Version: 2, ConnectedInstances: 2, AckedInstances: 2}, {Version: 2, ConnectedInstances: 1, AckedInstances: 1}}),
+			delivered:       metav1.ConditionTrue,
+			deliveredReason: ReasonAcked,
+			rejected:        metav1.ConditionFalse,
+			rejectedReason:  ReasonNoNack,
+		},
+		{
+			name:            "nacked by an instance of another replica",
+			delivery:        aggregateDeliveryStatus([]model.DeliveryStatus{{Version: 2, ConnectedInstances: 2, AckedInstances: 2}, {Version: 2, ConnectedInstances: 1, NackedInstances: 1, LastNackMessage: "bad rule"}}),
+			delivered:       metav1.ConditionFalse,
+			deliveredReason: ReasonPending,
+			rejected:        metav1.ConditionTrue,
+			rejectedReason:  ReasonNacked,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := newTestRateLimitStrategy("a", 10)
+			cluster := newTestCluster("primary", true, obj)
+			w := newTestWatcher(t, RateLimitStrategyKind, cluster)
+			setCachedObjects(w, "primary", obj)
+
+			if err := w.updateStatus(context.Background(), testNamespacedApp, tt.delivery); err != nil {
+				t.Fatal(err)
+			}
+			status := getRuleStatus(t, cluster, obj)
+			assertCondition(t, status, crdv1alpha1.RuleConditionDelivered, tt.delivered, tt.deliveredReason)
+			assertCondition(t, status, crdv1alpha1.RuleConditionRejected, tt.rejected, tt.rejectedReason)
+			if status.Version != tt.delivery.Version || status.ConnectedInstances != tt.delivery.ConnectedInstances ||
+				status.AckedInstances != tt.delivery.AckedInstances {
+				t.Errorf("status = %d/%d/%d, want %d/%d/%d", status.Version, status.ConnectedInstances, status.AckedInstances,
+					tt.delivery.Version, tt.delivery.ConnectedInstances, tt.delivery.AckedInstances)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"github.com/opensergo/opensergo-control-plane/pkg/convert"
+	"github.com/opensergo/opensergo-control-plane/pkg/util"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/anypb"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TranslateObject validates the object of the given kind and translates it to the packed rule with the translator
+// of the kind, and validates the rule against the constraints declared in the proto, so that invalid rules never
+// reach the SDKs, whichever source the object comes from. The objects failing the validation of the kind are
+// rejected with an *InvalidObjectError. The rule is nil if the kind has no translator.
+func TranslateObject(kind CRDKind, object client.Object) (*anypb.Any, error) {
+	crdMetadata, exists := GetCrdMetadata(kind)
+	if !exists || crdMetadata.Translator() == nil {
+		return nil, nil
+	}
+	if errs := crdMetadata.Translator().Validate(object); len(errs) > 0 {
+		return nil, &InvalidObjectError{Errs: errs}
+	}
+	rule, err := crdMetadata.Translator().Translate(object)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := rule.(protoValidator); ok {
+		if err := v.ValidateAll(); err != nil {
+			return nil, errors.Wrap(err, "the translated rule violates the constraints of the proto")
+		}
+	}
+	// Marshal deterministically so that the same rule always has the same bytes.
+	packRule, err := util.MessageToAnyWithError(rule)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack the rule")
+	}
+	return packRule, nil
+}
+
+// InvalidObjectError is the error of TranslateObject for the objects failing the validation of the kind.
+type InvalidObjectError struct {
+	Errs field.ErrorList
+}
+
+func (e *InvalidObjectError) Error() string {
+	return "invalid OpenSergo CRD: " + e.Errs.ToAggregate().Error()
+}
+
+// protoValidator is implemented by the proto messages with validators generated by protoc-gen-validate.
+type protoValidator interface {
+	ValidateAll() error
+}
+
+func unexpectedObjectError(object client.Object) error {
+	return errors.Errorf("unexpected object type: %T", object)
+}
+
+func appendError(errs []error, err error) []error {
+	if err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// translateError aggregates the errors of conversion into a single error.
+func translateError(errs []error) error {
+	return utilerrors.NewAggregate(errs)
+}
+
+// fieldErrors converts the errors of conversion to field-level errors.
+func fieldErrors(errs []error) field.ErrorList {
+	var fieldErrs field.ErrorList
+	for _, err := range errs {
+		var convertErr *convert.ConversionError
+		if errors.As(err, &convertErr) {
+			fieldErrs = append(fieldErrs, field.Invalid(field.NewPath(convertErr.Field), convertErr.Value, convertErr.Reason))
+		} else {
+			fieldErrs = append(fieldErrs, field.InternalError(nil, err))
+		}
+	}
+	return fieldErrs
+}
+
+// normalizeDuration normalizes the duration in place if it is valid.
+func normalizeDuration(value *string) {
+	if normalized, err := convert.NormalizeDuration("", *value); err == nil {
+		*value = normalized
+	}
+}
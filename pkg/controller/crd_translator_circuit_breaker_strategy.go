@@ -0,0 +1,157 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/convert"
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type circuitBreakerStrategyTranslator struct{}
+
+func (t *circuitBreakerStrategyTranslator) convert(cbs *crdv1alpha1.CircuitBreakerStrategy) (*pb.CircuitBreakerStrategy, []error) {
+	var errs []error
+	strategy, err := convert.ParseCircuitBreakerStrategy("spec.strategy", cbs.Spec.Strategy)
+	errs = appendError(errs, err)
+	var triggerRatio float64
+	// The ErrorRequestCount strategy is triggered by triggerCount, so the ratio is optional.
+	if strategy != pb.CircuitBreakerStrategy_STRATEGY_ERROR_REQUEST_COUNT || cbs.Spec.TriggerRatio != "" {
+		triggerRatio, err = convert.ParseRatio("spec.triggerRatio", cbs.Spec.TriggerRatio)
+		errs = appendError(errs, err)
+	}
+	statDuration, err := convert.ParsePositiveDuration("spec.statDuration", cbs.Spec.StatDuration)
+	errs = appendError(errs, err)
+	recoveryTimeout, err := convert.ParsePositiveDuration("spec.recoveryTimeout", cbs.Spec.RecoveryTimeout)
+	errs = appendError(errs, err)
+	recoveryTimeoutValue, err := recoveryTimeout.ValueInt32("spec.recoveryTimeout")
+	errs = appendError(errs, err)
+
+	rule := &pb.CircuitBreakerStrategy{
+		Name:                    cbs.Name,
+		Strategy:                strategy,
+		TriggerRatio:            triggerRatio,
+		StatDuration:            statDuration.Value,
+		StatDurationTimeUnit:    statDuration.Unit,
+		RecoveryTimeout:         recoveryTimeoutValue,
+		RecoveryTimeoutTimeUnit: recoveryTimeout.Unit,
+		MinRequestAmount:        cbs.Spec.MinRequestAmount,
+		TriggerCount:            cbs.Spec.TriggerCount,
+	}
+	if cbs.Spec.SlowConditions.MaxAllowedRt != "" {
+		maxAllowedRt, err := convert.ParseDuration("spec.slowConditions.maxAllowedRt", cbs.Spec.SlowConditions.MaxAllowedRt)
+		errs = appendError(errs, err)
+		maxAllowedRtMillis, err := maxAllowedRt.MillisInt32("spec.slowConditions.maxAllowedRt")
+		errs = appendError(errs, err)
+		rule.SlowCondition = &pb.CircuitBreakerStrategy_CircuitBreakerSlowCondition{MaxAllowedRtMillis: maxAllowedRtMillis}
+	}
+	if !isEmptyErrorConditions(&cbs.Spec.ErrorConditions) {
+		errorCondition, conditionErrs := convertErrorConditions("spec.errorConditions", &cbs.Spec.ErrorConditions)
+		rule.ErrorCondition = errorCondition
+		errs = append(errs, conditionErrs...)
+	}
+	return rule, errs
+}
+
+func isEmptyErrorConditions(conditions *crdv1alpha1.ErrorConditions) bool {
+	return len(conditions.HttpStatusCodes) == 0 && len(conditions.GrpcCodes) == 0 && len(conditions.ErrorTypes) == 0
+}
+
+// convertErrorConditions converts the error conditions at the path, which are shared by the circuit breakers and the retries.
+func convertErrorConditions(path string, conditions *crdv1alpha1.ErrorConditions) (*pb.CircuitBreakerStrategy_CircuitBreakerErrorCondition, []error) {
+	var errs []error
+	condition := &pb.CircuitBreakerStrategy_CircuitBreakerErrorCondition{ErrorTypes: conditions.ErrorTypes}
+	for i, statusCodes := range conditions.HttpStatusCodes {
+		min, max, err := convert.ParseHttpStatusCodeRange(fmt.Sprintf("%s.httpStatusCodes[%d]", path, i), string(statusCodes))
+		errs = appendError(errs, err)
+		condition.HttpStatusCodes = append(condition.HttpStatusCodes, &pb.CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange{
+			Min: min,
+			Max: max,
+		})
+	}
+	for i, grpcCode := range conditions.GrpcCodes {
+		code, err := convert.ParseGrpcCode(fmt.Sprintf("%s.grpcCodes[%d]", path, i), grpcCode)
+		errs = appendError(errs, err)
+		condition.GrpcCodes = append(condition.GrpcCodes, code)
+	}
+	return condition, errs
+}
+
+func (t *circuitBreakerStrategyTranslator) Translate(object client.Object) (proto.Message, error) {
+	cbs, ok := object.(*crdv1alpha1.CircuitBreakerStrategy)
+	if !ok {
+		return nil, unexpectedObjectError(object)
+	}
+	rule, errs := t.convert(cbs)
+	return rule, translateError(errs)
+}
+
+func (t *circuitBreakerStrategyTranslator) Validate(object client.Object) field.ErrorList {
+	cbs, ok := object.(*crdv1alpha1.CircuitBreakerStrategy)
+	if !ok {
+		return field.ErrorList{field.InternalError(nil, unexpectedObjectError(object))}
+	}
+	rule, convertErrs := t.convert(cbs)
+	errs := fieldErrors(convertErrs)
+	if cbs.Spec.MinRequestAmount <= 0 {
+		errs = append(errs, field.Invalid(field.NewPath("spec", "minRequestAmount"), cbs.Spec.MinRequestAmount, "must be positive"))
+	}
+	if rule.Strategy == pb.CircuitBreakerStrategy_STRATEGY_ERROR_REQUEST_COUNT && cbs.Spec.TriggerCount <= 0 {
+		errs = append(errs, field.Invalid(field.NewPath("spec", "triggerCount"), cbs.Spec.TriggerCount,
+			"must be positive for the "+convert.CircuitBreakerStrategyErrorRequestCount+" strategy"))
+	}
+	errs = append(errs, validateErrorTypes(field.NewPath("spec", "errorConditions"), &cbs.Spec.ErrorConditions)...)
+	return errs
+}
+
+func (t *circuitBreakerStrategyTranslator) Default(object client.Object) {
+	cbs, ok := object.(*crdv1alpha1.CircuitBreakerStrategy)
+	if !ok {
+		return
+	}
+	if cbs.Spec.TriggerRatio != "" {
+		if ratio, err := convert.NormalizeRatio("spec.triggerRatio", cbs.Spec.TriggerRatio); err == nil {
+			cbs.Spec.TriggerRatio = ratio
+		}
+	}
+	normalizeDuration(&cbs.Spec.StatDuration)
+	normalizeDuration(&cbs.Spec.RecoveryTimeout)
+	if cbs.Spec.SlowConditions.MaxAllowedRt != "" {
+		normalizeDuration(&cbs.Spec.SlowConditions.MaxAllowedRt)
+	}
+	defaultErrorConditions(&cbs.Spec.ErrorConditions)
+}
+
+func validateErrorTypes(path *field.Path, conditions *crdv1alpha1.ErrorConditions) field.ErrorList {
+	var errs field.ErrorList
+	for i, errorType := range conditions.ErrorTypes {
+		if errorType == "" {
+			errs = append(errs, field.Required(path.Child("errorTypes").Index(i), ""))
+		}
+	}
+	return errs
+}
+
+func defaultErrorConditions(conditions *crdv1alpha1.ErrorConditions) {
+	for i := range conditions.GrpcCodes {
+		conditions.GrpcCodes[i] = strings.ToUpper(conditions.GrpcCodes[i])
+	}
+}
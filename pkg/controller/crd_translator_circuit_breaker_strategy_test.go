@@ -0,0 +1,164 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/convert"
+)
+
+func TestCircuitBreakerStrategyValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(spec *crdv1alpha1.CircuitBreakerStrategySpec)
+		want   []string
+	}{
+		{
+			name:   "error ratio",
+			mutate: func(spec *crdv1alpha1.CircuitBreakerStrategySpec) {},
+		},
+		{
+			name: "error conditions",
+			mutate: func(spec *crdv1alpha1.CircuitBreakerStrategySpec) {
+				spec.ErrorConditions = crdv1alpha1.ErrorConditions{
+					HttpStatusCodes: []crdv1alpha1.HttpStatusCodeRange{"500-599", "429"},
+					GrpcCodes:       []string{"UNAVAILABLE", "DEADLINE_EXCEEDED"},
+					ErrorTypes:      []string{"java.io.IOException"},
+				}
+			},
+		},
+		{
+			name: "error count without ratio",
+			mutate: func(spec *crdv1alpha1.CircuitBreakerStrategySpec) {
+				spec.Strategy = convert.CircuitBreakerStrategyErrorRequestCount
+				spec.TriggerRatio = ""
+				spec.TriggerCount = 10
+			},
+		},
+		{
+			name: "error count without trigger count",
+			mutate: func(spec *crdv1alpha1.CircuitBreakerStrategySpec) {
+				spec.Strategy = convert.CircuitBreakerStrategyErrorRequestCount
+			},
+			want: []string{"spec.triggerCount: Invalid value"},
+		},
+		{
+			name: "error ratio without ratio",
+			mutate: func(spec *crdv1alpha1.CircuitBreakerStrategySpec) {
+				spec.TriggerRatio = ""
+				spec.TriggerCount = 10
+			},
+			want: []string{"spec.triggerRatio: Invalid value"},
+		},
+		{
+			name: "slow ratio",
+			mutate: func(spec *crdv1alpha1.CircuitBreakerStrategySpec) {
+				spec.Strategy = convert.CircuitBreakerStrategySlowRequestRatio
+				spec.SlowConditions.MaxAllowedRt = "500ms"
+			},
+		},
+		{
+			name: "unknown strategy",
+			mutate: func(spec *crdv1alpha1.CircuitBreakerStrategySpec) {
+				spec.Strategy = "ErrorCount"
+			},
+			want: []string{"spec.strategy: Invalid value"},
+		},
+		{
+			name: "non-positive min request amount",
+			mutate: func(spec *crdv1alpha1.CircuitBreakerStrategySpec) {
+				spec.MinRequestAmount = 0
+			},
+			want: []string{"spec.minRequestAmount: Invalid value"},
+		},
+		{
+			name: "invalid durations",
+			mutate: func(spec *crdv1alpha1.CircuitBreakerStrategySpec) {
+				spec.StatDuration = "0s"
+				spec.RecoveryTimeout = "5"
+				spec.SlowConditions.MaxAllowedRt = "fast"
+			},
+			want: []string{
+				"spec.recoveryTimeout: Invalid value",
+				"spec.slowConditions.maxAllowedRt: Invalid value",
+				"spec.statDuration: Invalid value",
+			},
+		},
+		{
+			name: "invalid error conditions",
+			mutate: func(spec *crdv1alpha1.CircuitBreakerStrategySpec) {
+				spec.ErrorConditions = crdv1alpha1.ErrorConditions{
+					HttpStatusCodes: []crdv1alpha1.HttpStatusCodeRange{"500-599", "600", "599-500"},
+					GrpcCodes:       []string{"UNAVAILABLE", "14"},
+					ErrorTypes:      []string{"java.io.IOException", ""},
+				}
+			},
+			want: []string{
+				"spec.errorConditions.errorTypes[1]: Required value",
+				"spec.errorConditions.grpcCodes[1]: Invalid value",
+				"spec.errorConditions.httpStatusCodes[1]: Invalid value",
+				"spec.errorConditions.httpStatusCodes[2]: Invalid value",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cbs := &crdv1alpha1.CircuitBreakerStrategy{
+				ObjectMeta: newTestObjectMeta("cbs"),
+				Spec: crdv1alpha1.CircuitBreakerStrategySpec{
+					Strategy:         convert.CircuitBreakerStrategyErrorRequestRatio,
+					TriggerRatio:     "50%",
+					StatDuration:     "1s",
+					RecoveryTimeout:  "5s",
+					MinRequestAmount: 5,
+				},
+			}
+			tt.mutate(&cbs.Spec)
+			if got := validationErrors(t, CircuitBreakerStrategyKind, cbs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCircuitBreakerStrategyDefault(t *testing.T) {
+	cbs := &crdv1alpha1.CircuitBreakerStrategy{
+		ObjectMeta: newTestObjectMeta("cbs"),
+		Spec: crdv1alpha1.CircuitBreakerStrategySpec{
+			Strategy:         convert.CircuitBreakerStrategyErrorRequestRatio,
+			TriggerRatio:     "0.5",
+			StatDuration:     "1000ms",
+			RecoveryTimeout:  "60s",
+			MinRequestAmount: 5,
+			ErrorConditions:  crdv1alpha1.ErrorConditions{GrpcCodes: []string{"unavailable"}},
+		},
+	}
+	crdMetadata, _ := GetCrdMetadata(CircuitBreakerStrategyKind)
+	crdMetadata.Defaulter().Default(cbs)
+	want := crdv1alpha1.CircuitBreakerStrategySpec{
+		Strategy:         convert.CircuitBreakerStrategyErrorRequestRatio,
+		TriggerRatio:     "50%",
+		StatDuration:     "1s",
+		RecoveryTimeout:  "1min",
+		MinRequestAmount: 5,
+		ErrorConditions:  crdv1alpha1.ErrorConditions{GrpcCodes: []string{"UNAVAILABLE"}},
+	}
+	if !reflect.DeepEqual(cbs.Spec, want) {
+		t.Errorf("defaulted spec = %+v, want %+v", cbs.Spec, want)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/convert"
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type concurrencyLimitStrategyTranslator struct{}
+
+func (t *concurrencyLimitStrategyTranslator) convert(cls *crdv1alpha1.ConcurrencyLimitStrategy) (*pb.ConcurrencyLimitStrategy, []error) {
+	var errs []error
+	limitMode, err := convert.ParseConcurrencyLimitMode("spec.limitMode", cls.Spec.LimitMode)
+	errs = appendError(errs, err)
+	rule := &pb.ConcurrencyLimitStrategy{
+		Name:           cls.Name,
+		LimitMode:      limitMode,
+		MaxConcurrency: cls.Spec.MaxConcurrencyThreshold,
+	}
+	if adaptive := cls.Spec.Adaptive; adaptive != nil {
+		algorithm := pb.ConcurrencyLimitStrategy_ALGORITHM_GRADIENT
+		if adaptive.Algorithm != "" {
+			algorithm, err = convert.ParseAdaptiveAlgorithm("spec.adaptive.algorithm", adaptive.Algorithm)
+			errs = appendError(errs, err)
+		}
+		smoothing, err := convert.ParseDecimal("spec.adaptive.smoothing", adaptive.Smoothing)
+		errs = appendError(errs, err)
+		probeInterval, err := convert.ParsePositiveDuration("spec.adaptive.probeInterval", adaptive.ProbeInterval)
+		errs = appendError(errs, err)
+		rule.Adaptive = &pb.ConcurrencyLimitStrategy_AdaptiveLimit{
+			Algorithm:           algorithm,
+			MinConcurrency:      adaptive.MinConcurrency,
+			MaxConcurrency:      adaptive.MaxConcurrency,
+			Smoothing:           smoothing,
+			ProbeIntervalMillis: probeInterval.Millis(),
+		}
+		// The clients unaware of the adaptive limit fall back to the upper bound.
+		rule.MaxConcurrency = adaptive.MaxConcurrency
+	}
+	return rule, errs
+}
+
+func (t *concurrencyLimitStrategyTranslator) Translate(object client.Object) (proto.Message, error) {
+	cls, ok := object.(*crdv1alpha1.ConcurrencyLimitStrategy)
+	if !ok {
+		return nil, unexpectedObjectError(object)
+	}
+	rule, errs := t.convert(cls)
+	return rule, translateError(errs)
+}
+
+func (t *concurrencyLimitStrategyTranslator) Validate(object client.Object) field.ErrorList {
+	cls, ok := object.(*crdv1alpha1.ConcurrencyLimitStrategy)
+	if !ok {
+		return field.ErrorList{field.InternalError(nil, unexpectedObjectError(object))}
+	}
+	_, convertErrs := t.convert(cls)
+	errs := fieldErrors(convertErrs)
+	specPath := field.NewPath("spec")
+	adaptive := cls.Spec.Adaptive
+	switch {
+	case adaptive == nil && cls.Spec.MaxConcurrencyThreshold <= 0:
+		errs = append(errs, field.Invalid(specPath.Child("maxConcurrency"), cls.Spec.MaxConcurrencyThreshold, "must be positive"))
+	case adaptive != nil && cls.Spec.MaxConcurrencyThreshold != 0:
+		errs = append(errs, field.Forbidden(specPath.Child("maxConcurrency"), "the adaptive limit is bounded by adaptive.maxConcurrency instead"))
+	}
+	if adaptive == nil {
+		return errs
+	}
+	adaptivePath := specPath.Child("adaptive")
+	if adaptive.MinConcurrency <= 0 {
+		errs = append(errs, field.Invalid(adaptivePath.Child("minConcurrency"), adaptive.MinConcurrency, "must be positive"))
+	}
+	if adaptive.MaxConcurrency < adaptive.MinConcurrency {
+		errs = append(errs, field.Invalid(adaptivePath.Child("maxConcurrency"), adaptive.MaxConcurrency,
+			fmt.Sprintf("must not be less than minConcurrency %d", adaptive.MinConcurrency)))
+	}
+	// The unparsable smoothing is reported by the conversion already.
+	if smoothing, err := convert.ParseDecimal("", adaptive.Smoothing); err == nil && (smoothing <= 0 || smoothing > 1) {
+		errs = append(errs, field.Invalid(adaptivePath.Child("smoothing"), adaptive.Smoothing, "must be in (0, 1]"))
+	}
+	return errs
+}
+
+func (t *concurrencyLimitStrategyTranslator) Default(object client.Object) {
+	cls, ok := object.(*crdv1alpha1.ConcurrencyLimitStrategy)
+	if !ok {
+		return
+	}
+	if cls.Spec.LimitMode == "" {
+		cls.Spec.LimitMode = crdv1alpha1.LocalLimitMode
+	}
+	if adaptive := cls.Spec.Adaptive; adaptive != nil {
+		if adaptive.Algorithm == "" {
+			adaptive.Algorithm = crdv1alpha1.GradientAdaptiveAlgorithm
+		}
+		normalizeDuration(&adaptive.ProbeInterval)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+)
+
+func newTestAdaptiveConcurrencyLimit() *crdv1alpha1.AdaptiveConcurrencyLimit {
+	return &crdv1alpha1.AdaptiveConcurrencyLimit{
+		MinConcurrency: 2,
+		MaxConcurrency: 64,
+		Smoothing:      "0.2",
+		ProbeInterval:  "30s",
+	}
+}
+
+func TestConcurrencyLimitStrategyValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(spec *crdv1alpha1.ConcurrencyLimitStrategySpec)
+		want   []string
+	}{
+		{
+			name:   "static",
+			mutate: func(spec *crdv1alpha1.ConcurrencyLimitStrategySpec) {},
+		},
+		{
+			name: "adaptive",
+			mutate: func(spec *crdv1alpha1.ConcurrencyLimitStrategySpec) {
+				spec.MaxConcurrencyThreshold = 0
+				spec.Adaptive = newTestAdaptiveConcurrencyLimit()
+				spec.Adaptive.Algorithm = crdv1alpha1.VegasAdaptiveAlgorithm
+			},
+		},
+		{
+			name: "adaptive with equal bounds and smoothing 1",
+			mutate: func(spec *crdv1alpha1.ConcurrencyLimitStrategySpec) {
+				spec.MaxConcurrencyThreshold = 0
+				spec.Adaptive = newTestAdaptiveConcurrencyLimit()
+				spec.Adaptive.MaxConcurrency = spec.Adaptive.MinConcurrency
+				spec.Adaptive.Smoothing = "1"
+			},
+		},
+		{
+			name: "static without max concurrency",
+			mutate: func(spec *crdv1alpha1.ConcurrencyLimitStrategySpec) {
+				spec.MaxConcurrencyThreshold = 0
+			},
+			want: []string{"spec.maxConcurrency: Invalid value"},
+		},
+		{
+			name: "unknown limit mode",
+			mutate: func(spec *crdv1alpha1.ConcurrencyLimitStrategySpec) {
+				spec.LimitMode = "Cluster"
+			},
+			want: []string{"spec.limitMode: Invalid value"},
+		},
+		{
+			name: "adaptive with static max concurrency",
+			mutate: func(spec *crdv1alpha1.ConcurrencyLimitStrategySpec) {
+				spec.Adaptive = newTestAdaptiveConcurrencyLimit()
+			},
+			want: []string{"spec.maxConcurrency: Forbidden"},
+		},
+		{
+			name: "adaptive with non-positive min concurrency",
+			mutate: func(spec *crdv1alpha1.ConcurrencyLimitStrategySpec) {
+				spec.MaxConcurrencyThreshold = 0
+				spec.Adaptive = newTestAdaptiveConcurrencyLimit()
+				spec.Adaptive.MinConcurrency = 0
+			},
+			want: []string{"spec.adaptive.minConcurrency: Invalid value"},
+		},
+		{
+			name: "adaptive with max concurrency less than min concurrency",
+			mutate: func(spec *crdv1alpha1.ConcurrencyLimitStrategySpec) {
+				spec.MaxConcurrencyThreshold = 0
+				spec.Adaptive = newTestAdaptiveConcurrencyLimit()
+				spec.Adaptive.MaxConcurrency = 1
+			},
+			want: []string{"spec.adaptive.maxConcurrency: Invalid value"},
+		},
+		{
+			name: "adaptive with smoothing out of range",
+			mutate: func(spec *crdv1alpha1.ConcurrencyLimitStrategySpec) {
+				spec.MaxConcurrencyThreshold = 0
+				spec.Adaptive = newTestAdaptiveConcurrencyLimit()
+				spec.Adaptive.Smoothing = "0"
+			},
+			want: []string{"spec.adaptive.smoothing: Invalid value"},
+		},
+		{
+			name: "adaptive with invalid fields",
+			mutate: func(spec *crdv1alpha1.ConcurrencyLimitStrategySpec) {
+				spec.MaxConcurrencyThreshold = 0
+				spec.Adaptive = newTestAdaptiveConcurrencyLimit()
+				spec.Adaptive.Algorithm = "AIMD"
+				spec.Adaptive.Smoothing = "high"
+				spec.Adaptive.ProbeInterval = ""
+			},
+			want: []string{
+				"spec.adaptive.algorithm: Invalid value",
+				"spec.adaptive.probeInterval: Invalid value",
+				"spec.adaptive.smoothing: Invalid value",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cls := &crdv1alpha1.ConcurrencyLimitStrategy{
+				ObjectMeta: newTestObjectMeta("cls"),
+				Spec: crdv1alpha1.ConcurrencyLimitStrategySpec{
+					MaxConcurrencyThreshold: 8,
+					LimitMode:               crdv1alpha1.LocalLimitMode,
+				},
+			}
+			tt.mutate(&cls.Spec)
+			if got := validationErrors(t, ConcurrencyLimitStrategyKind, cls); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestConcurrencyLimitStrategyMaxConcurrency pins how the adaptive limit is exposed to the SDKs:
+// the static maxConcurrency is rejected along with the adaptive limit, and the translated rule carries
+// adaptive.maxConcurrency as its MaxConcurrency, so that the SDKs unaware of the adaptive limit
+// fall back to a static limit at the upper bound.
+func TestConcurrencyLimitStrategyMaxConcurrency(t *testing.T) {
+	tests := []struct {
+		name               string
+		threshold          int64
+		adaptive           *crdv1alpha1.AdaptiveConcurrencyLimit
+		wantErrors         []string
+		wantMaxConcurrency int64
+	}{
+		{name: "static", threshold: 8, wantMaxConcurrency: 8},
+		{name: "adaptive", adaptive: newTestAdaptiveConcurrencyLimit(), wantMaxConcurrency: 64},
+		{
+			name:               "adaptive with static max concurrency",
+			threshold:          8,
+			adaptive:           newTestAdaptiveConcurrencyLimit(),
+			wantErrors:         []string{"spec.maxConcurrency: Forbidden"},
+			wantMaxConcurrency: 64,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cls := &crdv1alpha1.ConcurrencyLimitStrategy{
+				ObjectMeta: newTestObjectMeta("cls"),
+				Spec: crdv1alpha1.ConcurrencyLimitStrategySpec{
+					MaxConcurrencyThreshold: tt.threshold,
+					LimitMode:               crdv1alpha1.LocalLimitMode,
+					Adaptive:                tt.adaptive,
+				},
+			}
+			if got := validationErrors(t, ConcurrencyLimitStrategyKind, cls); !reflect.DeepEqual(got, tt.wantErrors) {
+				t.Errorf("Validate() = %v, want %v", got, tt.wantErrors)
+			}
+			msg, err := translate(t, ConcurrencyLimitStrategyKind, cls)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rule := msg.(*pb.ConcurrencyLimitStrategy)
+			if rule.MaxConcurrency != tt.wantMaxConcurrency {
+				t.Errorf("MaxConcurrency = %d, want %d", rule.MaxConcurrency, tt.wantMaxConcurrency)
+			}
+			if (rule.Adaptive != nil) != (tt.adaptive != nil) {
+				t.Errorf("Adaptive = %v, want set: %v", rule.Adaptive, tt.adaptive != nil)
+			}
+			if tt.adaptive != nil && rule.Adaptive.MaxConcurrency != rule.MaxConcurrency {
+				t.Errorf("Adaptive.MaxConcurrency = %d, want the same as MaxConcurrency %d", rule.Adaptive.MaxConcurrency, rule.MaxConcurrency)
+			}
+		})
+	}
+}
+
+func TestConcurrencyLimitStrategyDefault(t *testing.T) {
+	cls := &crdv1alpha1.ConcurrencyLimitStrategy{
+		ObjectMeta: newTestObjectMeta("cls"),
+		Spec:       crdv1alpha1.ConcurrencyLimitStrategySpec{Adaptive: newTestAdaptiveConcurrencyLimit()},
+	}
+	cls.Spec.Adaptive.ProbeInterval = "60000ms"
+	crdMetadata, _ := GetCrdMetadata(ConcurrencyLimitStrategyKind)
+	crdMetadata.Defaulter().Default(cls)
+	if cls.Spec.LimitMode != crdv1alpha1.LocalLimitMode || cls.Spec.Adaptive.Algorithm != crdv1alpha1.GradientAdaptiveAlgorithm ||
+		cls.Spec.Adaptive.ProbeInterval != "1min" {
+		t.Errorf("defaulted spec = %+v, adaptive = %+v", cls.Spec, cls.Spec.Adaptive)
+	}
+	if got := validationErrors(t, ConcurrencyLimitStrategyKind, cls); len(got) != 0 {
+		t.Errorf("Validate() of the defaulted object = %v", got)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"strings"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/convert"
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type fallbackActionTranslator struct{}
+
+func (t *fallbackActionTranslator) convert(fa *crdv1alpha1.FallbackAction) (*pb.FallbackAction, []error) {
+	var errs []error
+	rule := &pb.FallbackAction{Name: fa.Name}
+	if resp := fa.Spec.HttpResponse; resp != nil {
+		rule.HttpResponse = &pb.FallbackAction_HttpResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    resp.Headers,
+			Body:       resp.Body,
+		}
+	}
+	if resp := fa.Spec.GrpcResponse; resp != nil {
+		code, err := convert.ParseGrpcCode("spec.grpcResponse.code", resp.Code)
+		errs = appendError(errs, err)
+		rule.GrpcResponse = &pb.FallbackAction_GrpcResponse{
+			Code:    code,
+			Message: resp.Message,
+		}
+	}
+	if ref := fa.Spec.FallbackResource; ref != nil {
+		rule.FallbackResource = &pb.FallbackAction_FallbackResourceRef{TargetResourceName: ref.TargetResourceName}
+	}
+	return rule, errs
+}
+
+func (t *fallbackActionTranslator) Translate(object client.Object) (proto.Message, error) {
+	fa, ok := object.(*crdv1alpha1.FallbackAction)
+	if !ok {
+		return nil, unexpectedObjectError(object)
+	}
+	rule, errs := t.convert(fa)
+	return rule, translateError(errs)
+}
+
+func (t *fallbackActionTranslator) Validate(object client.Object) field.ErrorList {
+	fa, ok := object.(*crdv1alpha1.FallbackAction)
+	if !ok {
+		return field.ErrorList{field.InternalError(nil, unexpectedObjectError(object))}
+	}
+	_, convertErrs := t.convert(fa)
+	errs := fieldErrors(convertErrs)
+	specPath := field.NewPath("spec")
+	responses := 0
+	if resp := fa.Spec.HttpResponse; resp != nil {
+		responses++
+		if resp.StatusCode < 100 || resp.StatusCode > 599 {
+			errs = append(errs, field.Invalid(specPath.Child("httpResponse", "statusCode"), resp.StatusCode, "must be between 100 and 599"))
+		}
+	}
+	if fa.Spec.GrpcResponse != nil {
+		responses++
+	}
+	if ref := fa.Spec.FallbackResource; ref != nil {
+		responses++
+		if ref.TargetResourceName == "" {
+			errs = append(errs, field.Required(specPath.Child("fallbackResource", "targetResourceName"), ""))
+		}
+	}
+	if responses != 1 {
+		errs = append(errs, field.Invalid(specPath, responses,
+			"exactly one of httpResponse, grpcResponse and fallbackResource must be set"))
+	}
+	return errs
+}
+
+func (t *fallbackActionTranslator) Default(object client.Object) {
+	fa, ok := object.(*crdv1alpha1.FallbackAction)
+	if !ok {
+		return
+	}
+	if resp := fa.Spec.GrpcResponse; resp != nil {
+		resp.Code = strings.ToUpper(resp.Code)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+)
+
+func TestFallbackActionValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		spec crdv1alpha1.FallbackActionSpec
+		want []string
+	}{
+		{
+			name: "HTTP response",
+			spec: crdv1alpha1.FallbackActionSpec{
+				HttpResponse: &crdv1alpha1.FallbackHttpResponse{StatusCode: 429, Headers: map[string]string{"Retry-After": "1"}, Body: "busy"},
+			},
+		},
+		{
+			name: "gRPC response",
+			spec: crdv1alpha1.FallbackActionSpec{GrpcResponse: &crdv1alpha1.FallbackGrpcResponse{Code: "UNAVAILABLE"}},
+		},
+		{
+			name: "fallback resource",
+			spec: crdv1alpha1.FallbackActionSpec{FallbackResource: &crdv1alpha1.FallbackResourceRef{TargetResourceName: "/degraded"}},
+		},
+		{
+			name: "no response",
+			want: []string{"spec: Invalid value"},
+		},
+		{
+			name: "multiple responses",
+			spec: crdv1alpha1.FallbackActionSpec{
+				HttpResponse: &crdv1alpha1.FallbackHttpResponse{StatusCode: 503},
+				GrpcResponse: &crdv1alpha1.FallbackGrpcResponse{Code: "UNAVAILABLE"},
+			},
+			want: []string{"spec: Invalid value"},
+		},
+		{
+			name: "HTTP status code below 100",
+			spec: crdv1alpha1.FallbackActionSpec{HttpResponse: &crdv1alpha1.FallbackHttpResponse{StatusCode: 99}},
+			want: []string{"spec.httpResponse.statusCode: Invalid value"},
+		},
+		{
+			name: "HTTP status code above 599",
+			spec: crdv1alpha1.FallbackActionSpec{HttpResponse: &crdv1alpha1.FallbackHttpResponse{StatusCode: 600}},
+			want: []string{"spec.httpResponse.statusCode: Invalid value"},
+		},
+		{
+			name: "unknown gRPC code",
+			spec: crdv1alpha1.FallbackActionSpec{GrpcResponse: &crdv1alpha1.FallbackGrpcResponse{Code: "BUSY"}},
+			want: []string{"spec.grpcResponse.code: Invalid value"},
+		},
+		{
+			name: "empty fallback resource",
+			spec: crdv1alpha1.FallbackActionSpec{FallbackResource: &crdv1alpha1.FallbackResourceRef{}},
+			want: []string{"spec.fallbackResource.targetResourceName: Required value"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fa := &crdv1alpha1.FallbackAction{ObjectMeta: newTestObjectMeta("fa"), Spec: tt.spec}
+			if got := validationErrors(t, FallbackActionKind, fa); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFallbackActionDefault(t *testing.T) {
+	fa := &crdv1alpha1.FallbackAction{
+		ObjectMeta: newTestObjectMeta("fa"),
+		Spec:       crdv1alpha1.FallbackActionSpec{GrpcResponse: &crdv1alpha1.FallbackGrpcResponse{Code: "unavailable"}},
+	}
+	crdMetadata, _ := GetCrdMetadata(FallbackActionKind)
+	crdMetadata.Defaulter().Default(fa)
+	if fa.Spec.GrpcResponse.Code != "UNAVAILABLE" {
+		t.Errorf("code = %s, want UNAVAILABLE", fa.Spec.GrpcResponse.Code)
+	}
+	if got := validationErrors(t, FallbackActionKind, fa); len(got) != 0 {
+		t.Errorf("Validate() of the defaulted object = %v", got)
+	}
+}
+
+func TestFaultToleranceRuleActionValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		action *crdv1alpha1.FaultToleranceActionRef
+		want   []string
+	}{
+		{name: "no action"},
+		{name: "fallback action", action: &crdv1alpha1.FaultToleranceActionRef{Name: "fallback", Kind: crdv1alpha1.FallbackActionKind}},
+		{
+			name:   "no name",
+			action: &crdv1alpha1.FaultToleranceActionRef{Kind: crdv1alpha1.FallbackActionKind},
+			want:   []string{"spec.action.name: Required value"},
+		},
+		{
+			name:   "strategy kind",
+			action: &crdv1alpha1.FaultToleranceActionRef{Name: "rls", Kind: crdv1alpha1.RateLimitStrategyKind},
+			want:   []string{"spec.action.kind: Unsupported value"},
+		},
+		{
+			name:   "unknown kind",
+			action: &crdv1alpha1.FaultToleranceActionRef{Name: "fallback", Kind: "Fallback"},
+			want:   []string{"spec.action.kind: Unsupported value"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ftr := &crdv1alpha1.FaultToleranceRule{
+				ObjectMeta: newTestObjectMeta("ftr"),
+				Spec: crdv1alpha1.FaultToleranceRuleSpec{
+					Targets:    []crdv1alpha1.FaultToleranceTargetRef{{TargetResourceName: "/foo"}},
+					Strategies: []crdv1alpha1.FaultToleranceStrategyRef{{Name: "rls", Kind: crdv1alpha1.RateLimitStrategyKind}},
+					Action:     tt.action,
+				},
+			}
+			if got := validationErrors(t, FaultToleranceRuleKind, ftr); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
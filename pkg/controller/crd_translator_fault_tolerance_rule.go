@@ -0,0 +1,174 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/convert"
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type faultToleranceRuleTranslator struct{}
+
+func (t *faultToleranceRuleTranslator) convert(ftr *crdv1alpha1.FaultToleranceRule) (*pb.FaultToleranceRule, []error) {
+	var errs []error
+	rule := &pb.FaultToleranceRule{}
+	for i, target := range ftr.Spec.Targets {
+		path := fmt.Sprintf("spec.targets[%d]", i)
+		matchType, _, err := compileTarget(path, &target)
+		errs = appendError(errs, err)
+		targetRef := &pb.FaultToleranceRule_FaultToleranceRuleTargetRef{
+			TargetResourceName: target.TargetResourceName,
+			MatchType:          matchType,
+			Priority:           target.Priority,
+		}
+		for j, source := range target.Sources {
+			s, sourceErrs := convertSource(fmt.Sprintf("%s.sources[%d]", path, j), &source)
+			errs = append(errs, sourceErrs...)
+			targetRef.Sources = append(targetRef.Sources, s)
+		}
+		rule.Targets = append(rule.Targets, targetRef)
+	}
+	for _, strategy := range ftr.Spec.Strategies {
+		rule.Strategies = append(rule.Strategies, &pb.FaultToleranceRule_FaultToleranceStrategyRef{
+			Name: strategy.Name,
+			Kind: strategy.Kind,
+		})
+	}
+	if ftr.Spec.Action != nil {
+		rule.Action = &pb.FaultToleranceRule_FaultToleranceActionRef{
+			Name: ftr.Spec.Action.Name,
+			Kind: ftr.Spec.Action.Kind,
+		}
+	}
+	return rule, errs
+}
+
+func (t *faultToleranceRuleTranslator) Translate(object client.Object) (proto.Message, error) {
+	ftr, ok := object.(*crdv1alpha1.FaultToleranceRule)
+	if !ok {
+		return nil, unexpectedObjectError(object)
+	}
+	rule, errs := t.convert(ftr)
+	return rule, translateError(errs)
+}
+
+// compileTarget compiles the resource name or pattern of the target according to its match type,
+// so that malformed patterns are rejected by the control plane rather than the SDKs.
+func compileTarget(path string, target *crdv1alpha1.FaultToleranceTargetRef) (pb.FaultToleranceRule_MatchType, *regexp.Regexp, error) {
+	matchType := pb.FaultToleranceRule_MATCH_EXACT
+	if target.MatchType != "" {
+		var err error
+		if matchType, err = convert.ParseMatchType(path+".matchType", target.MatchType); err != nil {
+			return matchType, nil, err
+		}
+	}
+	pattern, err := convert.CompileResourcePattern(path+".targetResourceName", matchType, target.TargetResourceName)
+	return matchType, pattern, err
+}
+
+// convertSource converts a source of the targets of a FaultToleranceRule.
+func convertSource(path string, source *crdv1alpha1.FaultToleranceSource) (*pb.FaultToleranceRule_FaultToleranceRuleSource, []error) {
+	var errs []error
+	var err error
+	s := &pb.FaultToleranceRule_FaultToleranceRuleSource{}
+	if source.App != nil {
+		s.App, err = convert.ParseStringMatch(path+".app", source.App)
+		errs = appendError(errs, err)
+	}
+	if source.Namespace != nil {
+		s.Namespace, err = convert.ParseStringMatch(path+".namespace", source.Namespace)
+		errs = appendError(errs, err)
+	}
+	if source.Method != nil {
+		s.Method, err = convert.ParseStringMatch(path+".method", source.Method)
+		errs = appendError(errs, err)
+	}
+	var matchErrs []error
+	s.Headers, matchErrs = convertStringMatches(path+".headers", source.Headers)
+	errs = append(errs, matchErrs...)
+	s.QueryParams, matchErrs = convertStringMatches(path+".queryParams", source.QueryParams)
+	errs = append(errs, matchErrs...)
+	return s, errs
+}
+
+// convertStringMatches converts the matchers keyed by name in the order of the names, so that the errors are stable.
+func convertStringMatches(path string, matches map[string]crdv1alpha1.StringMatch) (map[string]*pb.StringMatch, []error) {
+	if len(matches) == 0 {
+		return nil, nil
+	}
+	names := make([]string, 0, len(matches))
+	for name := range matches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var errs []error
+	converted := make(map[string]*pb.StringMatch, len(matches))
+	for _, name := range names {
+		match := matches[name]
+		m, err := convert.ParseStringMatch(fmt.Sprintf("%s[%s]", path, name), &match)
+		errs = appendError(errs, err)
+		converted[name] = m
+	}
+	return converted, errs
+}
+
+func (t *faultToleranceRuleTranslator) Validate(object client.Object) field.ErrorList {
+	ftr, ok := object.(*crdv1alpha1.FaultToleranceRule)
+	if !ok {
+		return field.ErrorList{field.InternalError(nil, unexpectedObjectError(object))}
+	}
+	_, convertErrs := t.convert(ftr)
+	errs := fieldErrors(convertErrs)
+	specPath := field.NewPath("spec")
+	for i, target := range ftr.Spec.Targets {
+		if target.TargetResourceName == "" {
+			errs = append(errs, field.Required(specPath.Child("targets").Index(i).Child("targetResourceName"), ""))
+		}
+		for j, source := range target.Sources {
+			if source.App == nil && source.Namespace == nil && source.Method == nil && len(source.Headers) == 0 && len(source.QueryParams) == 0 {
+				errs = append(errs, field.Required(specPath.Child("targets").Index(i).Child("sources").Index(j), "at least one matcher is required"))
+			}
+		}
+	}
+	for i, strategy := range ftr.Spec.Strategies {
+		if strategy.Name == "" {
+			errs = append(errs, field.Required(specPath.Child("strategies").Index(i).Child("name"), ""))
+		}
+		if kind, exists := resolveStrategyKind(strategy.Kind); !exists || !isStrategyKind(kind) {
+			var supportedKinds []string
+			for _, kind := range strategyKinds() {
+				supportedKinds = append(supportedKinds, shortKind(kind))
+			}
+			errs = append(errs, field.NotSupported(specPath.Child("strategies").Index(i).Child("kind"), strategy.Kind, supportedKinds))
+		}
+	}
+	if action := ftr.Spec.Action; action != nil {
+		if action.Name == "" {
+			errs = append(errs, field.Required(specPath.Child("action", "name"), ""))
+		}
+		if kind, exists := resolveStrategyKind(action.Kind); !exists || !isActionKind(kind) {
+			errs = append(errs, field.NotSupported(specPath.Child("action", "kind"), action.Kind, []string{shortKind(FallbackActionKind)}))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,113 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/convert"
+	commonpb "github.com/opensergo/opensergo-control-plane/pkg/proto/common/v1"
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type paramFlowStrategyTranslator struct{}
+
+func (t *paramFlowStrategyTranslator) convert(pfs *crdv1alpha1.ParamFlowStrategy) (*pb.ParamFlowStrategy, []error) {
+	var errs []error
+	paramSource, err := convert.ParseParamSource("spec.paramSource", pfs.Spec.ParamSource)
+	errs = appendError(errs, err)
+	limitMode, err := convert.ParseParamFlowLimitMode("spec.limitMode", pfs.Spec.LimitMode)
+	errs = appendError(errs, err)
+	rule := &pb.ParamFlowStrategy{
+		Name:                 pfs.Name,
+		ParamSource:          paramSource,
+		ParamKey:             pfs.Spec.ParamKey,
+		LimitMode:            limitMode,
+		Threshold:            pfs.Spec.Threshold,
+		StatDuration:         pfs.Spec.StatDurationSeconds,
+		StatDurationTimeUnit: commonpb.TimeUnit_SECOND,
+	}
+	if pfs.Spec.ParamIndex != nil {
+		rule.ParamIndex = *pfs.Spec.ParamIndex
+	}
+	for _, exception := range pfs.Spec.Exceptions {
+		rule.Exceptions = append(rule.Exceptions, &pb.ParamFlowStrategy_ParamException{
+			Value:     exception.Value,
+			Threshold: exception.Threshold,
+		})
+	}
+	return rule, errs
+}
+
+func (t *paramFlowStrategyTranslator) Translate(object client.Object) (proto.Message, error) {
+	pfs, ok := object.(*crdv1alpha1.ParamFlowStrategy)
+	if !ok {
+		return nil, unexpectedObjectError(object)
+	}
+	rule, errs := t.convert(pfs)
+	return rule, translateError(errs)
+}
+
+func (t *paramFlowStrategyTranslator) Validate(object client.Object) field.ErrorList {
+	pfs, ok := object.(*crdv1alpha1.ParamFlowStrategy)
+	if !ok {
+		return field.ErrorList{field.InternalError(nil, unexpectedObjectError(object))}
+	}
+	rule, convertErrs := t.convert(pfs)
+	errs := fieldErrors(convertErrs)
+	specPath := field.NewPath("spec")
+	switch rule.ParamSource {
+	case pb.ParamFlowStrategy_SOURCE_HEADER, pb.ParamFlowStrategy_SOURCE_QUERY_PARAM:
+		if pfs.Spec.ParamKey == "" {
+			errs = append(errs, field.Required(specPath.Child("paramKey"), "required by the "+pfs.Spec.ParamSource+" source"))
+		}
+	case pb.ParamFlowStrategy_SOURCE_ARG_INDEX:
+		if pfs.Spec.ParamIndex == nil {
+			errs = append(errs, field.Required(specPath.Child("paramIndex"), "required by the "+pfs.Spec.ParamSource+" source"))
+		} else if *pfs.Spec.ParamIndex < 0 {
+			errs = append(errs, field.Invalid(specPath.Child("paramIndex"), *pfs.Spec.ParamIndex, "must be non-negative"))
+		}
+	}
+	if pfs.Spec.Threshold < 0 {
+		errs = append(errs, field.Invalid(specPath.Child("threshold"), pfs.Spec.Threshold, "must be non-negative"))
+	}
+	if pfs.Spec.StatDurationSeconds <= 0 {
+		errs = append(errs, field.Invalid(specPath.Child("statDurationSeconds"), pfs.Spec.StatDurationSeconds, "must be positive"))
+	}
+	values := make(map[string]bool, len(pfs.Spec.Exceptions))
+	for i, exception := range pfs.Spec.Exceptions {
+		exceptionPath := specPath.Child("exceptions").Index(i)
+		if values[exception.Value] {
+			errs = append(errs, field.Duplicate(exceptionPath.Child("value"), exception.Value))
+		}
+		values[exception.Value] = true
+		if exception.Threshold < 0 {
+			errs = append(errs, field.Invalid(exceptionPath.Child("threshold"), exception.Threshold, "must be non-negative"))
+		}
+	}
+	return errs
+}
+
+func (t *paramFlowStrategyTranslator) Default(object client.Object) {
+	pfs, ok := object.(*crdv1alpha1.ParamFlowStrategy)
+	if !ok {
+		return
+	}
+	if pfs.Spec.LimitMode == "" {
+		pfs.Spec.LimitMode = crdv1alpha1.LocalLimitMode
+	}
+}
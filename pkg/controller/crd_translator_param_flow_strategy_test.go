@@ -0,0 +1,147 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+)
+
+func TestParamFlowStrategyValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(spec *crdv1alpha1.ParamFlowStrategySpec)
+		want   []string
+	}{
+		{
+			name:   "header",
+			mutate: func(spec *crdv1alpha1.ParamFlowStrategySpec) {},
+		},
+		{
+			name: "query parameter with exceptions",
+			mutate: func(spec *crdv1alpha1.ParamFlowStrategySpec) {
+				spec.ParamSource = crdv1alpha1.QueryParamParamSource
+				spec.Exceptions = []crdv1alpha1.ParamException{{Value: "vip", Threshold: 100}, {Value: "blocked", Threshold: 0}}
+			},
+		},
+		{
+			name: "argument index",
+			mutate: func(spec *crdv1alpha1.ParamFlowStrategySpec) {
+				spec.ParamSource = crdv1alpha1.ArgIndexParamSource
+				spec.ParamKey = ""
+				spec.ParamIndex = int32Ptr(0)
+			},
+		},
+		{
+			name: "zero threshold",
+			mutate: func(spec *crdv1alpha1.ParamFlowStrategySpec) {
+				spec.Threshold = 0
+			},
+		},
+		{
+			name: "header without key",
+			mutate: func(spec *crdv1alpha1.ParamFlowStrategySpec) {
+				spec.ParamKey = ""
+			},
+			want: []string{"spec.paramKey: Required value"},
+		},
+		{
+			name: "query parameter without key",
+			mutate: func(spec *crdv1alpha1.ParamFlowStrategySpec) {
+				spec.ParamSource = crdv1alpha1.QueryParamParamSource
+				spec.ParamKey = ""
+			},
+			want: []string{"spec.paramKey: Required value"},
+		},
+		{
+			name: "argument index without index",
+			mutate: func(spec *crdv1alpha1.ParamFlowStrategySpec) {
+				spec.ParamSource = crdv1alpha1.ArgIndexParamSource
+			},
+			want: []string{"spec.paramIndex: Required value"},
+		},
+		{
+			name: "negative argument index",
+			mutate: func(spec *crdv1alpha1.ParamFlowStrategySpec) {
+				spec.ParamSource = crdv1alpha1.ArgIndexParamSource
+				spec.ParamIndex = int32Ptr(-1)
+			},
+			want: []string{"spec.paramIndex: Invalid value"},
+		},
+		{
+			name: "unknown source and limit mode",
+			mutate: func(spec *crdv1alpha1.ParamFlowStrategySpec) {
+				spec.ParamSource = "Cookie"
+				spec.LimitMode = "Cluster"
+			},
+			want: []string{"spec.limitMode: Invalid value", "spec.paramSource: Invalid value"},
+		},
+		{
+			name: "negative threshold and non-positive stat duration",
+			mutate: func(spec *crdv1alpha1.ParamFlowStrategySpec) {
+				spec.Threshold = -1
+				spec.StatDurationSeconds = 0
+			},
+			want: []string{"spec.statDurationSeconds: Invalid value", "spec.threshold: Invalid value"},
+		},
+		{
+			name: "invalid exceptions",
+			mutate: func(spec *crdv1alpha1.ParamFlowStrategySpec) {
+				spec.Exceptions = []crdv1alpha1.ParamException{{Value: "vip", Threshold: 100}, {Value: "vip", Threshold: -1}}
+			},
+			want: []string{"spec.exceptions[1].threshold: Invalid value", "spec.exceptions[1].value: Duplicate value"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pfs := &crdv1alpha1.ParamFlowStrategy{
+				ObjectMeta: newTestObjectMeta("pfs"),
+				Spec: crdv1alpha1.ParamFlowStrategySpec{
+					ParamSource:         crdv1alpha1.HeaderParamSource,
+					ParamKey:            "X-User-Id",
+					LimitMode:           crdv1alpha1.LocalLimitMode,
+					Threshold:           10,
+					StatDurationSeconds: 1,
+				},
+			}
+			tt.mutate(&pfs.Spec)
+			if got := validationErrors(t, ParamFlowStrategyKind, pfs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParamFlowStrategyDefault(t *testing.T) {
+	pfs := &crdv1alpha1.ParamFlowStrategy{
+		ObjectMeta: newTestObjectMeta("pfs"),
+		Spec: crdv1alpha1.ParamFlowStrategySpec{
+			ParamSource:         crdv1alpha1.HeaderParamSource,
+			ParamKey:            "X-User-Id",
+			Threshold:           10,
+			StatDurationSeconds: 1,
+		},
+	}
+	crdMetadata, _ := GetCrdMetadata(ParamFlowStrategyKind)
+	crdMetadata.Defaulter().Default(pfs)
+	if pfs.Spec.LimitMode != crdv1alpha1.LocalLimitMode {
+		t.Errorf("limitMode = %q, want %q", pfs.Spec.LimitMode, crdv1alpha1.LocalLimitMode)
+	}
+	if got := validationErrors(t, ParamFlowStrategyKind, pfs); len(got) != 0 {
+		t.Errorf("Validate() of the defaulted object = %v", got)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/convert"
+	commonpb "github.com/opensergo/opensergo-control-plane/pkg/proto/common/v1"
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type rateLimitStrategyTranslator struct{}
+
+func (t *rateLimitStrategyTranslator) convert(rls *crdv1alpha1.RateLimitStrategy) (*pb.RateLimitStrategy, []error) {
+	var errs []error
+	metricType, err := convert.ParseMetricType("spec.metricType", rls.Spec.MetricType)
+	errs = appendError(errs, err)
+	limitMode, err := convert.ParseRateLimitMode("spec.limitMode", rls.Spec.LimitMode)
+	errs = appendError(errs, err)
+	rule := &pb.RateLimitStrategy{
+		Name:                 rls.Name,
+		MetricType:           metricType,
+		LimitMode:            limitMode,
+		Threshold:            rls.Spec.Threshold,
+		StatDuration:         rls.Spec.StatDurationSeconds,
+		StatDurationTimeUnit: commonpb.TimeUnit_SECOND,
+	}
+	// An empty control behavior means Reject, which is left unset for the clients unaware of it.
+	if rls.Spec.ControlBehavior != "" {
+		rule.ControlBehavior, err = convert.ParseControlBehavior("spec.controlBehavior", rls.Spec.ControlBehavior)
+		errs = appendError(errs, err)
+	}
+	if warmUp := rls.Spec.WarmUp; warmUp != nil {
+		warmUpPeriod, err := convert.ParsePositiveDuration("spec.warmUp.warmUpPeriod", warmUp.WarmUpPeriod)
+		errs = appendError(errs, err)
+		rule.WarmUp = &pb.RateLimitStrategy_WarmUp{
+			ColdFactor:         warmUp.ColdFactor,
+			WarmUpPeriodMillis: warmUpPeriod.Millis(),
+		}
+		if rule.WarmUp.ColdFactor == 0 {
+			rule.WarmUp.ColdFactor = crdv1alpha1.DefaultColdFactor
+		}
+	}
+	if rls.Spec.MaxQueueingTime != "" {
+		maxQueueingTime, err := convert.ParsePositiveDuration("spec.maxQueueingTime", rls.Spec.MaxQueueingTime)
+		errs = appendError(errs, err)
+		rule.Queueing = &pb.RateLimitStrategy_Queueing{MaxQueueingTimeMillis: maxQueueingTime.Millis()}
+	}
+	return rule, errs
+}
+
+func (t *rateLimitStrategyTranslator) Translate(object client.Object) (proto.Message, error) {
+	rls, ok := object.(*crdv1alpha1.RateLimitStrategy)
+	if !ok {
+		return nil, unexpectedObjectError(object)
+	}
+	rule, errs := t.convert(rls)
+	return rule, translateError(errs)
+}
+
+func (t *rateLimitStrategyTranslator) Validate(object client.Object) field.ErrorList {
+	rls, ok := object.(*crdv1alpha1.RateLimitStrategy)
+	if !ok {
+		return field.ErrorList{field.InternalError(nil, unexpectedObjectError(object))}
+	}
+	rule, convertErrs := t.convert(rls)
+	errs := fieldErrors(convertErrs)
+	specPath := field.NewPath("spec")
+	if rls.Spec.Threshold < 0 {
+		errs = append(errs, field.Invalid(specPath.Child("threshold"), rls.Spec.Threshold, "must be non-negative"))
+	}
+	if rls.Spec.StatDurationSeconds <= 0 {
+		errs = append(errs, field.Invalid(specPath.Child("statDurationSeconds"), rls.Spec.StatDurationSeconds, "must be positive"))
+	}
+	behavior := rls.Spec.ControlBehavior
+	if behavior == "" {
+		behavior = crdv1alpha1.RejectControlBehavior
+	}
+	warmUp := rule.ControlBehavior == pb.RateLimitStrategy_BEHAVIOR_WARM_UP || rule.ControlBehavior == pb.RateLimitStrategy_BEHAVIOR_WARM_UP_QUEUEING
+	queueing := rule.ControlBehavior == pb.RateLimitStrategy_BEHAVIOR_QUEUEING || rule.ControlBehavior == pb.RateLimitStrategy_BEHAVIOR_WARM_UP_QUEUEING
+	switch {
+	case warmUp && rls.Spec.WarmUp == nil:
+		errs = append(errs, field.Required(specPath.Child("warmUp"), "required by the "+behavior+" behavior"))
+	case !warmUp && rls.Spec.WarmUp != nil:
+		errs = append(errs, field.Forbidden(specPath.Child("warmUp"), "not used by the "+behavior+" behavior"))
+	case warmUp && rls.Spec.WarmUp.ColdFactor != 0 && rls.Spec.WarmUp.ColdFactor < 2:
+		errs = append(errs, field.Invalid(specPath.Child("warmUp", "coldFactor"), rls.Spec.WarmUp.ColdFactor, "must be at least 2"))
+	}
+	switch {
+	case queueing && rls.Spec.MaxQueueingTime == "":
+		errs = append(errs, field.Required(specPath.Child("maxQueueingTime"), "required by the "+behavior+" behavior"))
+	case !queueing && rls.Spec.MaxQueueingTime != "":
+		errs = append(errs, field.Forbidden(specPath.Child("maxQueueingTime"), "not used by the "+behavior+" behavior"))
+	}
+	return errs
+}
+
+func (t *rateLimitStrategyTranslator) Default(object client.Object) {
+	rls, ok := object.(*crdv1alpha1.RateLimitStrategy)
+	if !ok {
+		return
+	}
+	if rls.Spec.MetricType == "" {
+		rls.Spec.MetricType = crdv1alpha1.RequestAmountMetricType
+	}
+	if rls.Spec.LimitMode == "" {
+		rls.Spec.LimitMode = crdv1alpha1.LocalLimitMode
+	}
+	if warmUp := rls.Spec.WarmUp; warmUp != nil {
+		if warmUp.ColdFactor == 0 {
+			warmUp.ColdFactor = crdv1alpha1.DefaultColdFactor
+		}
+		normalizeDuration(&warmUp.WarmUpPeriod)
+	}
+	if rls.Spec.MaxQueueingTime != "" {
+		normalizeDuration(&rls.Spec.MaxQueueingTime)
+	}
+}
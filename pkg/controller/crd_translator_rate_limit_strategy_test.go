@@ -0,0 +1,189 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+)
+
+func TestRateLimitStrategyValidate(t *testing.T) {
+	warmUp := func() *crdv1alpha1.RateLimitWarmUp {
+		return &crdv1alpha1.RateLimitWarmUp{WarmUpPeriod: "10s"}
+	}
+	tests := []struct {
+		name   string
+		mutate func(spec *crdv1alpha1.RateLimitStrategySpec)
+		want   []string
+	}{
+		{
+			name:   "reject by default",
+			mutate: func(spec *crdv1alpha1.RateLimitStrategySpec) {},
+		},
+		{
+			name: "reject",
+			mutate: func(spec *crdv1alpha1.RateLimitStrategySpec) {
+				spec.ControlBehavior = crdv1alpha1.RejectControlBehavior
+			},
+		},
+		{
+			name: "warm-up",
+			mutate: func(spec *crdv1alpha1.RateLimitStrategySpec) {
+				spec.ControlBehavior = crdv1alpha1.WarmUpControlBehavior
+				spec.WarmUp = &crdv1alpha1.RateLimitWarmUp{WarmUpPeriod: "10s", ColdFactor: 2}
+			},
+		},
+		{
+			name: "queueing",
+			mutate: func(spec *crdv1alpha1.RateLimitStrategySpec) {
+				spec.ControlBehavior = crdv1alpha1.QueueingControlBehavior
+				spec.MaxQueueingTime = "500ms"
+			},
+		},
+		{
+			name: "warm-up queueing",
+			mutate: func(spec *crdv1alpha1.RateLimitStrategySpec) {
+				spec.ControlBehavior = crdv1alpha1.WarmUpQueueingControlBehavior
+				spec.WarmUp = warmUp()
+				spec.MaxQueueingTime = "500ms"
+			},
+		},
+		{
+			name: "warm-up without warm-up",
+			mutate: func(spec *crdv1alpha1.RateLimitStrategySpec) {
+				spec.ControlBehavior = crdv1alpha1.WarmUpControlBehavior
+			},
+			want: []string{"spec.warmUp: Required value"},
+		},
+		{
+			name: "cold factor less than 2",
+			mutate: func(spec *crdv1alpha1.RateLimitStrategySpec) {
+				spec.ControlBehavior = crdv1alpha1.WarmUpControlBehavior
+				spec.WarmUp = &crdv1alpha1.RateLimitWarmUp{WarmUpPeriod: "10s", ColdFactor: 1}
+			},
+			want: []string{"spec.warmUp.coldFactor: Invalid value"},
+		},
+		{
+			name: "invalid warm-up period",
+			mutate: func(spec *crdv1alpha1.RateLimitStrategySpec) {
+				spec.ControlBehavior = crdv1alpha1.WarmUpControlBehavior
+				spec.WarmUp = &crdv1alpha1.RateLimitWarmUp{WarmUpPeriod: "0s"}
+			},
+			want: []string{"spec.warmUp.warmUpPeriod: Invalid value"},
+		},
+		{
+			name: "queueing without max queueing time",
+			mutate: func(spec *crdv1alpha1.RateLimitStrategySpec) {
+				spec.ControlBehavior = crdv1alpha1.QueueingControlBehavior
+			},
+			want: []string{"spec.maxQueueingTime: Required value"},
+		},
+		{
+			name: "warm-up queueing without either",
+			mutate: func(spec *crdv1alpha1.RateLimitStrategySpec) {
+				spec.ControlBehavior = crdv1alpha1.WarmUpQueueingControlBehavior
+			},
+			want: []string{"spec.maxQueueingTime: Required value", "spec.warmUp: Required value"},
+		},
+		{
+			name: "reject with warm-up and max queueing time",
+			mutate: func(spec *crdv1alpha1.RateLimitStrategySpec) {
+				spec.WarmUp = warmUp()
+				spec.MaxQueueingTime = "500ms"
+			},
+			want: []string{"spec.maxQueueingTime: Forbidden", "spec.warmUp: Forbidden"},
+		},
+		{
+			name: "queueing with warm-up",
+			mutate: func(spec *crdv1alpha1.RateLimitStrategySpec) {
+				spec.ControlBehavior = crdv1alpha1.QueueingControlBehavior
+				spec.MaxQueueingTime = "500ms"
+				spec.WarmUp = warmUp()
+			},
+			want: []string{"spec.warmUp: Forbidden"},
+		},
+		{
+			name: "unknown control behavior",
+			mutate: func(spec *crdv1alpha1.RateLimitStrategySpec) {
+				spec.ControlBehavior = "Throttling"
+			},
+			want: []string{"spec.controlBehavior: Invalid value"},
+		},
+		{
+			name: "invalid enums and numbers",
+			mutate: func(spec *crdv1alpha1.RateLimitStrategySpec) {
+				spec.MetricType = "Concurrency"
+				spec.LimitMode = "Cluster"
+				spec.Threshold = -1
+				spec.StatDurationSeconds = 0
+			},
+			want: []string{
+				"spec.limitMode: Invalid value",
+				"spec.metricType: Invalid value",
+				"spec.statDurationSeconds: Invalid value",
+				"spec.threshold: Invalid value",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rls := &crdv1alpha1.RateLimitStrategy{
+				ObjectMeta: newTestObjectMeta("rls"),
+				Spec: crdv1alpha1.RateLimitStrategySpec{
+					MetricType:          crdv1alpha1.RequestAmountMetricType,
+					LimitMode:           crdv1alpha1.LocalLimitMode,
+					Threshold:           10,
+					StatDurationSeconds: 1,
+				},
+			}
+			tt.mutate(&rls.Spec)
+			if got := validationErrors(t, RateLimitStrategyKind, rls); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimitStrategyDefault(t *testing.T) {
+	rls := &crdv1alpha1.RateLimitStrategy{
+		ObjectMeta: newTestObjectMeta("rls"),
+		Spec: crdv1alpha1.RateLimitStrategySpec{
+			Threshold:           10,
+			StatDurationSeconds: 1,
+			ControlBehavior:     crdv1alpha1.WarmUpQueueingControlBehavior,
+			WarmUp:              &crdv1alpha1.RateLimitWarmUp{WarmUpPeriod: "60000ms"},
+			MaxQueueingTime:     "1000ms",
+		},
+	}
+	crdMetadata, _ := GetCrdMetadata(RateLimitStrategyKind)
+	crdMetadata.Defaulter().Default(rls)
+	want := crdv1alpha1.RateLimitStrategySpec{
+		MetricType:          crdv1alpha1.RequestAmountMetricType,
+		LimitMode:           crdv1alpha1.LocalLimitMode,
+		Threshold:           10,
+		StatDurationSeconds: 1,
+		ControlBehavior:     crdv1alpha1.WarmUpQueueingControlBehavior,
+		WarmUp:              &crdv1alpha1.RateLimitWarmUp{WarmUpPeriod: "1min", ColdFactor: crdv1alpha1.DefaultColdFactor},
+		MaxQueueingTime:     "1s",
+	}
+	if !reflect.DeepEqual(rls.Spec, want) {
+		t.Errorf("defaulted spec = %+v, want %+v", rls.Spec, want)
+	}
+	if got := validationErrors(t, RateLimitStrategyKind, rls); len(got) != 0 {
+		t.Errorf("Validate() of the defaulted object = %v", got)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/convert"
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type retryStrategyTranslator struct{}
+
+func (t *retryStrategyTranslator) convert(rs *crdv1alpha1.RetryStrategy) (*pb.RetryStrategy, []error) {
+	var errs []error
+	rule := &pb.RetryStrategy{
+		Name:        rs.Name,
+		MaxAttempts: rs.Spec.MaxAttempts,
+	}
+	if rs.Spec.PerTryTimeout != "" {
+		perTryTimeout, err := convert.ParsePositiveDuration("spec.perTryTimeout", rs.Spec.PerTryTimeout)
+		errs = appendError(errs, err)
+		rule.PerTryTimeoutMillis = perTryTimeout.Millis()
+	}
+	if backoff := rs.Spec.Backoff; backoff != nil {
+		baseInterval, err := convert.ParsePositiveDuration("spec.backoff.baseInterval", backoff.BaseInterval)
+		errs = appendError(errs, err)
+		rule.Backoff = &pb.RetryStrategy_RetryBackoff{
+			BaseIntervalMillis: baseInterval.Millis(),
+			Multiplier:         2,
+		}
+		if backoff.MaxInterval != "" {
+			maxInterval, err := convert.ParsePositiveDuration("spec.backoff.maxInterval", backoff.MaxInterval)
+			errs = appendError(errs, err)
+			rule.Backoff.MaxIntervalMillis = maxInterval.Millis()
+		}
+		if backoff.Multiplier != "" {
+			multiplier, err := convert.ParseDecimal("spec.backoff.multiplier", backoff.Multiplier)
+			errs = appendError(errs, err)
+			rule.Backoff.Multiplier = multiplier
+		}
+	}
+	if retryOn := rs.Spec.RetryOn; retryOn != nil {
+		condition, conditionErrs := convertErrorConditions("spec.retryOn", &retryOn.ErrorConditions)
+		errs = append(errs, conditionErrs...)
+		rule.RetryOn = &pb.RetryStrategy_RetryCondition{
+			GrpcCodes:  condition.GrpcCodes,
+			ErrorTypes: condition.ErrorTypes,
+			OnTimeout:  retryOn.Timeout,
+		}
+		for _, statusCodes := range condition.HttpStatusCodes {
+			rule.RetryOn.HttpStatusCodes = append(rule.RetryOn.HttpStatusCodes, &pb.RetryStrategy_RetryCondition_HttpStatusCodeRange{
+				Min: statusCodes.Min,
+				Max: statusCodes.Max,
+			})
+		}
+	}
+	if budget := rs.Spec.Budget; budget != nil {
+		ratio, err := convert.ParseRatio("spec.budget.ratio", budget.Ratio)
+		errs = appendError(errs, err)
+		rule.Budget = &pb.RetryStrategy_RetryBudget{
+			Ratio:               ratio,
+			MinRetriesPerSecond: budget.MinRetriesPerSecond,
+		}
+	}
+	return rule, errs
+}
+
+func (t *retryStrategyTranslator) Translate(object client.Object) (proto.Message, error) {
+	rs, ok := object.(*crdv1alpha1.RetryStrategy)
+	if !ok {
+		return nil, unexpectedObjectError(object)
+	}
+	rule, errs := t.convert(rs)
+	return rule, translateError(errs)
+}
+
+func (t *retryStrategyTranslator) Validate(object client.Object) field.ErrorList {
+	rs, ok := object.(*crdv1alpha1.RetryStrategy)
+	if !ok {
+		return field.ErrorList{field.InternalError(nil, unexpectedObjectError(object))}
+	}
+	rule, convertErrs := t.convert(rs)
+	errs := fieldErrors(convertErrs)
+	specPath := field.NewPath("spec")
+	if rs.Spec.MaxAttempts <= 0 {
+		errs = append(errs, field.Invalid(specPath.Child("maxAttempts"), rs.Spec.MaxAttempts, "must be positive"))
+	}
+	if backoff := rule.Backoff; backoff != nil && len(convertErrs) == 0 {
+		if backoff.MaxIntervalMillis > 0 && backoff.MaxIntervalMillis < backoff.BaseIntervalMillis {
+			errs = append(errs, field.Invalid(specPath.Child("backoff", "maxInterval"), rs.Spec.Backoff.MaxInterval,
+				"must not be less than baseInterval "+rs.Spec.Backoff.BaseInterval))
+		}
+		if backoff.Multiplier < 1 {
+			errs = append(errs, field.Invalid(specPath.Child("backoff", "multiplier"), rs.Spec.Backoff.Multiplier, "must not be less than 1"))
+		}
+	}
+	if rs.Spec.RetryOn != nil {
+		errs = append(errs, validateErrorTypes(specPath.Child("retryOn"), &rs.Spec.RetryOn.ErrorConditions)...)
+	}
+	if budget := rs.Spec.Budget; budget != nil && budget.MinRetriesPerSecond < 0 {
+		errs = append(errs, field.Invalid(specPath.Child("budget", "minRetriesPerSecond"), budget.MinRetriesPerSecond, "must be non-negative"))
+	}
+	return errs
+}
+
+func (t *retryStrategyTranslator) Default(object client.Object) {
+	rs, ok := object.(*crdv1alpha1.RetryStrategy)
+	if !ok {
+		return
+	}
+	if rs.Spec.PerTryTimeout != "" {
+		normalizeDuration(&rs.Spec.PerTryTimeout)
+	}
+	if backoff := rs.Spec.Backoff; backoff != nil {
+		normalizeDuration(&backoff.BaseInterval)
+		if backoff.MaxInterval != "" {
+			normalizeDuration(&backoff.MaxInterval)
+		}
+	}
+	if rs.Spec.RetryOn != nil {
+		defaultErrorConditions(&rs.Spec.RetryOn.ErrorConditions)
+	}
+	if budget := rs.Spec.Budget; budget != nil {
+		if ratio, err := convert.NormalizeRatio("spec.budget.ratio", budget.Ratio); err == nil {
+			budget.Ratio = ratio
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+)
+
+func TestRetryStrategyValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(spec *crdv1alpha1.RetryStrategySpec)
+		want   []string
+	}{
+		{
+			name:   "attempts only",
+			mutate: func(spec *crdv1alpha1.RetryStrategySpec) {},
+		},
+		{
+			name: "all fields",
+			mutate: func(spec *crdv1alpha1.RetryStrategySpec) {
+				spec.PerTryTimeout = "500ms"
+				spec.Backoff = &crdv1alpha1.RetryBackoff{BaseInterval: "100ms", MaxInterval: "1s", Multiplier: "1.5"}
+				spec.RetryOn = &crdv1alpha1.RetryConditions{
+					ErrorConditions: crdv1alpha1.ErrorConditions{
+						HttpStatusCodes: []crdv1alpha1.HttpStatusCodeRange{"502-504"},
+						GrpcCodes:       []string{"UNAVAILABLE"},
+						ErrorTypes:      []string{"java.net.ConnectException"},
+					},
+					Timeout: true,
+				}
+				spec.Budget = &crdv1alpha1.RetryBudget{Ratio: "20%", MinRetriesPerSecond: 10}
+			},
+		},
+		{
+			name: "max interval equal to base interval",
+			mutate: func(spec *crdv1alpha1.RetryStrategySpec) {
+				spec.Backoff = &crdv1alpha1.RetryBackoff{BaseInterval: "1s", MaxInterval: "1000ms", Multiplier: "1"}
+			},
+		},
+		{
+			name: "non-positive max attempts",
+			mutate: func(spec *crdv1alpha1.RetryStrategySpec) {
+				spec.MaxAttempts = 0
+			},
+			want: []string{"spec.maxAttempts: Invalid value"},
+		},
+		{
+			name: "zero per-try timeout",
+			mutate: func(spec *crdv1alpha1.RetryStrategySpec) {
+				spec.PerTryTimeout = "0ms"
+			},
+			want: []string{"spec.perTryTimeout: Invalid value"},
+		},
+		{
+			name: "backoff without base interval",
+			mutate: func(spec *crdv1alpha1.RetryStrategySpec) {
+				spec.Backoff = &crdv1alpha1.RetryBackoff{}
+			},
+			want: []string{"spec.backoff.baseInterval: Invalid value"},
+		},
+		{
+			name: "max interval less than base interval",
+			mutate: func(spec *crdv1alpha1.RetryStrategySpec) {
+				spec.Backoff = &crdv1alpha1.RetryBackoff{BaseInterval: "1s", MaxInterval: "500ms"}
+			},
+			want: []string{"spec.backoff.maxInterval: Invalid value"},
+		},
+		{
+			name: "multiplier less than 1",
+			mutate: func(spec *crdv1alpha1.RetryStrategySpec) {
+				spec.Backoff = &crdv1alpha1.RetryBackoff{BaseInterval: "100ms", Multiplier: "0.5"}
+			},
+			want: []string{"spec.backoff.multiplier: Invalid value"},
+		},
+		{
+			name: "invalid retry conditions",
+			mutate: func(spec *crdv1alpha1.RetryStrategySpec) {
+				spec.RetryOn = &crdv1alpha1.RetryConditions{
+					ErrorConditions: crdv1alpha1.ErrorConditions{
+						HttpStatusCodes: []crdv1alpha1.HttpStatusCodeRange{"5xx"},
+						GrpcCodes:       []string{"BUSY"},
+						ErrorTypes:      []string{""},
+					},
+				}
+			},
+			want: []string{
+				"spec.retryOn.errorTypes[0]: Required value",
+				"spec.retryOn.grpcCodes[0]: Invalid value",
+				"spec.retryOn.httpStatusCodes[0]: Invalid value",
+			},
+		},
+		{
+			name: "invalid budget",
+			mutate: func(spec *crdv1alpha1.RetryStrategySpec) {
+				spec.Budget = &crdv1alpha1.RetryBudget{Ratio: "150%", MinRetriesPerSecond: -1}
+			},
+			want: []string{"spec.budget.minRetriesPerSecond: Invalid value", "spec.budget.ratio: Invalid value"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := &crdv1alpha1.RetryStrategy{
+				ObjectMeta: newTestObjectMeta("rs"),
+				Spec:       crdv1alpha1.RetryStrategySpec{MaxAttempts: 3},
+			}
+			tt.mutate(&rs.Spec)
+			if got := validationErrors(t, RetryStrategyKind, rs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryStrategyDefault(t *testing.T) {
+	rs := &crdv1alpha1.RetryStrategy{
+		ObjectMeta: newTestObjectMeta("rs"),
+		Spec: crdv1alpha1.RetryStrategySpec{
+			MaxAttempts:   3,
+			PerTryTimeout: "1000ms",
+			Backoff:       &crdv1alpha1.RetryBackoff{BaseInterval: "60s", MaxInterval: "120s"},
+			RetryOn: &crdv1alpha1.RetryConditions{
+				ErrorConditions: crdv1alpha1.ErrorConditions{GrpcCodes: []string{"unavailable"}},
+			},
+			Budget: &crdv1alpha1.RetryBudget{Ratio: "0.2"},
+		},
+	}
+	crdMetadata, _ := GetCrdMetadata(RetryStrategyKind)
+	crdMetadata.Defaulter().Default(rs)
+	want := crdv1alpha1.RetryStrategySpec{
+		MaxAttempts:   3,
+		PerTryTimeout: "1s",
+		Backoff:       &crdv1alpha1.RetryBackoff{BaseInterval: "1min", MaxInterval: "2min"},
+		RetryOn: &crdv1alpha1.RetryConditions{
+			ErrorConditions: crdv1alpha1.ErrorConditions{GrpcCodes: []string{"UNAVAILABLE"}},
+		},
+		Budget: &crdv1alpha1.RetryBudget{Ratio: "20%"},
+	}
+	if !reflect.DeepEqual(rs.Spec, want) {
+		t.Errorf("defaulted spec = %+v, want %+v", rs.Spec, want)
+	}
+}
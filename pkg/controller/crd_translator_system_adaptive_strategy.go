@@ -0,0 +1,100 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/convert"
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type systemAdaptiveStrategyTranslator struct{}
+
+func (t *systemAdaptiveStrategyTranslator) convert(sas *crdv1alpha1.SystemAdaptiveStrategy) (*pb.SystemAdaptiveStrategy, []error) {
+	var errs []error
+	rule := &pb.SystemAdaptiveStrategy{
+		Name:           sas.Name,
+		MaxInboundQps:  sas.Spec.MaxInboundQps,
+		MaxConcurrency: sas.Spec.MaxConcurrency,
+	}
+	if sas.Spec.MaxCpuUsage != "" {
+		maxCpuUsage, err := convert.ParseRatio("spec.maxCpuUsage", sas.Spec.MaxCpuUsage)
+		errs = appendError(errs, err)
+		rule.MaxCpuUsage = maxCpuUsage
+	}
+	if sas.Spec.MaxSystemLoad != "" {
+		maxSystemLoad, err := convert.ParseDecimal("spec.maxSystemLoad", sas.Spec.MaxSystemLoad)
+		errs = appendError(errs, err)
+		rule.MaxSystemLoad = maxSystemLoad
+	}
+	if sas.Spec.MaxAvgRt != "" {
+		maxAvgRt, err := convert.ParsePositiveDuration("spec.maxAvgRt", sas.Spec.MaxAvgRt)
+		errs = appendError(errs, err)
+		rule.MaxAvgRtMillis = maxAvgRt.Millis()
+	}
+	return rule, errs
+}
+
+func (t *systemAdaptiveStrategyTranslator) Translate(object client.Object) (proto.Message, error) {
+	sas, ok := object.(*crdv1alpha1.SystemAdaptiveStrategy)
+	if !ok {
+		return nil, unexpectedObjectError(object)
+	}
+	rule, errs := t.convert(sas)
+	return rule, translateError(errs)
+}
+
+func (t *systemAdaptiveStrategyTranslator) Validate(object client.Object) field.ErrorList {
+	sas, ok := object.(*crdv1alpha1.SystemAdaptiveStrategy)
+	if !ok {
+		return field.ErrorList{field.InternalError(nil, unexpectedObjectError(object))}
+	}
+	_, convertErrs := t.convert(sas)
+	errs := fieldErrors(convertErrs)
+	specPath := field.NewPath("spec")
+	if sas.Spec.MaxInboundQps < 0 {
+		errs = append(errs, field.Invalid(specPath.Child("maxInboundQps"), sas.Spec.MaxInboundQps, "must be positive"))
+	}
+	if sas.Spec.MaxConcurrency < 0 {
+		errs = append(errs, field.Invalid(specPath.Child("maxConcurrency"), sas.Spec.MaxConcurrency, "must be positive"))
+	}
+	if sas.Spec.MaxCpuUsage == "" && sas.Spec.MaxSystemLoad == "" && sas.Spec.MaxAvgRt == "" &&
+		sas.Spec.MaxInboundQps == 0 && sas.Spec.MaxConcurrency == 0 {
+		errs = append(errs, field.Required(specPath, "at least one threshold must be set"))
+	}
+	// The strategy applies to the whole app, which is identified by the app label.
+	if sas.Labels["app"] == "" {
+		errs = append(errs, field.Required(field.NewPath("metadata", "labels").Key("app"), "the app to protect"))
+	}
+	return errs
+}
+
+func (t *systemAdaptiveStrategyTranslator) Default(object client.Object) {
+	sas, ok := object.(*crdv1alpha1.SystemAdaptiveStrategy)
+	if !ok {
+		return
+	}
+	if sas.Spec.MaxCpuUsage != "" {
+		if ratio, err := convert.NormalizeRatio("spec.maxCpuUsage", sas.Spec.MaxCpuUsage); err == nil {
+			sas.Spec.MaxCpuUsage = ratio
+		}
+	}
+	if sas.Spec.MaxAvgRt != "" {
+		normalizeDuration(&sas.Spec.MaxAvgRt)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+)
+
+func TestSystemAdaptiveStrategyValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		spec  crdv1alpha1.SystemAdaptiveStrategySpec
+		noApp bool
+		want  []string
+	}{
+		{name: "CPU usage", spec: crdv1alpha1.SystemAdaptiveStrategySpec{MaxCpuUsage: "80%"}},
+		{name: "system load", spec: crdv1alpha1.SystemAdaptiveStrategySpec{MaxSystemLoad: "2.5"}},
+		{name: "average RT", spec: crdv1alpha1.SystemAdaptiveStrategySpec{MaxAvgRt: "200ms"}},
+		{name: "inbound QPS", spec: crdv1alpha1.SystemAdaptiveStrategySpec{MaxInboundQps: 1000}},
+		{name: "concurrency", spec: crdv1alpha1.SystemAdaptiveStrategySpec{MaxConcurrency: 100}},
+		{
+			name: "all thresholds",
+			spec: crdv1alpha1.SystemAdaptiveStrategySpec{
+				MaxCpuUsage:    "0.8",
+				MaxSystemLoad:  "8",
+				MaxAvgRt:       "1s",
+				MaxInboundQps:  1000,
+				MaxConcurrency: 100,
+			},
+		},
+		{
+			name: "no threshold",
+			want: []string{"spec: Required value"},
+		},
+		{
+			name:  "no app label",
+			spec:  crdv1alpha1.SystemAdaptiveStrategySpec{MaxCpuUsage: "80%"},
+			noApp: true,
+			want:  []string{"metadata.labels[app]: Required value"},
+		},
+		{
+			name: "invalid thresholds",
+			spec: crdv1alpha1.SystemAdaptiveStrategySpec{
+				MaxCpuUsage:    "120%",
+				MaxSystemLoad:  "high",
+				MaxAvgRt:       "0ms",
+				MaxInboundQps:  -1,
+				MaxConcurrency: -1,
+			},
+			want: []string{
+				"spec.maxAvgRt: Invalid value",
+				"spec.maxConcurrency: Invalid value",
+				"spec.maxCpuUsage: Invalid value",
+				"spec.maxInboundQps: Invalid value",
+				"spec.maxSystemLoad: Invalid value",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sas := &crdv1alpha1.SystemAdaptiveStrategy{ObjectMeta: newTestObjectMeta("sas"), Spec: tt.spec}
+			if tt.noApp {
+				sas.Labels = nil
+			}
+			if got := validationErrors(t, SystemAdaptiveStrategyKind, sas); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSystemAdaptiveStrategyDefault(t *testing.T) {
+	sas := &crdv1alpha1.SystemAdaptiveStrategy{
+		ObjectMeta: newTestObjectMeta("sas"),
+		Spec:       crdv1alpha1.SystemAdaptiveStrategySpec{MaxCpuUsage: "0.8", MaxAvgRt: "1000ms", MaxSystemLoad: "2.50"},
+	}
+	crdMetadata, _ := GetCrdMetadata(SystemAdaptiveStrategyKind)
+	crdMetadata.Defaulter().Default(sas)
+	want := crdv1alpha1.SystemAdaptiveStrategySpec{MaxCpuUsage: "80%", MaxAvgRt: "1s", MaxSystemLoad: "2.50"}
+	if !reflect.DeepEqual(sas.Spec, want) {
+		t.Errorf("defaulted spec = %+v, want %+v", sas.Spec, want)
+	}
+}
@@ -0,0 +1,697 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"sort"
+	"testing"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/convert"
+	commonpb "github.com/opensergo/opensergo-control-plane/pkg/proto/common/v1"
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newTestObjectMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Namespace: testNamespacedApp.Namespace,
+		Name:      name,
+		Labels:    map[string]string{"app": testNamespacedApp.App},
+	}
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+// translate translates the object with the translator of the kind.
+func translate(t *testing.T, kind CRDKind, object client.Object) (proto.Message, error) {
+	t.Helper()
+	crdMetadata, exists := GetCrdMetadata(kind)
+	if !exists {
+		t.Fatalf("kind %s is not registered", kind)
+	}
+	return crdMetadata.Translator().Translate(object)
+}
+
+// conversionErrorFields returns the sorted fields of the conversion errors aggregated in err.
+func conversionErrorFields(t *testing.T, err error) []string {
+	t.Helper()
+	var aggregate utilerrors.Aggregate
+	if !errors.As(err, &aggregate) {
+		t.Fatalf("error = %v, want an aggregate of conversion errors", err)
+	}
+	var fields []string
+	for _, e := range aggregate.Errors() {
+		var convertErr *convert.ConversionError
+		if !errors.As(e, &convertErr) {
+			t.Fatalf("error = %v, want a *convert.ConversionError", e)
+		}
+		fields = append(fields, convertErr.Field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// validationErrors validates the object with the translator of the kind, and returns the sorted errors
+// in the form of "<field>: <type>", e.g. "spec.timeout: Invalid value".
+func validationErrors(t *testing.T, kind CRDKind, object client.Object) []string {
+	t.Helper()
+	crdMetadata, exists := GetCrdMetadata(kind)
+	if !exists {
+		t.Fatalf("kind %s is not registered", kind)
+	}
+	var errs []string
+	for _, fieldErr := range crdMetadata.Translator().Validate(object) {
+		errs = append(errs, fieldErr.Field+": "+fieldErr.Type.String())
+	}
+	sort.Strings(errs)
+	return errs
+}
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name   string
+		kind   CRDKind
+		object client.Object
+		want   proto.Message
+	}{
+		{
+			name: "FaultToleranceRule",
+			kind: FaultToleranceRuleKind,
+			object: &crdv1alpha1.FaultToleranceRule{
+				ObjectMeta: newTestObjectMeta("ftr"),
+				Spec: crdv1alpha1.FaultToleranceRuleSpec{
+					Targets: []crdv1alpha1.FaultToleranceTargetRef{
+						{TargetResourceName: "/foo"},
+						{
+							TargetResourceName: "/api/**",
+							MatchType:          crdv1alpha1.GlobMatchType,
+							Priority:           10,
+							Sources: []crdv1alpha1.FaultToleranceSource{{
+								App:         &crdv1alpha1.StringMatch{Exact: "bar-app"},
+								Namespace:   &crdv1alpha1.StringMatch{Prefix: "prod-"},
+								Method:      &crdv1alpha1.StringMatch{Regex: "GET|HEAD"},
+								Headers:     map[string]crdv1alpha1.StringMatch{"X-User": {Exact: "vip"}},
+								QueryParams: map[string]crdv1alpha1.StringMatch{"debug": {Exact: "true"}},
+							}},
+						},
+					},
+					Strategies: []crdv1alpha1.FaultToleranceStrategyRef{{Name: "rls", Kind: crdv1alpha1.RateLimitStrategyKind}},
+					Action:     &crdv1alpha1.FaultToleranceActionRef{Name: "fallback", Kind: crdv1alpha1.FallbackActionKind},
+				},
+			},
+			want: &pb.FaultToleranceRule{
+				Targets: []*pb.FaultToleranceRule_FaultToleranceRuleTargetRef{
+					{TargetResourceName: "/foo", MatchType: pb.FaultToleranceRule_MATCH_EXACT},
+					{
+						TargetResourceName: "/api/**",
+						MatchType:          pb.FaultToleranceRule_MATCH_GLOB,
+						Priority:           10,
+						Sources: []*pb.FaultToleranceRule_FaultToleranceRuleSource{{
+							App:         &pb.StringMatch{Exact: "bar-app"},
+							Namespace:   &pb.StringMatch{Prefix: "prod-"},
+							Method:      &pb.StringMatch{Regex: "GET|HEAD"},
+							Headers:     map[string]*pb.StringMatch{"X-User": {Exact: "vip"}},
+							QueryParams: map[string]*pb.StringMatch{"debug": {Exact: "true"}},
+						}},
+					},
+				},
+				Strategies: []*pb.FaultToleranceRule_FaultToleranceStrategyRef{{Name: "rls", Kind: crdv1alpha1.RateLimitStrategyKind}},
+				Action:     &pb.FaultToleranceRule_FaultToleranceActionRef{Name: "fallback", Kind: crdv1alpha1.FallbackActionKind},
+			},
+		},
+		{
+			name: "RateLimitStrategy",
+			kind: RateLimitStrategyKind,
+			object: &crdv1alpha1.RateLimitStrategy{
+				ObjectMeta: newTestObjectMeta("rls"),
+				Spec: crdv1alpha1.RateLimitStrategySpec{
+					MetricType:          crdv1alpha1.RequestAmountMetricType,
+					LimitMode:           crdv1alpha1.GlobalLimitMode,
+					Threshold:           100,
+					StatDurationSeconds: 2,
+					ControlBehavior:     crdv1alpha1.WarmUpQueueingControlBehavior,
+					WarmUp:              &crdv1alpha1.RateLimitWarmUp{WarmUpPeriod: "1min"},
+					MaxQueueingTime:     "500ms",
+				},
+			},
+			want: &pb.RateLimitStrategy{
+				Name:                 "rls",
+				MetricType:           pb.RateLimitStrategy_TYPE_REQUEST_AMOUNT,
+				LimitMode:            pb.RateLimitStrategy_MODE_GLOBAL,
+				Threshold:            100,
+				StatDuration:         2,
+				StatDurationTimeUnit: commonpb.TimeUnit_SECOND,
+				ControlBehavior:      pb.RateLimitStrategy_BEHAVIOR_WARM_UP_QUEUEING,
+				WarmUp:               &pb.RateLimitStrategy_WarmUp{ColdFactor: crdv1alpha1.DefaultColdFactor, WarmUpPeriodMillis: 60000},
+				Queueing:             &pb.RateLimitStrategy_Queueing{MaxQueueingTimeMillis: 500},
+			},
+		},
+		{
+			name: "ThrottlingStrategy",
+			kind: ThrottlingStrategyKind,
+			object: &crdv1alpha1.ThrottlingStrategy{
+				ObjectMeta: newTestObjectMeta("ts"),
+				Spec:       crdv1alpha1.ThrottlingStrategySpec{MinIntervalOfRequests: "20ms", QueueTimeout: "1s"},
+			},
+			want: &pb.ThrottlingStrategy{Name: "ts", MinIntervalMillisOfRequests: 20, QueueTimeoutMillis: 1000},
+		},
+		{
+			name: "static ConcurrencyLimitStrategy",
+			kind: ConcurrencyLimitStrategyKind,
+			object: &crdv1alpha1.ConcurrencyLimitStrategy{
+				ObjectMeta: newTestObjectMeta("cls"),
+				Spec:       crdv1alpha1.ConcurrencyLimitStrategySpec{MaxConcurrencyThreshold: 8, LimitMode: crdv1alpha1.LocalLimitMode},
+			},
+			want: &pb.ConcurrencyLimitStrategy{Name: "cls", LimitMode: pb.ConcurrencyLimitStrategy_MODE_LOCAL, MaxConcurrency: 8},
+		},
+		{
+			name: "adaptive ConcurrencyLimitStrategy",
+			kind: ConcurrencyLimitStrategyKind,
+			object: &crdv1alpha1.ConcurrencyLimitStrategy{
+				ObjectMeta: newTestObjectMeta("cls"),
+				Spec: crdv1alpha1.ConcurrencyLimitStrategySpec{
+					LimitMode: crdv1alpha1.GlobalLimitMode,
+					Adaptive: &crdv1alpha1.AdaptiveConcurrencyLimit{
+						Algorithm:      crdv1alpha1.VegasAdaptiveAlgorithm,
+						MinConcurrency: 2,
+						MaxConcurrency: 64,
+						Smoothing:      "0.5",
+						ProbeInterval:  "1min",
+					},
+				},
+			},
+			want: &pb.ConcurrencyLimitStrategy{
+				Name:           "cls",
+				LimitMode:      pb.ConcurrencyLimitStrategy_MODE_GLOBAL,
+				MaxConcurrency: 64,
+				Adaptive: &pb.ConcurrencyLimitStrategy_AdaptiveLimit{
+					Algorithm:           pb.ConcurrencyLimitStrategy_ALGORITHM_VEGAS,
+					MinConcurrency:      2,
+					MaxConcurrency:      64,
+					Smoothing:           0.5,
+					ProbeIntervalMillis: 60000,
+				},
+			},
+		},
+		{
+			name: "CircuitBreakerStrategy",
+			kind: CircuitBreakerStrategyKind,
+			object: &crdv1alpha1.CircuitBreakerStrategy{
+				ObjectMeta: newTestObjectMeta("cbs"),
+				Spec: crdv1alpha1.CircuitBreakerStrategySpec{
+					Strategy:         convert.CircuitBreakerStrategySlowRequestRatio,
+					TriggerRatio:     "60%",
+					TriggerCount:     3,
+					StatDuration:     "30s",
+					RecoveryTimeout:  "1min",
+					MinRequestAmount: 5,
+					SlowConditions:   crdv1alpha1.SlowConditions{MaxAllowedRt: "500ms"},
+					ErrorConditions: crdv1alpha1.ErrorConditions{
+						HttpStatusCodes: []crdv1alpha1.HttpStatusCodeRange{"503", "500-504"},
+						GrpcCodes:       []string{"UNAVAILABLE"},
+						ErrorTypes:      []string{"java.io.IOException"},
+					},
+				},
+			},
+			want: &pb.CircuitBreakerStrategy{
+				Name:                    "cbs",
+				Strategy:                pb.CircuitBreakerStrategy_STRATEGY_SLOW_REQUEST_RATIO,
+				TriggerRatio:            0.6,
+				TriggerCount:            3,
+				StatDuration:            30,
+				StatDurationTimeUnit:    commonpb.TimeUnit_SECOND,
+				RecoveryTimeout:         1,
+				RecoveryTimeoutTimeUnit: commonpb.TimeUnit_MINUTE,
+				MinRequestAmount:        5,
+				SlowCondition:           &pb.CircuitBreakerStrategy_CircuitBreakerSlowCondition{MaxAllowedRtMillis: 500},
+				ErrorCondition: &pb.CircuitBreakerStrategy_CircuitBreakerErrorCondition{
+					HttpStatusCodes: []*pb.CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange{
+						{Min: 503, Max: 503},
+						{Min: 500, Max: 504},
+					},
+					GrpcCodes:  []int32{14},
+					ErrorTypes: []string{"java.io.IOException"},
+				},
+			},
+		},
+		{
+			name: "CircuitBreakerStrategy by error count",
+			kind: CircuitBreakerStrategyKind,
+			object: &crdv1alpha1.CircuitBreakerStrategy{
+				ObjectMeta: newTestObjectMeta("cbs"),
+				Spec: crdv1alpha1.CircuitBreakerStrategySpec{
+					Strategy:         convert.CircuitBreakerStrategyErrorRequestCount,
+					TriggerCount:     10,
+					StatDuration:     "1s",
+					RecoveryTimeout:  "5s",
+					MinRequestAmount: 1,
+				},
+			},
+			want: &pb.CircuitBreakerStrategy{
+				Name:                    "cbs",
+				Strategy:                pb.CircuitBreakerStrategy_STRATEGY_ERROR_REQUEST_COUNT,
+				TriggerCount:            10,
+				StatDuration:            1,
+				StatDurationTimeUnit:    commonpb.TimeUnit_SECOND,
+				RecoveryTimeout:         5,
+				RecoveryTimeoutTimeUnit: commonpb.TimeUnit_SECOND,
+				MinRequestAmount:        1,
+			},
+		},
+		{
+			name: "ParamFlowStrategy",
+			kind: ParamFlowStrategyKind,
+			object: &crdv1alpha1.ParamFlowStrategy{
+				ObjectMeta: newTestObjectMeta("pfs"),
+				Spec: crdv1alpha1.ParamFlowStrategySpec{
+					ParamSource:         crdv1alpha1.ArgIndexParamSource,
+					ParamKey:            "uid",
+					ParamIndex:          int32Ptr(1),
+					LimitMode:           crdv1alpha1.GlobalLimitMode,
+					Threshold:           10,
+					StatDurationSeconds: 3,
+					Exceptions:          []crdv1alpha1.ParamException{{Value: "vip", Threshold: 100}},
+				},
+			},
+			want: &pb.ParamFlowStrategy{
+				Name:                 "pfs",
+				ParamSource:          pb.ParamFlowStrategy_SOURCE_ARG_INDEX,
+				ParamKey:             "uid",
+				ParamIndex:           1,
+				LimitMode:            pb.ParamFlowStrategy_MODE_GLOBAL,
+				Threshold:            10,
+				StatDuration:         3,
+				StatDurationTimeUnit: commonpb.TimeUnit_SECOND,
+				Exceptions:           []*pb.ParamFlowStrategy_ParamException{{Value: "vip", Threshold: 100}},
+			},
+		},
+		{
+			name: "SystemAdaptiveStrategy",
+			kind: SystemAdaptiveStrategyKind,
+			object: &crdv1alpha1.SystemAdaptiveStrategy{
+				ObjectMeta: newTestObjectMeta("sas"),
+				Spec: crdv1alpha1.SystemAdaptiveStrategySpec{
+					MaxCpuUsage:    "80%",
+					MaxSystemLoad:  "2.5",
+					MaxAvgRt:       "200ms",
+					MaxInboundQps:  1000,
+					MaxConcurrency: 50,
+				},
+			},
+			want: &pb.SystemAdaptiveStrategy{
+				Name:           "sas",
+				MaxCpuUsage:    0.8,
+				MaxSystemLoad:  2.5,
+				MaxAvgRtMillis: 200,
+				MaxInboundQps:  1000,
+				MaxConcurrency: 50,
+			},
+		},
+		{
+			name: "RetryStrategy",
+			kind: RetryStrategyKind,
+			object: &crdv1alpha1.RetryStrategy{
+				ObjectMeta: newTestObjectMeta("rs"),
+				Spec: crdv1alpha1.RetryStrategySpec{
+					MaxAttempts:   3,
+					PerTryTimeout: "1s",
+					Backoff:       &crdv1alpha1.RetryBackoff{BaseInterval: "100ms", MaxInterval: "2s", Multiplier: "1.5"},
+					RetryOn: &crdv1alpha1.RetryConditions{
+						ErrorConditions: crdv1alpha1.ErrorConditions{
+							HttpStatusCodes: []crdv1alpha1.HttpStatusCodeRange{"502-504"},
+							GrpcCodes:       []string{"UNAVAILABLE", "ABORTED"},
+							ErrorTypes:      []string{"ConnectException"},
+						},
+						Timeout: true,
+					},
+					Budget: &crdv1alpha1.RetryBudget{Ratio: "20%", MinRetriesPerSecond: 10},
+				},
+			},
+			want: &pb.RetryStrategy{
+				Name:                "rs",
+				MaxAttempts:         3,
+				PerTryTimeoutMillis: 1000,
+				Backoff:             &pb.RetryStrategy_RetryBackoff{BaseIntervalMillis: 100, MaxIntervalMillis: 2000, Multiplier: 1.5},
+				RetryOn: &pb.RetryStrategy_RetryCondition{
+					HttpStatusCodes: []*pb.RetryStrategy_RetryCondition_HttpStatusCodeRange{{Min: 502, Max: 504}},
+					GrpcCodes:       []int32{14, 10},
+					ErrorTypes:      []string{"ConnectException"},
+					OnTimeout:       true,
+				},
+				Budget: &pb.RetryStrategy_RetryBudget{Ratio: 0.2, MinRetriesPerSecond: 10},
+			},
+		},
+		{
+			name: "RetryStrategy with the default multiplier",
+			kind: RetryStrategyKind,
+			object: &crdv1alpha1.RetryStrategy{
+				ObjectMeta: newTestObjectMeta("rs"),
+				Spec: crdv1alpha1.RetryStrategySpec{
+					MaxAttempts: 2,
+					Backoff:     &crdv1alpha1.RetryBackoff{BaseInterval: "10ms"},
+				},
+			},
+			want: &pb.RetryStrategy{
+				Name:        "rs",
+				MaxAttempts: 2,
+				Backoff:     &pb.RetryStrategy_RetryBackoff{BaseIntervalMillis: 10, Multiplier: 2},
+			},
+		},
+		{
+			name: "TimeoutStrategy",
+			kind: TimeoutStrategyKind,
+			object: &crdv1alpha1.TimeoutStrategy{
+				ObjectMeta: newTestObjectMeta("ts"),
+				Spec:       crdv1alpha1.TimeoutStrategySpec{Timeout: "3s"},
+			},
+			want: &pb.TimeoutStrategy{Name: "ts", TimeoutMillis: 3000},
+		},
+		{
+			name: "FallbackAction with an HTTP response",
+			kind: FallbackActionKind,
+			object: &crdv1alpha1.FallbackAction{
+				ObjectMeta: newTestObjectMeta("fa"),
+				Spec: crdv1alpha1.FallbackActionSpec{
+					HttpResponse: &crdv1alpha1.FallbackHttpResponse{
+						StatusCode: 429,
+						Headers:    map[string]string{"Retry-After": "1"},
+						Body:       "too many requests",
+					},
+				},
+			},
+			want: &pb.FallbackAction{
+				Name: "fa",
+				HttpResponse: &pb.FallbackAction_HttpResponse{
+					StatusCode: 429,
+					Headers:    map[string]string{"Retry-After": "1"},
+					Body:       "too many requests",
+				},
+			},
+		},
+		{
+			name: "FallbackAction with a gRPC response",
+			kind: FallbackActionKind,
+			object: &crdv1alpha1.FallbackAction{
+				ObjectMeta: newTestObjectMeta("fa"),
+				Spec: crdv1alpha1.FallbackActionSpec{
+					GrpcResponse: &crdv1alpha1.FallbackGrpcResponse{Code: "resource_exhausted", Message: "busy"},
+				},
+			},
+			want: &pb.FallbackAction{
+				Name:         "fa",
+				GrpcResponse: &pb.FallbackAction_GrpcResponse{Code: 8, Message: "busy"},
+			},
+		},
+		{
+			name: "FallbackAction with a fallback resource",
+			kind: FallbackActionKind,
+			object: &crdv1alpha1.FallbackAction{
+				ObjectMeta: newTestObjectMeta("fa"),
+				Spec: crdv1alpha1.FallbackActionSpec{
+					FallbackResource: &crdv1alpha1.FallbackResourceRef{TargetResourceName: "/degraded"},
+				},
+			},
+			want: &pb.FallbackAction{
+				Name:             "fa",
+				FallbackResource: &pb.FallbackAction_FallbackResourceRef{TargetResourceName: "/degraded"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := translate(t, tt.kind, tt.object)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("Translate() = %v, want %v", got, tt.want)
+			}
+			// The translated rule satisfies the constraints of the proto.
+			if _, err := TranslateObject(tt.kind, tt.object); err != nil {
+				t.Errorf("TranslateObject() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestTranslateConversionErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		kind   CRDKind
+		object client.Object
+		fields []string
+	}{
+		{
+			name: "FaultToleranceRule",
+			kind: FaultToleranceRuleKind,
+			object: &crdv1alpha1.FaultToleranceRule{
+				ObjectMeta: newTestObjectMeta("ftr"),
+				Spec: crdv1alpha1.FaultToleranceRuleSpec{
+					Targets: []crdv1alpha1.FaultToleranceTargetRef{
+						{TargetResourceName: "/foo", MatchType: "Prefix"},
+						{
+							TargetResourceName: "(",
+							MatchType:          crdv1alpha1.RegexMatchType,
+							Sources: []crdv1alpha1.FaultToleranceSource{{
+								App:         &crdv1alpha1.StringMatch{},
+								Namespace:   &crdv1alpha1.StringMatch{Exact: "a", Prefix: "b"},
+								Method:      &crdv1alpha1.StringMatch{Regex: "["},
+								Headers:     map[string]crdv1alpha1.StringMatch{"b": {}, "a": {}},
+								QueryParams: map[string]crdv1alpha1.StringMatch{"q": {Regex: "*"}},
+							}},
+						},
+					},
+				},
+			},
+			fields: []string{
+				"spec.targets[0].matchType",
+				"spec.targets[1].sources[0].app",
+				"spec.targets[1].sources[0].headers[a]",
+				"spec.targets[1].sources[0].headers[b]",
+				"spec.targets[1].sources[0].method.regex",
+				"spec.targets[1].sources[0].namespace",
+				"spec.targets[1].sources[0].queryParams[q].regex",
+				"spec.targets[1].targetResourceName",
+			},
+		},
+		{
+			name: "RateLimitStrategy",
+			kind: RateLimitStrategyKind,
+			object: &crdv1alpha1.RateLimitStrategy{
+				ObjectMeta: newTestObjectMeta("rls"),
+				Spec: crdv1alpha1.RateLimitStrategySpec{
+					MetricType:      "Concurrency",
+					LimitMode:       "Cluster",
+					ControlBehavior: "Throttling",
+					WarmUp:          &crdv1alpha1.RateLimitWarmUp{WarmUpPeriod: "0s"},
+					MaxQueueingTime: "1 s",
+				},
+			},
+			fields: []string{"spec.controlBehavior", "spec.limitMode", "spec.maxQueueingTime", "spec.metricType", "spec.warmUp.warmUpPeriod"},
+		},
+		{
+			name: "ThrottlingStrategy",
+			kind: ThrottlingStrategyKind,
+			object: &crdv1alpha1.ThrottlingStrategy{
+				ObjectMeta: newTestObjectMeta("ts"),
+				Spec:       crdv1alpha1.ThrottlingStrategySpec{MinIntervalOfRequests: "10", QueueTimeout: "0ms"},
+			},
+			fields: []string{"spec.minIntervalOfRequests", "spec.queueTimeout"},
+		},
+		{
+			name: "ConcurrencyLimitStrategy",
+			kind: ConcurrencyLimitStrategyKind,
+			object: &crdv1alpha1.ConcurrencyLimitStrategy{
+				ObjectMeta: newTestObjectMeta("cls"),
+				Spec: crdv1alpha1.ConcurrencyLimitStrategySpec{
+					LimitMode: "",
+					Adaptive:  &crdv1alpha1.AdaptiveConcurrencyLimit{Algorithm: "AIMD", Smoothing: "x", ProbeInterval: "0s"},
+				},
+			},
+			fields: []string{"spec.adaptive.algorithm", "spec.adaptive.probeInterval", "spec.adaptive.smoothing", "spec.limitMode"},
+		},
+		{
+			name: "CircuitBreakerStrategy",
+			kind: CircuitBreakerStrategyKind,
+			object: &crdv1alpha1.CircuitBreakerStrategy{
+				ObjectMeta: newTestObjectMeta("cbs"),
+				Spec: crdv1alpha1.CircuitBreakerStrategySpec{
+					Strategy:        "ErrorCount",
+					TriggerRatio:    "150%",
+					StatDuration:    "",
+					RecoveryTimeout: "3000000000s",
+					SlowConditions:  crdv1alpha1.SlowConditions{MaxAllowedRt: "30d"},
+					ErrorConditions: crdv1alpha1.ErrorConditions{
+						HttpStatusCodes: []crdv1alpha1.HttpStatusCodeRange{"5xx"},
+						GrpcCodes:       []string{"OK", "TIMEOUT"},
+					},
+				},
+			},
+			fields: []string{
+				"spec.errorConditions.grpcCodes[1]",
+				"spec.errorConditions.httpStatusCodes[0]",
+				"spec.recoveryTimeout",
+				"spec.slowConditions.maxAllowedRt",
+				"spec.statDuration",
+				"spec.strategy",
+				"spec.triggerRatio",
+			},
+		},
+		{
+			name: "ParamFlowStrategy",
+			kind: ParamFlowStrategyKind,
+			object: &crdv1alpha1.ParamFlowStrategy{
+				ObjectMeta: newTestObjectMeta("pfs"),
+				Spec:       crdv1alpha1.ParamFlowStrategySpec{ParamSource: "Cookie", LimitMode: "Cluster"},
+			},
+			fields: []string{"spec.limitMode", "spec.paramSource"},
+		},
+		{
+			name: "SystemAdaptiveStrategy",
+			kind: SystemAdaptiveStrategyKind,
+			object: &crdv1alpha1.SystemAdaptiveStrategy{
+				ObjectMeta: newTestObjectMeta("sas"),
+				Spec:       crdv1alpha1.SystemAdaptiveStrategySpec{MaxCpuUsage: "NaN", MaxSystemLoad: "-1", MaxAvgRt: "0ms"},
+			},
+			fields: []string{"spec.maxAvgRt", "spec.maxCpuUsage", "spec.maxSystemLoad"},
+		},
+		{
+			name: "RetryStrategy",
+			kind: RetryStrategyKind,
+			object: &crdv1alpha1.RetryStrategy{
+				ObjectMeta: newTestObjectMeta("rs"),
+				Spec: crdv1alpha1.RetryStrategySpec{
+					MaxAttempts:   3,
+					PerTryTimeout: "0s",
+					Backoff:       &crdv1alpha1.RetryBackoff{BaseInterval: "", MaxInterval: "1y", Multiplier: "twice"},
+					RetryOn: &crdv1alpha1.RetryConditions{
+						ErrorConditions: crdv1alpha1.ErrorConditions{
+							HttpStatusCodes: []crdv1alpha1.HttpStatusCodeRange{"599-500"},
+							GrpcCodes:       []string{"BUSY"},
+						},
+					},
+					Budget: &crdv1alpha1.RetryBudget{Ratio: "1.5"},
+				},
+			},
+			fields: []string{
+				"spec.backoff.baseInterval",
+				"spec.backoff.maxInterval",
+				"spec.backoff.multiplier",
+				"spec.budget.ratio",
+				"spec.perTryTimeout",
+				"spec.retryOn.grpcCodes[0]",
+				"spec.retryOn.httpStatusCodes[0]",
+			},
+		},
+		{
+			name: "TimeoutStrategy",
+			kind: TimeoutStrategyKind,
+			object: &crdv1alpha1.TimeoutStrategy{
+				ObjectMeta: newTestObjectMeta("ts"),
+				Spec:       crdv1alpha1.TimeoutStrategySpec{Timeout: "3"},
+			},
+			fields: []string{"spec.timeout"},
+		},
+		{
+			name: "FallbackAction",
+			kind: FallbackActionKind,
+			object: &crdv1alpha1.FallbackAction{
+				ObjectMeta: newTestObjectMeta("fa"),
+				Spec: crdv1alpha1.FallbackActionSpec{
+					GrpcResponse: &crdv1alpha1.FallbackGrpcResponse{Code: "503"},
+				},
+			},
+			fields: []string{"spec.grpcResponse.code"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := translate(t, tt.kind, tt.object)
+			got := conversionErrorFields(t, err)
+			sort.Strings(tt.fields)
+			if len(got) != len(tt.fields) {
+				t.Fatalf("fields = %v, want %v", got, tt.fields)
+			}
+			for i := range got {
+				if got[i] != tt.fields[i] {
+					t.Fatalf("fields = %v, want %v", got, tt.fields)
+				}
+			}
+			// Every conversion error is reported as a field error by validation as well.
+			crdMetadata, _ := GetCrdMetadata(tt.kind)
+			reported := make(map[string]bool)
+			for _, fieldErr := range crdMetadata.Translator().Validate(tt.object) {
+				reported[fieldErr.Field] = true
+			}
+			for _, f := range tt.fields {
+				if !reported[f] {
+					t.Errorf("field %s is not reported by Validate()", f)
+				}
+			}
+		})
+	}
+}
+
+func TestTranslateObject(t *testing.T) {
+	if rule, err := TranslateObject("example.com/v1/Foo", &crdv1alpha1.ThrottlingStrategy{}); rule != nil || err != nil {
+		t.Errorf("TranslateObject() of an unknown kind = %v, %v, want nil", rule, err)
+	}
+	if _, err := TranslateObject(ThrottlingStrategyKind, &corev1.ConfigMap{}); err == nil {
+		t.Error("TranslateObject() of an unexpected object succeeds")
+	}
+	invalid := &crdv1alpha1.ThrottlingStrategy{
+		ObjectMeta: newTestObjectMeta("ts"),
+		Spec:       crdv1alpha1.ThrottlingStrategySpec{MinIntervalOfRequests: "1x", QueueTimeout: "1s"},
+	}
+	if _, err := TranslateObject(ThrottlingStrategyKind, invalid); err == nil {
+		t.Error("TranslateObject() of an invalid object succeeds")
+	}
+	// The objects which can be translated but fail the validation of the kind are rejected too.
+	cbs := &crdv1alpha1.CircuitBreakerStrategy{
+		ObjectMeta: newTestObjectMeta("cbs"),
+		Spec: crdv1alpha1.CircuitBreakerStrategySpec{
+			Strategy:        convert.CircuitBreakerStrategyErrorRequestCount,
+			TriggerCount:    5,
+			StatDuration:    "1s",
+			RecoveryTimeout: "5s",
+		},
+	}
+	var invalidErr *InvalidObjectError
+	if _, err := TranslateObject(CircuitBreakerStrategyKind, cbs); !errors.As(err, &invalidErr) ||
+		len(invalidErr.Errs) != 1 || invalidErr.Errs[0].Field != "spec.minRequestAmount" {
+		t.Errorf("TranslateObject() of the object without minRequestAmount = %v, want the InvalidObjectError of spec.minRequestAmount", err)
+	}
+
+	obj := &crdv1alpha1.ThrottlingStrategy{
+		ObjectMeta: newTestObjectMeta("ts"),
+		Spec:       crdv1alpha1.ThrottlingStrategySpec{MinIntervalOfRequests: "20ms", QueueTimeout: "1s"},
+	}
+	first, err := TranslateObject(ThrottlingStrategyKind, obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _ := TranslateObject(ThrottlingStrategyKind, obj.DeepCopy())
+	if !proto.Equal(first, second) || string(first.GetValue()) != string(second.GetValue()) {
+		t.Error("the packed rules of the same object differ")
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/convert"
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type throttlingStrategyTranslator struct{}
+
+func (t *throttlingStrategyTranslator) convert(ts *crdv1alpha1.ThrottlingStrategy) (*pb.ThrottlingStrategy, []error) {
+	var errs []error
+	minInterval, err := convert.ParseDuration("spec.minIntervalOfRequests", ts.Spec.MinIntervalOfRequests)
+	errs = appendError(errs, err)
+	queueTimeout, err := convert.ParsePositiveDuration("spec.queueTimeout", ts.Spec.QueueTimeout)
+	errs = appendError(errs, err)
+	return &pb.ThrottlingStrategy{
+		Name:                        ts.Name,
+		MinIntervalMillisOfRequests: minInterval.Millis(),
+		QueueTimeoutMillis:          queueTimeout.Millis(),
+	}, errs
+}
+
+func (t *throttlingStrategyTranslator) Translate(object client.Object) (proto.Message, error) {
+	ts, ok := object.(*crdv1alpha1.ThrottlingStrategy)
+	if !ok {
+		return nil, unexpectedObjectError(object)
+	}
+	rule, errs := t.convert(ts)
+	return rule, translateError(errs)
+}
+
+func (t *throttlingStrategyTranslator) Validate(object client.Object) field.ErrorList {
+	ts, ok := object.(*crdv1alpha1.ThrottlingStrategy)
+	if !ok {
+		return field.ErrorList{field.InternalError(nil, unexpectedObjectError(object))}
+	}
+	rule, convertErrs := t.convert(ts)
+	errs := fieldErrors(convertErrs)
+	if len(errs) == 0 && rule.MinIntervalMillisOfRequests > rule.QueueTimeoutMillis {
+		errs = append(errs, field.Invalid(field.NewPath("spec", "minIntervalOfRequests"), ts.Spec.MinIntervalOfRequests,
+			"must not be larger than queueTimeout "+ts.Spec.QueueTimeout))
+	}
+	return errs
+}
+
+func (t *throttlingStrategyTranslator) Default(object client.Object) {
+	ts, ok := object.(*crdv1alpha1.ThrottlingStrategy)
+	if !ok {
+		return
+	}
+	normalizeDuration(&ts.Spec.MinIntervalOfRequests)
+	normalizeDuration(&ts.Spec.QueueTimeout)
+}
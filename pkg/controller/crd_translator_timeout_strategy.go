@@ -0,0 +1,62 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/convert"
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type timeoutStrategyTranslator struct{}
+
+func (t *timeoutStrategyTranslator) convert(ts *crdv1alpha1.TimeoutStrategy) (*pb.TimeoutStrategy, []error) {
+	var errs []error
+	timeout, err := convert.ParsePositiveDuration("spec.timeout", ts.Spec.Timeout)
+	errs = appendError(errs, err)
+	return &pb.TimeoutStrategy{
+		Name:          ts.Name,
+		TimeoutMillis: timeout.Millis(),
+	}, errs
+}
+
+func (t *timeoutStrategyTranslator) Translate(object client.Object) (proto.Message, error) {
+	ts, ok := object.(*crdv1alpha1.TimeoutStrategy)
+	if !ok {
+		return nil, unexpectedObjectError(object)
+	}
+	rule, errs := t.convert(ts)
+	return rule, translateError(errs)
+}
+
+func (t *timeoutStrategyTranslator) Validate(object client.Object) field.ErrorList {
+	ts, ok := object.(*crdv1alpha1.TimeoutStrategy)
+	if !ok {
+		return field.ErrorList{field.InternalError(nil, unexpectedObjectError(object))}
+	}
+	_, convertErrs := t.convert(ts)
+	return fieldErrors(convertErrs)
+}
+
+func (t *timeoutStrategyTranslator) Default(object client.Object) {
+	ts, ok := object.(*crdv1alpha1.TimeoutStrategy)
+	if !ok {
+		return
+	}
+	normalizeDuration(&ts.Spec.Timeout)
+}
@@ -0,0 +1,74 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+)
+
+func TestTimeoutStrategyValidate(t *testing.T) {
+	tests := []struct {
+		timeout string
+		want    []string
+	}{
+		{timeout: "3s"},
+		{timeout: "500ms"},
+		{timeout: "1min"},
+		{timeout: "", want: []string{"spec.timeout: Invalid value"}},
+		{timeout: "0s", want: []string{"spec.timeout: Invalid value"}},
+		{timeout: "3", want: []string{"spec.timeout: Invalid value"}},
+		{timeout: "-1s", want: []string{"spec.timeout: Invalid value"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.timeout, func(t *testing.T) {
+			ts := &crdv1alpha1.TimeoutStrategy{
+				ObjectMeta: newTestObjectMeta("ts"),
+				Spec:       crdv1alpha1.TimeoutStrategySpec{Timeout: tt.timeout},
+			}
+			if got := validationErrors(t, TimeoutStrategyKind, ts); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeoutStrategyDefault(t *testing.T) {
+	tests := []struct {
+		timeout string
+		want    string
+	}{
+		{timeout: "1000ms", want: "1s"},
+		{timeout: "120s", want: "2min"},
+		{timeout: "1500ms", want: "1500ms"},
+		// The invalid value is kept for validation to report.
+		{timeout: "3", want: "3"},
+	}
+	crdMetadata, _ := GetCrdMetadata(TimeoutStrategyKind)
+	for _, tt := range tests {
+		t.Run(tt.timeout, func(t *testing.T) {
+			ts := &crdv1alpha1.TimeoutStrategy{
+				ObjectMeta: newTestObjectMeta("ts"),
+				Spec:       crdv1alpha1.TimeoutStrategySpec{Timeout: tt.timeout},
+			}
+			crdMetadata.Defaulter().Default(ts)
+			if ts.Spec.Timeout != tt.want {
+				t.Errorf("timeout = %q, want %q", ts.Spec.Timeout, tt.want)
+			}
+		})
+	}
+}
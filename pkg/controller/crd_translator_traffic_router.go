@@ -0,0 +1,105 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"sort"
+
+	crdv1alpha1traffic "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1/traffic"
+	"github.com/opensergo/opensergo-control-plane/pkg/convert"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type trafficRouterTranslator struct{}
+
+func (t *trafficRouterTranslator) Translate(object client.Object) (proto.Message, error) {
+	tr, ok := object.(*crdv1alpha1traffic.TrafficRouter)
+	if !ok {
+		return nil, unexpectedObjectError(object)
+	}
+	return BuildRouteConfiguration(tr), nil
+}
+
+func (t *trafficRouterTranslator) Validate(object client.Object) field.ErrorList {
+	tr, ok := object.(*crdv1alpha1traffic.TrafficRouter)
+	if !ok {
+		return field.ErrorList{field.InternalError(nil, unexpectedObjectError(object))}
+	}
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+	if len(tr.Spec.Hosts) == 0 {
+		errs = append(errs, field.Required(specPath.Child("hosts"), ""))
+	}
+	for i, httpRoute := range tr.Spec.Http {
+		if httpRoute != nil {
+			for j, match := range httpRoute.Match {
+				errs = append(errs, validateHTTPMatchRequest(specPath.Child("http").Index(i).Child("match").Index(j), match)...)
+			}
+		}
+		routePath := specPath.Child("http").Index(i).Child("route")
+		if httpRoute == nil || len(httpRoute.Route) == 0 {
+			errs = append(errs, field.Required(routePath, ""))
+			continue
+		}
+		var totalWeight int32
+		for j, destination := range httpRoute.Route {
+			if destination == nil || destination.Destination == nil || destination.Destination.Host == "" {
+				errs = append(errs, field.Required(routePath.Index(j).Child("destination", "host"), ""))
+				continue
+			}
+			if destination.Weight < 0 {
+				errs = append(errs, field.Invalid(routePath.Index(j).Child("weight"), destination.Weight, "must be non-negative"))
+			}
+			totalWeight += destination.Weight
+		}
+		// The weights only take effect when there are multiple destinations.
+		if len(httpRoute.Route) > 1 && totalWeight != 100 {
+			errs = append(errs, field.Invalid(routePath, totalWeight, "the sum of the weights of destinations must be 100"))
+		}
+	}
+	return errs
+}
+
+// validateHTTPMatchRequest checks the StringMatch of a match request with the same rules as the StringMatch
+// of the fault-tolerance rules.
+func validateHTTPMatchRequest(path *field.Path, match *crdv1alpha1traffic.HTTPMatchRequest) field.ErrorList {
+	var errs []error
+	validate := func(path *field.Path, m *crdv1alpha1traffic.StringMatch) {
+		if m != nil {
+			errs = appendError(errs, convert.ValidateStringMatch(path.String(), m))
+		}
+	}
+	validateAll := func(path *field.Path, matches map[string]*crdv1alpha1traffic.StringMatch) {
+		// The names are sorted so that the errors are stable.
+		names := make([]string, 0, len(matches))
+		for name := range matches {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			validate(path.Key(name), matches[name])
+		}
+	}
+	validate(path.Child("uri"), match.GetUri())
+	validate(path.Child("scheme"), match.GetScheme())
+	validate(path.Child("method"), match.GetMethod())
+	validate(path.Child("authority"), match.GetAuthority())
+	validateAll(path.Child("headers"), match.GetHeaders())
+	validateAll(path.Child("queryParams"), match.GetQueryParams())
+	validateAll(path.Child("withoutHeaders"), match.GetWithoutHeaders())
+	return fieldErrors(errs)
+}
@@ -0,0 +1,99 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	crdv1alpha1traffic "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1/traffic"
+)
+
+func TestTrafficRouterValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(spec *crdv1alpha1traffic.TrafficRouterSpec)
+		want   []string
+	}{
+		{
+			name:   "single destination",
+			mutate: func(spec *crdv1alpha1traffic.TrafficRouterSpec) {},
+		},
+		{
+			name: "matches",
+			mutate: func(spec *crdv1alpha1traffic.TrafficRouterSpec) {
+				spec.Http[0].Match = []*crdv1alpha1traffic.HTTPMatchRequest{{
+					Method:      &crdv1alpha1traffic.StringMatch{Regex: "GET|HEAD"},
+					Headers:     map[string]*crdv1alpha1traffic.StringMatch{"x-user": {Exact: "vip"}},
+					QueryParams: map[string]*crdv1alpha1traffic.StringMatch{"debug": {Prefix: "t"}},
+				}}
+			},
+		},
+		{
+			name: "no hosts",
+			mutate: func(spec *crdv1alpha1traffic.TrafficRouterSpec) {
+				spec.Hosts = nil
+			},
+			want: []string{"spec.hosts: Required value"},
+		},
+		{
+			name: "weights not summing to 100",
+			mutate: func(spec *crdv1alpha1traffic.TrafficRouterSpec) {
+				spec.Http[0].Route = []*crdv1alpha1traffic.HTTPRouteDestination{
+					{Destination: &crdv1alpha1traffic.Destination{Host: "service-provider", Subset: "v1"}, Weight: 80},
+					{Destination: &crdv1alpha1traffic.Destination{Host: "service-provider", Subset: "v2"}, Weight: 10},
+				}
+			},
+			want: []string{"spec.http[0].route: Invalid value"},
+		},
+		{
+			name: "invalid string matches",
+			mutate: func(spec *crdv1alpha1traffic.TrafficRouterSpec) {
+				spec.Http[0].Match = []*crdv1alpha1traffic.HTTPMatchRequest{{
+					Uri: &crdv1alpha1traffic.StringMatch{},
+					Headers: map[string]*crdv1alpha1traffic.StringMatch{
+						"x-user": {Exact: "vip", Prefix: "v"},
+						"x-tag":  {Exact: "gray"},
+					},
+					QueryParams: map[string]*crdv1alpha1traffic.StringMatch{"debug": {Regex: "(t"}},
+				}}
+			},
+			want: []string{
+				"spec.http[0].match[0].headers[x-user]: Invalid value",
+				"spec.http[0].match[0].queryParams[debug].regex: Invalid value",
+				"spec.http[0].match[0].uri: Invalid value",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := &crdv1alpha1traffic.TrafficRouter{
+				ObjectMeta: newTestObjectMeta("tr"),
+				Spec: crdv1alpha1traffic.TrafficRouterSpec{
+					Hosts: []string{"service-provider"},
+					Http: []*crdv1alpha1traffic.HTTPRoute{{
+						Route: []*crdv1alpha1traffic.HTTPRouteDestination{
+							{Destination: &crdv1alpha1traffic.Destination{Host: "service-provider"}},
+						},
+					}},
+				},
+			}
+			tt.mutate(&tr.Spec)
+			if got := validationErrors(t, TrafficRouterKind, tr); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -16,25 +16,31 @@ package controller
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"hash/fnv"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/go-logr/logr"
-	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
-	crdv1alpha1traffic "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1/traffic"
 	"github.com/opensergo/opensergo-control-plane/pkg/model"
-	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
 	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
-	"github.com/opensergo/opensergo-control-plane/pkg/util"
 	"github.com/pkg/errors"
-	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	k8sApiError "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // CRDWatcher watches a specific kind of CRD.
@@ -45,8 +51,12 @@ type CRDWatcher struct {
 	logger logr.Logger
 	scheme *runtime.Scheme
 
-	// crdCache represents associated local cache for current kind of CRD.
-	crdCache *CRDCache
+	// clusters consists of all watched clusters, and the primary cluster is always the first one.
+	clusters []*Cluster
+	// crdCaches represents associated local caches for current kind of CRD: cluster name -> cache.
+	crdCaches map[string]*CRDCache
+	// configMapCache caches the objects of current kind decoded from the ConfigMaps of the primary cluster.
+	configMapCache *CRDCache
 
 	// subscribedList consists of all subscribed target of current kind of CRD.
 	subscribedList       map[model.SubscribeTarget]bool
@@ -55,8 +65,29 @@ type CRDWatcher struct {
 
 	crdGenerator    func() client.Object
 	sendDataHandler model.DataEntirePushHandler
-
-	updateMux sync.RWMutex
+	// statusUpdateHandler is called when the rules of a (namespace, app) may have changed,
+	// so that the status of the CRDs can be updated.
+	statusUpdateHandler func(n model.NamespacedApp, kind model.SubscribeKind)
+	// dependencies is the dependency graph between FaultToleranceRules and strategies,
+	// which is only present in the watcher of FaultToleranceRules.
+	dependencies *DependencyGraph
+
+	// contentVersion indicates whether the version of rules is derived from the resourceVersions of the
+	// observed objects rather than local counters, so that all replicas of the control plane agree on the version.
+	contentVersion bool
+	// revisions represents a map: cluster name -> the largest resourceVersion observed in the cluster
+	revisions map[string]*observedRevision
+	// configMapRevision is the largest resourceVersion of the ConfigMaps observed in the primary cluster.
+	configMapRevision *observedRevision
+
+	nackEventLimiter     *eventRateLimiter
+	deliveredGenerations *deliveredGenerations
+
+	// mergedVersions represents a map: (namespace, app) -> the latest version of the merged rules
+	mergedVersions map[model.NamespacedApp]*mergedVersion
+
+	updateMux  sync.RWMutex
+	versionMux sync.Mutex
 }
 
 const (
@@ -118,16 +149,32 @@ func (r *CRDWatcher) HasAnySubscribedOfApp(app model.NamespacedApp) bool {
 	return exist
 }
 
-func (r *CRDWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+// clusterReconciler reconciles the CRDs of a single cluster into the cache of the CRDWatcher.
+type clusterReconciler struct {
+	watcher *CRDWatcher
+	cluster *Cluster
+	client  client.Client
+}
+
+func (c *clusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return c.watcher.reconcile(ctx, c.cluster, c.client, req)
+}
+
+func (r *CRDWatcher) reconcile(ctx context.Context, cluster *Cluster, reader client.Reader, req ctrl.Request) (ctrl.Result, error) {
+	if r.dependencies != nil {
+		// The dependencies of all rules are tracked regardless of subscription.
+		r.updateDependencies(ctx, cluster, reader, req)
+	}
 	if !r.HasAnySubscribedOfNamespace(req.Namespace) {
 		// Ignore unmatched namespace
 		return ctrl.Result{Requeue: false, RequeueAfter: 0}, nil
 	}
-	logger := r.logger.WithValues("crdNamespace", req.Namespace, "crdName", req.Name, "kind", r.kind)
+	logger := r.logger.WithValues("crdNamespace", req.Namespace, "crdName", req.Name, "kind", r.kind, "cluster", cluster.name)
+	crdCache := r.crdCaches[cluster.name]
 
 	// your logic here
 	crd := r.crdGenerator()
-	if err := r.Get(ctx, req.NamespacedName, crd); err != nil {
+	if err := reader.Get(ctx, req.NamespacedName, crd); err != nil {
 		k8sApiErr, ok := err.(*k8sApiError.StatusError)
 		if !ok {
 			logger.Error(err, "Failed to get OpenSergo CRD")
@@ -157,7 +204,7 @@ func (r *CRDWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		namespacedApp := model.NamespacedApp{Namespace: req.Namespace, App: app}
 		appSubscribed := r.HasAnySubscribedOfApp(namespacedApp)
 		if !hasAppLabel || !appSubscribed {
-			if _, prevContains := r.crdCache.GetByNamespacedName(req.NamespacedName); prevContains {
+			if _, prevContains := crdCache.GetByNamespacedName(req.NamespacedName); prevContains {
 				logger.Info("OpenSergo CRD will be deleted because app label has been changed", "newApp", app)
 				crd = nil
 			} else {
@@ -170,13 +217,16 @@ func (r *CRDWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		} else {
 			logger.Info("OpenSergo CRD received", "crd", crd)
 		}
-		r.crdCache.SetByNamespaceApp(namespacedApp, crd)
-		r.crdCache.SetByNamespacedName(req.NamespacedName, crd)
+		crdCache.SetByNamespaceApp(namespacedApp, crd)
+		crdCache.SetByNamespacedName(req.NamespacedName, crd)
 
 	} else {
-		app, _ = r.crdCache.GetAppByNamespacedName(req.NamespacedName)
-		r.crdCache.DeleteByNamespaceApp(model.NamespacedApp{Namespace: req.Namespace, App: app}, req.Name)
-		r.crdCache.DeleteByNamespacedName(req.NamespacedName)
+		if prev, exists := crdCache.GetByNamespacedName(req.NamespacedName); exists && prev != nil {
+			r.forgetEvents(prev.GetUID())
+		}
+		app, _ = crdCache.GetAppByNamespacedName(req.NamespacedName)
+		crdCache.DeleteByNamespaceApp(model.NamespacedApp{Namespace: req.Namespace, App: app}, req.Name)
+		crdCache.DeleteByNamespacedName(req.NamespacedName)
 		logger.Info("OpenSergo CRD will be deleted")
 	}
 
@@ -185,160 +235,357 @@ func (r *CRDWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		App:       app,
 	}
 	// TODO: Now we can do something for the crd object!
-	rules, version := r.GetRules(nsa)
+	if err := r.pushRules(nsa); err != nil {
+		logger.Error(err, "Failed to send rules", "kind", r.kind)
+	}
+	return ctrl.Result{}, nil
+}
+
+// pushRules sends the merged rules of the (namespace, app), and triggers the status update of the CRDs.
+func (r *CRDWatcher) pushRules(n model.NamespacedApp) error {
 	status := &trpb.Status{
 		Code:    int32(200),
 		Message: "Get and send rule success",
 		Details: nil,
 	}
-	dataWithVersion := &trpb.DataWithVersion{Data: rules, Version: version}
-	err := r.sendDataHandler(req.Namespace, app, r.kind, dataWithVersion, status, "")
-	if err != nil {
-		logger.Error(err, "Failed to send rules", "kind", r.kind)
+	err := r.sendDataHandler(n.Namespace, n.App, r.kind, r.GetDataWithVersion(n), status, "")
+	if r.statusUpdateHandler != nil {
+		r.statusUpdateHandler(n, r.kind)
 	}
-	return ctrl.Result{}, nil
+	return err
 }
 
-func (r *CRDWatcher) GetRules(n model.NamespacedApp) ([]*anypb.Any, int64) {
-	var rules []*anypb.Any
-	objs, version := r.crdCache.GetByNamespaceApp(n)
+// setConfigMapObjects replaces the objects decoded from ConfigMaps with the given objects of the kind.
+// If several objects have the same namespace and name, the first one wins.
+// It returns the (namespace, app)s whose objects have changed.
+func (r *CRDWatcher) setConfigMapObjects(objs []client.Object) []model.NamespacedApp {
+	next := make(map[types.NamespacedName]client.Object, len(objs))
 	for _, obj := range objs {
-		if obj == nil {
-			continue
+		name := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+		if _, exists := next[name]; !exists {
+			next[name] = obj
 		}
-		rule, err := r.translateCrdToProto(obj)
-		if err != nil {
-			return rules, version
+	}
+
+	affected := make(map[model.NamespacedApp]bool)
+	for name, prev := range r.configMapCache.objects() {
+		if obj, exists := next[name]; exists && obj == prev {
+			delete(next, name)
+			continue
 		}
-		rules = append(rules, rule)
+		n := model.NamespacedApp{Namespace: name.Namespace, App: prev.GetLabels()["app"]}
+		r.configMapCache.DeleteByNamespaceApp(n, name.Name)
+		r.configMapCache.DeleteByNamespacedName(name)
+		affected[n] = true
+	}
+	for name, obj := range next {
+		n := model.NamespacedApp{Namespace: name.Namespace, App: obj.GetLabels()["app"]}
+		r.configMapCache.SetByNamespaceApp(n, obj)
+		r.configMapCache.SetByNamespacedName(name, obj)
+		affected[n] = true
 	}
-	return rules, version
+
+	changed := make([]model.NamespacedApp, 0, len(affected))
+	for n := range affected {
+		changed = append(changed, n)
+	}
+	return changed
 }
 
-func (r *CRDWatcher) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).For(r.crdGenerator()).Complete(r)
+// clusterObject represents a CRD object with the cluster which it is sourced from.
+type clusterObject struct {
+	cluster string
+	object  client.Object
 }
 
-func (r *CRDWatcher) translateCrdToProto(object client.Object) (*anypb.Any, error) {
-	var packRule *anypb.Any
-	var err error
-	var rule proto.Message
-	switch r.kind {
-	case FaultToleranceRuleKind:
-		ftr := object.(*crdv1alpha1.FaultToleranceRule)
-		var targets []*pb.FaultToleranceRule_FaultToleranceRuleTargetRef
-		var strategies []*pb.FaultToleranceRule_FaultToleranceStrategyRef
-		if ftr != nil {
-			for _, target := range ftr.Spec.Targets {
-				targets = append(targets, &pb.FaultToleranceRule_FaultToleranceRuleTargetRef{TargetResourceName: target.TargetResourceName})
+// versionSource is a source of the merged rules, i.e. the CRDs of a cluster or the ConfigMaps of the primary cluster.
+type versionSource struct {
+	cluster   string
+	configMap bool
+}
+
+// mergedVersion is the version of the merged rules of a (namespace, app) with the versions of the sources it derives from.
+type mergedVersion struct {
+	sources map[versionSource]int64
+	version int64
+}
+
+// mergedObjects merges the cached objects of all clusters for the given (namespace, app) according to
+// the merge policy of each cluster, and returns the versions of all sources of the merged objects.
+// The version of a source is the local counter of its cache, or the largest resourceVersion observed
+// in it if contentVersion is enabled. The objects of each source are sorted by name, so that all replicas
+// deliver the rules in the same order.
+func (r *CRDWatcher) mergedObjects(n model.NamespacedApp) ([]clusterObject, map[versionSource]int64) {
+	var merged []clusterObject
+	sources := make(map[versionSource]int64, len(r.clusters)+1)
+	names := make(map[string]bool)
+	primaryHasRules := false
+	for _, cluster := range r.clusters {
+		objs, v := r.crdCaches[cluster.name].GetByNamespaceApp(n)
+		objs = sortedByName(objs)
+		if r.contentVersion {
+			v = r.revisions[cluster.name].get()
+		}
+		sources[versionSource{cluster: cluster.name}] = v
+		if cluster.primary {
+			// The objects decoded from ConfigMaps belong to the primary cluster, and the CRDs win on conflicts.
+			configMapObjs, configMapVersion := r.configMapCache.GetByNamespaceApp(n)
+			if r.contentVersion {
+				configMapVersion = r.configMapRevision.get()
 			}
-			for _, strategy := range ftr.Spec.Strategies {
-				strategies = append(strategies, &pb.FaultToleranceRule_FaultToleranceStrategyRef{
-					Name: strategy.Name,
-					Kind: strategy.Kind,
-				})
+			sources[versionSource{cluster: cluster.name, configMap: true}] = configMapVersion
+			if len(configMapObjs) > 0 {
+				objs = append(objs, sortedByName(configMapObjs)...)
 			}
+			primaryHasRules = len(objs) > 0
+		} else if cluster.mergePolicy == MergePolicyPrimaryWins && primaryHasRules {
+			continue
 		}
-		rule = &pb.FaultToleranceRule{
-			Targets:    targets,
-			Strategies: strategies,
-			Action:     nil,
+		for _, obj := range objs {
+			if obj == nil || names[obj.GetName()] {
+				continue
+			}
+			names[obj.GetName()] = true
+			merged = append(merged, clusterObject{cluster: cluster.name, object: obj})
 		}
+	}
+	return merged, sources
+}
+
+// sortedByName returns a copy of the objects sorted by name.
+func sortedByName(objs []client.Object) []client.Object {
+	sorted := append(make([]client.Object, 0, len(objs)), objs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetName() < sorted[j].GetName()
+	})
+	return sorted
+}
+
+// mergedVersionOf returns the version of the merged rules of the (namespace, app) derived from the versions
+// of its sources, which never goes backwards. The version is the sum of the source versions when the sum
+// increases, or the previous version plus one when the sources change otherwise, e.g. when a cluster is
+// removed or the cache of a source is reset.
+//
+// If contentVersion is enabled, the source versions are observed resourceVersions which never decrease,
+// so the version is always their sum, and the replicas which have observed the same events agree on it.
+// A replica started after the deletion of the latest object only misses the resourceVersion of the
+// deletion, and it agrees with other replicas again once the next change is observed.
+func (r *CRDWatcher) mergedVersionOf(n model.NamespacedApp, sources map[versionSource]int64) int64 {
+	var sum int64
+	for _, v := range sources {
+		sum += v
+	}
+
+	r.versionMux.Lock()
+	defer r.versionMux.Unlock()
+
+	prev := r.mergedVersions[n]
+	if prev == nil {
+		r.mergedVersions[n] = &mergedVersion{sources: sources, version: sum}
+		return sum
+	}
+	if sameSourceVersions(prev.sources, sources) {
+		return prev.version
+	}
+	version := prev.version + 1
+	if sum > version {
+		version = sum
+	}
+	prev.sources = sources
+	prev.version = version
+	return version
+}
 
-	case RateLimitStrategyKind:
-		rls := object.(*crdv1alpha1.RateLimitStrategy)
-		mType, _ := strconv.ParseInt(rls.Spec.MetricType, 10, 32)
-		limitMode, _ := strconv.ParseInt(rls.Spec.LimitMode, 10, 32)
-		rule = &pb.RateLimitStrategy{
-			Name:         rls.Name,
-			MetricType:   pb.RateLimitStrategy_MetricType(mType),
-			LimitMode:    pb.RateLimitStrategy_LimitMode(limitMode),
-			Threshold:    rls.Spec.Threshold,
-			StatDuration: rls.Spec.StatDurationSeconds,
-			//StatDurationTimeUnit: 2, //todo 2应该为参数
+func sameSourceVersions(a, b map[versionSource]int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for source, v := range a {
+		if w, exists := b[source]; !exists || w != v {
+			return false
 		}
-	case ThrottlingStrategyKind:
-		ts := object.(*crdv1alpha1.ThrottlingStrategy)
-		miMill, err := util.Str2MillSeconds(ts.Spec.MinIntervalOfRequests)
+	}
+	return true
+}
+
+func (r *CRDWatcher) GetRules(n model.NamespacedApp) ([]*anypb.Any, int64) {
+	data := r.GetDataWithVersion(n)
+	return data.Data, data.Version
+}
+
+// GetDataWithVersion returns the merged rules of all clusters for the given (namespace, app),
+// with the provenance of each rule.
+func (r *CRDWatcher) GetDataWithVersion(n model.NamespacedApp) *trpb.DataWithVersion {
+	objs, sources := r.mergedObjects(n)
+	data := &trpb.DataWithVersion{Version: r.mergedVersionOf(n, sources)}
+	for _, obj := range objs {
+		rule, err := TranslateObject(r.kind, obj.object)
 		if err != nil {
-			miMill = -1
-			log.Println("translate to MinIntervalMillisOfRequests error, ", err)
+			// Exclude the invalid rule instead of dropping the whole batch.
+			// The error is also reported in the status and events of the object.
+			r.logger.Error(err, "Excluded the OpenSergo rule which is invalid or cannot be translated", "cluster", obj.cluster,
+				"namespace", obj.object.GetNamespace(), "name", obj.object.GetName())
+			continue
 		}
-		qtMill, err := util.Str2MillSeconds(ts.Spec.QueueTimeout)
-		if err != nil {
-			qtMill = -1
-			log.Println("translate to QueueTimeoutMillis error, ", err)
+		if rule == nil {
+			continue
 		}
-		rule = &pb.ThrottlingStrategy{
-			Name:                        ts.Name,
-			MinIntervalMillisOfRequests: miMill,
-			QueueTimeoutMillis:          qtMill,
+		data.Data = append(data.Data, rule)
+		data.Provenances = append(data.Provenances, &trpb.RuleProvenance{
+			Cluster:   obj.cluster,
+			Namespace: obj.object.GetNamespace(),
+			Name:      obj.object.GetName(),
+		})
+	}
+	data.ContentHash = contentHashOf(data)
+	return data
+}
+
+// contentHashOf calculates the fingerprint of the rules and their provenances,
+// which is the same for the same rules on all replicas.
+func contentHashOf(data *trpb.DataWithVersion) string {
+	if len(data.Data) == 0 {
+		return ""
+	}
+	h := fnv.New64a()
+	for i, rule := range data.Data {
+		_, _ = h.Write([]byte(rule.GetTypeUrl()))
+		_, _ = h.Write(rule.GetValue())
+		if i < len(data.Provenances) {
+			p := data.Provenances[i]
+			_, _ = h.Write([]byte(p.GetCluster() + "/" + p.GetNamespace() + "/" + p.GetName()))
 		}
-	case CircuitBreakerStrategyKind:
-		cbs := object.(*crdv1alpha1.CircuitBreakerStrategy)
-		tr, err := util.RatioStr2Float(cbs.Spec.TriggerRatio)
+	}
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// observedRevision tracks the largest resourceVersion of the objects in the observed events of a source,
+// including the deletion events, so that it never goes backwards.
+type observedRevision struct {
+	revision int64
+	mux      sync.Mutex
+}
+
+func (o *observedRevision) observe(obj client.Object) {
+	if o == nil || obj == nil {
+		return
+	}
+	revision, err := strconv.ParseInt(obj.GetResourceVersion(), 10, 64)
+	if err != nil {
+		return
+	}
+	o.mux.Lock()
+	defer o.mux.Unlock()
+
+	if revision > o.revision {
+		o.revision = revision
+	}
+}
+
+func (o *observedRevision) get() int64 {
+	if o == nil {
+		return 0
+	}
+	o.mux.Lock()
+	defer o.mux.Unlock()
+
+	return o.revision
+}
+
+// revisionRecordingHandler enqueues the requests of objects like handler.EnqueueRequestForObject,
+// and records the resourceVersions of the objects in the events.
+type revisionRecordingHandler struct {
+	handler.EnqueueRequestForObject
+	revision *observedRevision
+}
+
+func (h *revisionRecordingHandler) Create(evt event.CreateEvent, q workqueue.RateLimitingInterface) {
+	h.revision.observe(evt.Object)
+	h.EnqueueRequestForObject.Create(evt, q)
+}
+
+func (h *revisionRecordingHandler) Update(evt event.UpdateEvent, q workqueue.RateLimitingInterface) {
+	h.revision.observe(evt.ObjectNew)
+	h.EnqueueRequestForObject.Update(evt, q)
+}
+
+func (h *revisionRecordingHandler) Delete(evt event.DeleteEvent, q workqueue.RateLimitingInterface) {
+	h.revision.observe(evt.Object)
+	h.EnqueueRequestForObject.Delete(evt, q)
+}
+
+// SetupWithClusters registers the watcher to the managers of all clusters.
+// The watcher runs on every replica of the control plane regardless of leader election,
+// so that all replicas can serve the subscriptions of clients.
+func (r *CRDWatcher) SetupWithClusters() error {
+	gvk, err := apiutil.GVKForObject(r.crdGenerator(), r.scheme)
+	if err != nil {
+		return err
+	}
+	for _, cluster := range r.clusters {
+		c, err := controller.NewUnmanaged(strings.ToLower(gvk.Kind), cluster.manager, controller.Options{
+			Reconciler: &clusterReconciler{
+				watcher: r,
+				cluster: cluster,
+				client:  cluster.manager.GetClient(),
+			},
+		})
 		if err != nil {
-			tr = -1.0
-			log.Println("translate to TriggerRatio error, ", err)
+			return err
 		}
-		sdMill, err := util.Str2MillSeconds(cbs.Spec.StatDuration)
+		// Ignore the updates of status, which are written by the control plane itself.
+		err = c.Watch(&source.Kind{Type: r.crdGenerator()}, &revisionRecordingHandler{revision: r.revisions[cluster.name]},
+			predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}))
 		if err != nil {
-			sdMill = -1
+			return err
 		}
-		rtMill, err := util.Str2MillSeconds(cbs.Spec.RecoveryTimeout)
-		if err != nil {
-			rtMill = -1
+		if r.dependencies != nil {
+			if err = r.watchStrategies(c, cluster); err != nil {
+				return err
+			}
 		}
-		maMill, err := util.Str2MillSeconds(cbs.Spec.SlowConditions.MaxAllowedRt)
+		err = cluster.manager.Add(&nonLeaderElectionController{Controller: c})
 		if err != nil {
-			maMill = -1
-		}
-
-		rule = &pb.CircuitBreakerStrategy{
-			Name:                    cbs.Name,
-			Strategy:                util.Str2CBStrategy(cbs.Spec.Strategy),
-			TriggerRatio:            tr,
-			StatDuration:            sdMill, // todo int64 or int32
-			StatDurationTimeUnit:    0,
-			RecoveryTimeout:         int32(rtMill),
-			RecoveryTimeoutTimeUnit: 0,
-			MinRequestAmount:        cbs.Spec.MinRequestAmount,
-			SlowCondition:           &pb.CircuitBreakerStrategy_CircuitBreakerSlowCondition{MaxAllowedRtMillis: int32(maMill)},
-			ErrorCondition:          nil,
-		}
-	case ConcurrencyLimitStrategyKind:
-		cls := object.(*crdv1alpha1.ConcurrencyLimitStrategy)
-		rule = &pb.ConcurrencyLimitStrategy{
-			Name:           cls.Name,
-			LimitMode:      util.Str2LimitNode(cls.Spec.LimitMode),
-			MaxConcurrency: cls.Spec.MaxConcurrencyThreshold,
+			return err
 		}
-	case TrafficRouterKind:
-		cls := object.(*crdv1alpha1traffic.TrafficRouter)
-		rule = BuildRouteConfiguration(cls)
-	default:
-		return nil, nil
-	}
-	packRule, err = anypb.New(rule)
-	if err != nil {
-		log.Println("pack rule error", err)
-		return nil, err
 	}
-	return packRule, nil
+	return nil
+}
 
+// nonLeaderElectionController wraps a controller that runs regardless of leader election.
+type nonLeaderElectionController struct {
+	controller.Controller
 }
 
-func NewCRDWatcher(crdManager ctrl.Manager, kind model.SubscribeKind, crdGenerator func() client.Object, sendDataHandler model.DataEntirePushHandler) *CRDWatcher {
+func (c *nonLeaderElectionController) NeedLeaderElection() bool {
+	return false
+}
+
+func NewCRDWatcher(clusters []*Cluster, kind model.SubscribeKind, crdGenerator func() client.Object, sendDataHandler model.DataEntirePushHandler, contentVersion bool) *CRDWatcher {
+	crdCaches := make(map[string]*CRDCache, len(clusters))
+	revisions := make(map[string]*observedRevision, len(clusters))
+	for _, cluster := range clusters {
+		crdCaches[cluster.name] = NewCRDCache(kind)
+		revisions[cluster.name] = &observedRevision{}
+	}
 	return &CRDWatcher{
 		kind:                 kind,
-		Client:               crdManager.GetClient(),
+		Client:               clusters[0].manager.GetClient(),
 		logger:               ctrl.Log.WithName("controller").WithName(kind),
-		scheme:               crdManager.GetScheme(),
+		scheme:               clusters[0].manager.GetScheme(),
+		clusters:             clusters,
 		subscribedList:       make(map[model.SubscribeTarget]bool, 4),
 		subscribedNamespaces: make(map[string]bool),
 		subscribedApps:       make(map[model.NamespacedApp]bool),
 		crdGenerator:         crdGenerator,
-		crdCache:             NewCRDCache(kind),
+		crdCaches:            crdCaches,
+		configMapCache:       NewCRDCache(kind),
 		sendDataHandler:      sendDataHandler,
+		contentVersion:       contentVersion,
+		revisions:            revisions,
+		configMapRevision:    &observedRevision{},
+		nackEventLimiter:     newEventRateLimiter(DefaultNackEventInterval),
+		deliveredGenerations: newDeliveredGenerations(),
+		mergedVersions:       make(map[model.NamespacedApp]*mergedVersion),
 	}
 }
@@ -0,0 +1,403 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+const (
+	testNamespace = "default"
+	testApp       = "foo-app"
+)
+
+var testNamespacedApp = model.NamespacedApp{Namespace: testNamespace, App: testApp}
+
+// newTestWatcher creates a watcher of the kind on the given clusters without managers,
+// which has subscribed to the rules of testNamespacedApp.
+func newTestWatcher(t *testing.T, kind model.SubscribeKind, clusters ...*Cluster) *CRDWatcher {
+	t.Helper()
+	crdMetadata, ok := GetCrdMetadata(kind)
+	if !ok {
+		t.Fatalf("kind %s is not registered", kind)
+	}
+	crdCaches := make(map[string]*CRDCache, len(clusters))
+	revisions := make(map[string]*observedRevision, len(clusters))
+	for _, cluster := range clusters {
+		crdCaches[cluster.name] = NewCRDCache(kind)
+		revisions[cluster.name] = &observedRevision{}
+	}
+	w := &CRDWatcher{
+		kind:                 kind,
+		logger:               ctrl.Log.WithName("test").WithName(kind),
+		scheme:               scheme,
+		clusters:             clusters,
+		subscribedList:       make(map[model.SubscribeTarget]bool),
+		subscribedNamespaces: make(map[string]bool),
+		subscribedApps:       make(map[model.NamespacedApp]bool),
+		crdGenerator:         crdMetadata.Generator(),
+		crdCaches:            crdCaches,
+		configMapCache:       NewCRDCache(kind),
+		revisions:            revisions,
+		configMapRevision:    &observedRevision{},
+		sendDataHandler: func(namespace, app, kind string, dataWithVersion *trpb.DataWithVersion, status *trpb.Status, respId string) error {
+			return nil
+		},
+		nackEventLimiter:     newEventRateLimiter(DefaultNackEventInterval),
+		deliveredGenerations: newDeliveredGenerations(),
+		mergedVersions:       make(map[model.NamespacedApp]*mergedVersion),
+	}
+	err := w.AddSubscribeTarget(model.SubscribeTarget{Namespace: testNamespace, AppName: testApp, Kind: kind})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+func newTestRateLimitStrategy(name string, threshold int64) *v1alpha1.RateLimitStrategy {
+	return newTestRateLimitStrategyWithRevision(name, threshold, "")
+}
+
+func newTestRateLimitStrategyWithRevision(name string, threshold int64, resourceVersion string) *v1alpha1.RateLimitStrategy {
+	return &v1alpha1.RateLimitStrategy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       testNamespace,
+			Name:            name,
+			Labels:          map[string]string{"app": testApp},
+			ResourceVersion: resourceVersion,
+		},
+		Spec: v1alpha1.RateLimitStrategySpec{
+			MetricType:          "RequestAmount",
+			LimitMode:           "Local",
+			Threshold:           threshold,
+			StatDurationSeconds: 1,
+		},
+	}
+}
+
+func setCachedObjects(w *CRDWatcher, cluster string, objs ...client.Object) {
+	for _, obj := range objs {
+		w.crdCaches[cluster].SetByNamespaceApp(testNamespacedApp, obj)
+		w.crdCaches[cluster].SetByNamespacedName(types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, obj)
+	}
+}
+
+func provenancesOf(data *trpb.DataWithVersion) []string {
+	var provenances []string
+	for _, p := range data.GetProvenances() {
+		provenances = append(provenances, p.GetCluster()+"/"+p.GetName())
+	}
+	return provenances
+}
+
+func TestCRDWatcherMergePolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		mergePolicy   MergePolicy
+		primaryObjs   []client.Object
+		secondaryObjs []client.Object
+		want          []string
+	}{
+		{
+			name:          "union merges the rules of all clusters",
+			mergePolicy:   MergePolicyUnion,
+			primaryObjs:   []client.Object{newTestRateLimitStrategy("a", 10)},
+			secondaryObjs: []client.Object{newTestRateLimitStrategy("b", 20)},
+			want:          []string{"primary/a", "secondary/b"},
+		},
+		{
+			name:          "union prefers the primary cluster on name conflicts",
+			mergePolicy:   MergePolicyUnion,
+			primaryObjs:   []client.Object{newTestRateLimitStrategy("a", 10)},
+			secondaryObjs: []client.Object{newTestRateLimitStrategy("a", 20), newTestRateLimitStrategy("b", 20)},
+			want:          []string{"primary/a", "secondary/b"},
+		},
+		{
+			name:          "union with empty primary cluster",
+			mergePolicy:   MergePolicyUnion,
+			secondaryObjs: []client.Object{newTestRateLimitStrategy("b", 20)},
+			want:          []string{"secondary/b"},
+		},
+		{
+			name:          "primary wins ignores the cluster when the primary cluster has rules",
+			mergePolicy:   MergePolicyPrimaryWins,
+			primaryObjs:   []client.Object{newTestRateLimitStrategy("a", 10)},
+			secondaryObjs: []client.Object{newTestRateLimitStrategy("b", 20)},
+			want:          []string{"primary/a"},
+		},
+		{
+			name:          "primary wins falls back to the cluster when the primary cluster has no rules",
+			mergePolicy:   MergePolicyPrimaryWins,
+			secondaryObjs: []client.Object{newTestRateLimitStrategy("b", 20)},
+			want:          []string{"secondary/b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := newTestWatcher(t, RateLimitStrategyKind,
+				&Cluster{name: "primary", primary: true, mergePolicy: MergePolicyUnion},
+				&Cluster{name: "secondary", mergePolicy: tt.mergePolicy})
+			setCachedObjects(w, "primary", tt.primaryObjs...)
+			setCachedObjects(w, "secondary", tt.secondaryObjs...)
+
+			data := w.GetDataWithVersion(testNamespacedApp)
+			if got := provenancesOf(data); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("provenances = %v, want %v", got, tt.want)
+			}
+			if len(data.GetData()) != len(tt.want) {
+				t.Errorf("got %d rules, want %d", len(data.GetData()), len(tt.want))
+			}
+		})
+	}
+}
+
+func TestCRDWatcherMergePolicyWithConfigMaps(t *testing.T) {
+	w := newTestWatcher(t, RateLimitStrategyKind,
+		&Cluster{name: "primary", primary: true, mergePolicy: MergePolicyUnion},
+		&Cluster{name: "secondary", mergePolicy: MergePolicyPrimaryWins})
+	setCachedObjects(w, "primary", newTestRateLimitStrategy("a", 10))
+	setCachedObjects(w, "secondary", newTestRateLimitStrategy("c", 30))
+	// The CRD wins over the object decoded from a ConfigMap with the same name.
+	w.setConfigMapObjects([]client.Object{newTestRateLimitStrategy("a", 20), newTestRateLimitStrategy("b", 20)})
+
+	want := []string{"primary/a", "primary/b"}
+	if got := provenancesOf(w.GetDataWithVersion(testNamespacedApp)); !reflect.DeepEqual(got, want) {
+		t.Errorf("provenances = %v, want %v", got, want)
+	}
+}
+
+func TestCRDWatcherMergedVersion(t *testing.T) {
+	w := newTestWatcher(t, RateLimitStrategyKind,
+		&Cluster{name: "primary", primary: true, mergePolicy: MergePolicyUnion},
+		&Cluster{name: "secondary", mergePolicy: MergePolicyUnion})
+
+	var prev int64
+	steps := []struct {
+		name   string
+		update func()
+		bumped bool
+	}{
+		{
+			name:   "add a rule to the primary cluster",
+			update: func() { setCachedObjects(w, "primary", newTestRateLimitStrategy("a", 10)) },
+			bumped: true,
+		},
+		{
+			name: "add and update a rule of the secondary cluster",
+			update: func() {
+				setCachedObjects(w, "secondary", newTestRateLimitStrategy("b", 20))
+				setCachedObjects(w, "secondary", newTestRateLimitStrategy("b", 25))
+			},
+			bumped: true,
+		},
+		{
+			name:   "no change",
+			update: func() {},
+		},
+		{
+			name: "reset the cache of the secondary cluster",
+			update: func() {
+				w.crdCaches["secondary"] = NewCRDCache(RateLimitStrategyKind)
+				setCachedObjects(w, "secondary", newTestRateLimitStrategy("b", 30))
+			},
+			bumped: true,
+		},
+		{
+			name: "remove the secondary cluster",
+			update: func() {
+				w.clusters = w.clusters[:1]
+			},
+			bumped: true,
+		},
+		{
+			name: "add the ConfigMap objects",
+			update: func() {
+				w.setConfigMapObjects([]client.Object{newTestRateLimitStrategy("c", 10)})
+			},
+			bumped: true,
+		},
+		{
+			name: "reset the ConfigMap cache",
+			update: func() {
+				w.configMapCache = NewCRDCache(RateLimitStrategyKind)
+			},
+			bumped: true,
+		},
+	}
+	for _, step := range steps {
+		step.update()
+		version := w.GetDataWithVersion(testNamespacedApp).GetVersion()
+		if step.bumped && version <= prev {
+			t.Errorf("%s: version = %d, want greater than %d", step.name, version, prev)
+		}
+		if !step.bumped && version != prev {
+			t.Errorf("%s: version = %d, want %d", step.name, version, prev)
+		}
+		prev = version
+	}
+}
+
+func TestCRDWatcherReconcile(t *testing.T) {
+	primary := &Cluster{name: "primary", primary: true, mergePolicy: MergePolicyUnion}
+	secondary := &Cluster{name: "secondary", mergePolicy: MergePolicyUnion}
+	w := newTestWatcher(t, RateLimitStrategyKind, primary, secondary)
+	var pushed *trpb.DataWithVersion
+	w.sendDataHandler = func(namespace, app, kind string, dataWithVersion *trpb.DataWithVersion, status *trpb.Status, respId string) error {
+		pushed = dataWithVersion
+		return nil
+	}
+
+	primaryClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(newTestRateLimitStrategy("a", 10)).Build()
+	secondaryClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(newTestRateLimitStrategy("a", 20), newTestRateLimitStrategy("b", 20)).Build()
+
+	ctx := context.Background()
+	reconcile := func(cluster *Cluster, c client.Client, name string) {
+		t.Helper()
+		req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: name}}
+		if _, err := w.reconcile(ctx, cluster, c, req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	reconcile(primary, primaryClient, "a")
+	reconcile(secondary, secondaryClient, "a")
+	reconcile(secondary, secondaryClient, "b")
+	want := []string{"primary/a", "secondary/b"}
+	if got := provenancesOf(pushed); !reflect.DeepEqual(got, want) {
+		t.Errorf("provenances = %v, want %v", got, want)
+	}
+	prev := pushed.GetVersion()
+
+	if err := primaryClient.Delete(ctx, newTestRateLimitStrategy("a", 10)); err != nil {
+		t.Fatal(err)
+	}
+	reconcile(primary, primaryClient, "a")
+	want = []string{"secondary/a", "secondary/b"}
+	if got := provenancesOf(pushed); !reflect.DeepEqual(got, want) {
+		t.Errorf("provenances = %v, want %v", got, want)
+	}
+	if pushed.GetVersion() <= prev {
+		t.Errorf("version = %d, want greater than %d", pushed.GetVersion(), prev)
+	}
+}
+
+func TestCRDWatcherContentVersion(t *testing.T) {
+	newReplica := func() (*CRDWatcher, *revisionRecordingHandler) {
+		w := newTestWatcher(t, RateLimitStrategyKind, &Cluster{name: "primary", primary: true, mergePolicy: MergePolicyUnion})
+		w.contentVersion = true
+		return w, &revisionRecordingHandler{revision: w.revisions["primary"]}
+	}
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer queue.ShutDown()
+	create := func(w *CRDWatcher, h *revisionRecordingHandler, obj client.Object) {
+		h.Create(event.CreateEvent{Object: obj}, queue)
+		setCachedObjects(w, "primary", obj)
+	}
+	remove := func(w *CRDWatcher, h *revisionRecordingHandler, obj client.Object) {
+		h.Delete(event.DeleteEvent{Object: obj}, queue)
+		w.crdCaches["primary"].DeleteByNamespaceApp(testNamespacedApp, obj.GetName())
+		w.crdCaches["primary"].DeleteByNamespacedName(types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()})
+	}
+
+	a := newTestRateLimitStrategyWithRevision("a", 10, "100")
+	b := newTestRateLimitStrategyWithRevision("b", 20, "105")
+	updatedA := newTestRateLimitStrategyWithRevision("a", 30, "110")
+
+	// The first replica observes the creation and update of a, while the second replica starts after the update.
+	w1, h1 := newReplica()
+	create(w1, h1, a)
+	create(w1, h1, b)
+	v1 := w1.GetDataWithVersion(testNamespacedApp)
+	create(w1, h1, updatedA)
+	w2, h2 := newReplica()
+	create(w2, h2, b)
+	create(w2, h2, updatedA)
+
+	d1, d2 := w1.GetDataWithVersion(testNamespacedApp), w2.GetDataWithVersion(testNamespacedApp)
+	if d1.GetVersion() != 110 || d2.GetVersion() != 110 {
+		t.Errorf("versions = %d, %d, want 110", d1.GetVersion(), d2.GetVersion())
+	}
+	if d1.GetVersion() <= v1.GetVersion() {
+		t.Errorf("version = %d, want greater than %d", d1.GetVersion(), v1.GetVersion())
+	}
+	if d1.GetContentHash() == "" || d1.GetContentHash() != d2.GetContentHash() {
+		t.Errorf("content hashes = %q, %q, want the same non-empty hash", d1.GetContentHash(), d2.GetContentHash())
+	}
+	if d1.GetContentHash() == v1.GetContentHash() {
+		t.Errorf("content hash %q is not changed", d1.GetContentHash())
+	}
+
+	// The deletion carries the resourceVersion of the deletion, so the version keeps increasing.
+	deletedB := newTestRateLimitStrategyWithRevision("b", 20, "120")
+	remove(w1, h1, deletedB)
+	remove(w2, h2, deletedB)
+	d1, d2 = w1.GetDataWithVersion(testNamespacedApp), w2.GetDataWithVersion(testNamespacedApp)
+	if d1.GetVersion() != 120 || d2.GetVersion() != 120 {
+		t.Errorf("versions after deletion = %d, %d, want 120", d1.GetVersion(), d2.GetVersion())
+	}
+	if len(d1.GetData()) != 1 || d1.GetContentHash() != d2.GetContentHash() {
+		t.Errorf("got %d rules with content hashes %q, %q, want 1 rule with the same hash",
+			len(d1.GetData()), d1.GetContentHash(), d2.GetContentHash())
+	}
+
+	// A stale event never moves the version backwards.
+	h1.Update(event.UpdateEvent{ObjectOld: a, ObjectNew: a}, queue)
+	if v := w1.GetDataWithVersion(testNamespacedApp).GetVersion(); v != 120 {
+		t.Errorf("version after a stale event = %d, want 120", v)
+	}
+}
+
+func TestCRDWatcherReconcileForgetsDeletedObjects(t *testing.T) {
+	cluster := &Cluster{name: "primary", primary: true, mergePolicy: MergePolicyUnion}
+	w := newTestWatcher(t, RateLimitStrategyKind, cluster)
+	obj := newTestRateLimitStrategy("a", 10)
+	obj.UID = "uid-a"
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(obj).Build()
+
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: "a"}}
+	if _, err := w.reconcile(ctx, cluster, c, req); err != nil {
+		t.Fatal(err)
+	}
+	w.deliveredGenerations.markDelivered(obj.UID, 1)
+	w.nackEventLimiter.Allow(obj.UID)
+
+	if err := c.Delete(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.reconcile(ctx, cluster, c, req); err != nil {
+		t.Fatal(err)
+	}
+	if _, exists := w.deliveredGenerations.generations[obj.UID]; exists {
+		t.Error("the delivered generation of the deleted object is kept")
+	}
+	if _, exists := w.nackEventLimiter.lastEventTime[obj.UID]; exists {
+		t.Error("the NACK event time of the deleted object is kept")
+	}
+}
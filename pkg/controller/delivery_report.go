@@ -0,0 +1,453 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	"github.com/pkg/errors"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	k8sApiError "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// DeliveryReportLabel marks a Lease as the delivery report of a replica of the control plane.
+	DeliveryReportLabel = "opensergo.io/delivery-report"
+	// DeliveryReportAnnotation is the annotation of a delivery report Lease which carries the delivery status
+	// of the rules to the instances connected to the replica.
+	DeliveryReportAnnotation = "opensergo.io/delivery-report"
+
+	// DefaultDeliveryReportLeaseDuration is the duration after which the delivery report of a replica expires
+	// if the replica stops renewing it, e.g. when the replica crashes.
+	DefaultDeliveryReportLeaseDuration = 30 * time.Second
+
+	deliveryReportNamePrefix = "opensergo-delivery-"
+	// deliveryReportFlushInterval is the minimum interval between two writes of the report of a replica.
+	deliveryReportFlushInterval = time.Second
+	inClusterNamespacePath      = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+)
+
+// deliveryReportEntry is the delivery status of the rules of a (namespace, app, kind) in the report of a replica.
+type deliveryReportEntry struct {
+	Namespace          string `json:"namespace"`
+	App                string `json:"app"`
+	Kind               string `json:"kind"`
+	Version            int64  `json:"version"`
+	ConnectedInstances int32  `json:"connectedInstances"`
+	AckedInstances     int32  `json:"ackedInstances,omitempty"`
+	NackedInstances    int32  `json:"nackedInstances,omitempty"`
+	LastNackMessage    string `json:"lastNackMessage,omitempty"`
+}
+
+// deliveryReports aggregates the delivery status of rules across the replicas of the control plane.
+// As the instances of an app may connect to different replicas, each replica reports the delivery status
+// of its own instances in a Lease which is renewed periodically, and the leader sums up the reports of all
+// replicas whose Leases have not expired when updating the status of CRDs.
+type deliveryReports struct {
+	replica       string
+	namespace     string
+	leaseDuration time.Duration
+
+	client client.Client
+	reader client.Reader
+	// local provides the delivery status of the instances connected to the current replica.
+	local model.DeliveryStatusProvider
+	// changedHandler is called with the (namespace, app, kind)s whose delivery status may have changed in other replicas.
+	changedHandler func(key statusKey)
+
+	// keys consists of the (namespace, app, kind)s whose delivery status is reported by the current replica.
+	keys      map[statusKey]bool
+	dirty     bool
+	renewedAt time.Time
+
+	mux sync.Mutex
+}
+
+func newDeliveryReports(replica, namespace string, c client.Client, reader client.Reader) *deliveryReports {
+	return &deliveryReports{
+		replica:       replica,
+		namespace:     namespace,
+		leaseDuration: DefaultDeliveryReportLeaseDuration,
+		client:        c,
+		reader:        reader,
+		keys:          make(map[statusKey]bool),
+	}
+}
+
+// setLocalProvider sets the provider of the delivery status of the current replica.
+func (d *deliveryReports) setLocalProvider(provider model.DeliveryStatusProvider) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	d.local = provider
+}
+
+// markChanged marks the local delivery status of the key as changed, which will be written in the next flush.
+func (d *deliveryReports) markChanged(key statusKey) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	d.keys[key] = true
+	d.dirty = true
+}
+
+func (d *deliveryReports) leaseName() string {
+	return deliveryReportNamePrefix + d.replica
+}
+
+// run writes the report of the current replica when it changes, and renews it periodically.
+// The report is removed when the context is done.
+func (d *deliveryReports) run(ctx context.Context) error {
+	ticker := time.NewTicker(deliveryReportFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			removeCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Namespace: d.namespace, Name: d.leaseName()}}
+			if err := d.client.Delete(removeCtx, lease); err != nil && !k8sApiError.IsNotFound(err) {
+				setupLog.Error(err, "Failed to remove the delivery report", "replica", d.replica)
+			}
+			return nil
+		case now := <-ticker.C:
+			if err := d.flush(ctx, now); err != nil {
+				setupLog.Error(err, "Failed to write the delivery report", "replica", d.replica)
+			}
+		}
+	}
+}
+
+// flush writes the report of the current replica if it has changed or needs renewal.
+func (d *deliveryReports) flush(ctx context.Context, now time.Time) error {
+	d.mux.Lock()
+	if !d.dirty && now.Sub(d.renewedAt) < d.leaseDuration/3 {
+		d.mux.Unlock()
+		return nil
+	}
+	entries := make([]deliveryReportEntry, 0, len(d.keys))
+	for key := range d.keys {
+		var status model.DeliveryStatus
+		if d.local != nil {
+			status = d.local(key.Namespace, key.App, key.kind)
+		}
+		if status.ConnectedInstances == 0 {
+			// The rules are no longer delivered by the current replica.
+			delete(d.keys, key)
+			continue
+		}
+		entries = append(entries, deliveryReportEntry{
+			Namespace:          key.Namespace,
+			App:                key.App,
+			Kind:               key.kind,
+			Version:            status.Version,
+			ConnectedInstances: status.ConnectedInstances,
+			AckedInstances:     status.AckedInstances,
+			NackedInstances:    status.NackedInstances,
+			LastNackMessage:    status.LastNackMessage,
+		})
+	}
+	d.dirty = false
+	d.mux.Unlock()
+
+	err := d.writeReport(ctx, entries, now)
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if err != nil {
+		// Retry in the next flush.
+		d.dirty = true
+		return err
+	}
+	d.renewedAt = now
+	return nil
+}
+
+func (d *deliveryReports) writeReport(ctx context.Context, entries []deliveryReportEntry, now time.Time) error {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Namespace+"/"+entries[i].App+"/"+entries[i].Kind < entries[j].Namespace+"/"+entries[j].App+"/"+entries[j].Kind
+	})
+	report, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	leaseSeconds := int32(d.leaseDuration / time.Second)
+	renewTime := metav1.NewMicroTime(now)
+
+	lease := &coordinationv1.Lease{}
+	err = d.reader.Get(ctx, types.NamespacedName{Namespace: d.namespace, Name: d.leaseName()}, lease)
+	if k8sApiError.IsNotFound(err) {
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   d.namespace,
+				Name:        d.leaseName(),
+				Labels:      map[string]string{DeliveryReportLabel: "true"},
+				Annotations: map[string]string{DeliveryReportAnnotation: string(report)},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &d.replica,
+				LeaseDurationSeconds: &leaseSeconds,
+				AcquireTime:          &renewTime,
+				RenewTime:            &renewTime,
+			},
+		}
+		return d.client.Create(ctx, lease)
+	}
+	if err != nil {
+		return err
+	}
+	if lease.Annotations == nil {
+		lease.Annotations = make(map[string]string)
+	}
+	lease.Annotations[DeliveryReportAnnotation] = string(report)
+	lease.Spec.HolderIdentity = &d.replica
+	lease.Spec.LeaseDurationSeconds = &leaseSeconds
+	lease.Spec.RenewTime = &renewTime
+	return d.client.Update(ctx, lease)
+}
+
+// Status returns the delivery status of the rules of the (namespace, app, kind) aggregated from the current
+// replica and the unexpired reports of other replicas.
+func (d *deliveryReports) Status(namespace, app, kind string) model.DeliveryStatus {
+	d.mux.Lock()
+	local := d.local
+	d.mux.Unlock()
+
+	var statuses []model.DeliveryStatus
+	if local != nil {
+		statuses = append(statuses, local(namespace, app, kind))
+	}
+	leases, err := d.listReports(context.Background())
+	if err != nil {
+		setupLog.Error(err, "Failed to list the delivery reports, only the delivery status of the current replica is used")
+	}
+	now := time.Now()
+	for i := range leases {
+		lease := &leases[i]
+		if lease.Name == d.leaseName() || leaseExpired(lease, now) {
+			continue
+		}
+		for _, entry := range reportEntriesOf(lease) {
+			if entry.Namespace == namespace && entry.App == app && entry.Kind == kind {
+				statuses = append(statuses, model.DeliveryStatus{
+					Version:            entry.Version,
+					ConnectedInstances: entry.ConnectedInstances,
+					AckedInstances:     entry.AckedInstances,
+					NackedInstances:    entry.NackedInstances,
+					LastNackMessage:    entry.LastNackMessage,
+				})
+			}
+		}
+	}
+	return aggregateDeliveryStatus(statuses)
+}
+
+// aggregateDeliveryStatus sums up the delivery status of several replicas. Only the instances which have
+// received the latest version among all replicas are counted as ACKed or NACKed.
+func aggregateDeliveryStatus(statuses []model.DeliveryStatus) model.DeliveryStatus {
+	var aggregated model.DeliveryStatus
+	for _, status := range statuses {
+		if status.ConnectedInstances > 0 && status.Version > aggregated.Version {
+			aggregated.Version = status.Version
+		}
+	}
+	for _, status := range statuses {
+		aggregated.ConnectedInstances += status.ConnectedInstances
+		if status.Version != aggregated.Version {
+			continue
+		}
+		aggregated.AckedInstances += status.AckedInstances
+		aggregated.NackedInstances += status.NackedInstances
+		if aggregated.LastNackMessage == "" && status.NackedInstances > 0 {
+			aggregated.LastNackMessage = status.LastNackMessage
+		}
+	}
+	return aggregated
+}
+
+func (d *deliveryReports) listReports(ctx context.Context) ([]coordinationv1.Lease, error) {
+	leases := &coordinationv1.LeaseList{}
+	err := d.reader.List(ctx, leases, client.InNamespace(d.namespace), client.MatchingLabels{DeliveryReportLabel: "true"})
+	if err != nil {
+		return nil, err
+	}
+	return leases.Items, nil
+}
+
+// removeExpiredReports removes the reports of the replicas which have stopped renewing them,
+// so that the status of the rules they reported is updated. It is only run by the leader.
+func (d *deliveryReports) removeExpiredReports(ctx context.Context, now time.Time) error {
+	leases, err := d.listReports(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range leases {
+		lease := &leases[i]
+		if lease.Name == d.leaseName() || !leaseExpired(lease, now) {
+			continue
+		}
+		setupLog.Info("Removing the expired delivery report", "lease", lease.Name)
+		if err = d.client.Delete(ctx, lease); err != nil && !k8sApiError.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *deliveryReports) runJanitor(ctx context.Context) error {
+	ticker := time.NewTicker(d.leaseDuration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			if err := d.removeExpiredReports(ctx, now); err != nil {
+				setupLog.Error(err, "Failed to remove the expired delivery reports")
+			}
+		}
+	}
+}
+
+// onReportChanged triggers the status update of the rules in the old and new reports of a replica.
+func (d *deliveryReports) onReportChanged(leases ...*coordinationv1.Lease) {
+	if d.changedHandler == nil {
+		return
+	}
+	keys := make(map[statusKey]bool)
+	for _, lease := range leases {
+		if lease == nil || lease.Name == d.leaseName() {
+			continue
+		}
+		for _, entry := range reportEntriesOf(lease) {
+			keys[statusKey{NamespacedApp: model.NamespacedApp{Namespace: entry.Namespace, App: entry.App}, kind: entry.Kind}] = true
+		}
+	}
+	for key := range keys {
+		d.changedHandler(key)
+	}
+}
+
+func (d *deliveryReports) eventHandler() toolscache.ResourceEventHandler {
+	return toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			lease, _ := obj.(*coordinationv1.Lease)
+			d.onReportChanged(lease)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldLease, _ := oldObj.(*coordinationv1.Lease)
+			newLease, _ := newObj.(*coordinationv1.Lease)
+			if oldLease != nil && newLease != nil &&
+				oldLease.Annotations[DeliveryReportAnnotation] == newLease.Annotations[DeliveryReportAnnotation] {
+				// Renewal only.
+				return
+			}
+			d.onReportChanged(oldLease, newLease)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			lease, _ := obj.(*coordinationv1.Lease)
+			d.onReportChanged(lease)
+		},
+	}
+}
+
+func leaseExpired(lease *coordinationv1.Lease, now time.Time) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	return lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second).Before(now)
+}
+
+func reportEntriesOf(lease *coordinationv1.Lease) []deliveryReportEntry {
+	var entries []deliveryReportEntry
+	if err := json.Unmarshal([]byte(lease.Annotations[DeliveryReportAnnotation]), &entries); err != nil {
+		setupLog.Error(err, "Ignored the invalid delivery report", "lease", lease.Name)
+		return nil
+	}
+	return entries
+}
+
+// setupDeliveryReports enables the aggregation of the delivery status across replicas in the given namespace
+// of the primary cluster.
+func (k *KubernetesOperator) setupDeliveryReports(namespace string) error {
+	if namespace == "" {
+		data, err := ioutil.ReadFile(inClusterNamespacePath)
+		if err != nil {
+			return errors.Wrap(err, "the namespace of the delivery reports is unknown, which defaults to the leader election namespace")
+		}
+		namespace = strings.TrimSpace(string(data))
+	}
+	replica, err := os.Hostname()
+	if err != nil {
+		return errors.Wrap(err, "unable to get the name of the replica")
+	}
+
+	mgr := k.clusters[0].manager
+	selector := labels.SelectorFromSet(labels.Set{DeliveryReportLabel: "true"})
+	reportCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		Scheme:    mgr.GetScheme(),
+		Mapper:    mgr.GetRESTMapper(),
+		Namespace: namespace,
+		SelectorsByObject: cache.SelectorsByObject{
+			&coordinationv1.Lease{}: {Label: selector},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	informer, err := reportCache.GetInformer(context.Background(), &coordinationv1.Lease{})
+	if err != nil {
+		return err
+	}
+
+	reports := newDeliveryReports(strings.ToLower(replica), namespace, mgr.GetClient(), reportCache)
+	reports.changedHandler = func(key statusKey) {
+		k.enqueueStatusUpdate(key.NamespacedApp, key.kind)
+	}
+	informer.AddEventHandler(reports.eventHandler())
+	if err = mgr.Add(&nonLeaderElectionRunnable{Runnable: reportCache}); err != nil {
+		return err
+	}
+	if err = mgr.Add(&nonLeaderElectionRunnable{Runnable: manager.RunnableFunc(func(ctx context.Context) error {
+		if !reportCache.WaitForCacheSync(ctx) {
+			return nil
+		}
+		return reports.run(ctx)
+	})}); err != nil {
+		return err
+	}
+	if err = mgr.Add(manager.RunnableFunc(reports.runJanitor)); err != nil {
+		return err
+	}
+	k.deliveryReports = reports
+	return nil
+}
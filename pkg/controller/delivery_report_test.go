@@ -0,0 +1,164 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestAggregateDeliveryStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []model.DeliveryStatus
+		want     model.DeliveryStatus
+	}{
+		{
+			name: "no replica",
+			want: model.DeliveryStatus{},
+		},
+		{
+			name: "sum of replicas on the same version",
+			statuses: []model.DeliveryStatus{
+				{Version: 3, ConnectedInstances: 2, AckedInstances: 2},
+				{Version: 3, ConnectedInstances: 3, AckedInstances: 1, NackedInstances: 1, LastNackMessage: "bad rule"},
+			},
+			want: model.DeliveryStatus{Version: 3, ConnectedInstances: 5, AckedInstances: 3, NackedInstances: 1, LastNackMessage: "bad rule"},
+		},
+		{
+			name: "instances of an outdated replica are pending",
+			statuses: []model.DeliveryStatus{
+				{Version: 2, ConnectedInstances: 2, AckedInstances: 2},
+				{Version: 3, ConnectedInstances: 1, AckedInstances: 1},
+			},
+			want: model.DeliveryStatus{Version: 3, ConnectedInstances: 3, AckedInstances: 1},
+		},
+		{
+			name: "the version of a replica without instances is ignored",
+			statuses: []model.DeliveryStatus{
+				{Version: 4},
+				{Version: 3, ConnectedInstances: 1, AckedInstances: 1},
+			},
+			want: model.DeliveryStatus{Version: 3, ConnectedInstances: 1, AckedInstances: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := aggregateDeliveryStatus(tt.statuses); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("aggregateDeliveryStatus() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func newTestDeliveryReports(replica string, c client.Client, local map[statusKey]model.DeliveryStatus) *deliveryReports {
+	d := newDeliveryReports(replica, "opensergo-system", c, c)
+	d.setLocalProvider(func(namespace, app, kind string) model.DeliveryStatus {
+		return local[statusKey{NamespacedApp: model.NamespacedApp{Namespace: namespace, App: app}, kind: kind}]
+	})
+	for key := range local {
+		d.markChanged(key)
+	}
+	return d
+}
+
+func TestDeliveryReports(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	key := statusKey{NamespacedApp: testNamespacedApp, kind: RateLimitStrategyKind}
+	otherKey := statusKey{NamespacedApp: model.NamespacedApp{Namespace: testNamespace, App: "bar-app"}, kind: RateLimitStrategyKind}
+
+	leaderLocal := map[statusKey]model.DeliveryStatus{
+		key: {Version: 5, ConnectedInstances: 1, AckedInstances: 1},
+	}
+	leader := newTestDeliveryReports("replica-0", c, leaderLocal)
+	var changed []statusKey
+	leader.changedHandler = func(key statusKey) {
+		changed = append(changed, key)
+	}
+	follower := newTestDeliveryReports("replica-1", c, map[statusKey]model.DeliveryStatus{
+		key:      {Version: 5, ConnectedInstances: 2, AckedInstances: 1, NackedInstances: 1, LastNackMessage: "bad rule"},
+		otherKey: {Version: 7},
+	})
+	stale := newTestDeliveryReports("replica-2", c, map[statusKey]model.DeliveryStatus{
+		key: {Version: 5, ConnectedInstances: 4, AckedInstances: 4},
+	})
+
+	now := time.Now()
+	if err := follower.flush(ctx, now); err != nil {
+		t.Fatal(err)
+	}
+	if err := stale.flush(ctx, now.Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if follower.keys[otherKey] {
+		t.Error("the key without connected instances is still reported")
+	}
+
+	// The report of the leader itself is ignored in favor of the local status, and the expired report is ignored.
+	want := model.DeliveryStatus{Version: 5, ConnectedInstances: 3, AckedInstances: 2, NackedInstances: 1, LastNackMessage: "bad rule"}
+	if got := leader.Status(key.Namespace, key.App, key.kind); !reflect.DeepEqual(got, want) {
+		t.Errorf("Status() = %+v, want %+v", got, want)
+	}
+
+	// A renewal keeps the report, while an update of the report triggers the status update of its keys.
+	lease := &coordinationv1.Lease{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: "opensergo-system", Name: "opensergo-delivery-replica-1"}, lease); err != nil {
+		t.Fatal(err)
+	}
+	renewed := lease.DeepCopy()
+	leader.eventHandler().OnUpdate(lease, renewed)
+	if len(changed) != 0 {
+		t.Errorf("changed keys on renewal = %v, want none", changed)
+	}
+	follower.setLocalProvider(func(namespace, app, kind string) model.DeliveryStatus {
+		return model.DeliveryStatus{Version: 6, ConnectedInstances: 2, AckedInstances: 2}
+	})
+	follower.markChanged(key)
+	if err := follower.flush(ctx, now.Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	updated := &coordinationv1.Lease{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: "opensergo-system", Name: "opensergo-delivery-replica-1"}, updated); err != nil {
+		t.Fatal(err)
+	}
+	leader.eventHandler().OnUpdate(lease, updated)
+	if !reflect.DeepEqual(changed, []statusKey{key}) {
+		t.Errorf("changed keys = %v, want %v", changed, []statusKey{key})
+	}
+	want = model.DeliveryStatus{Version: 6, ConnectedInstances: 3, AckedInstances: 2}
+	if got := leader.Status(key.Namespace, key.App, key.kind); !reflect.DeepEqual(got, want) {
+		t.Errorf("Status() after update = %+v, want %+v", got, want)
+	}
+
+	// The expired report is removed by the leader.
+	if err := leader.removeExpiredReports(ctx, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	leases, err := leader.listReports(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leases) != 1 || leases[0].Name != "opensergo-delivery-replica-1" {
+		t.Errorf("got %d reports, want only the report of replica-1", len(leases))
+	}
+}
@@ -24,11 +24,17 @@ import (
 	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
 	crdv1alpha1traffic "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1/traffic"
 	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	"github.com/opensergo/opensergo-control-plane/pkg/source"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -47,6 +53,11 @@ func init() {
 	// +kubebuilder:scaffold:scheme
 }
 
+// Scheme returns the scheme of the operator, where the Go types of all registered kinds are registered.
+func Scheme() *runtime.Scheme {
+	return scheme
+}
+
 type CRDType int32
 
 const (
@@ -66,51 +77,291 @@ func (c CRDType) String() string {
 }
 
 type KubernetesOperator struct {
-	crdManager  ctrl.Manager
+	// clusters consists of all watched clusters, and the primary cluster is always the first one.
+	clusters    []*Cluster
 	controllers map[string]*CRDWatcher
 	ctx         context.Context
 	ctxCancel   context.CancelFunc
 	started     atomic.Value
 
-	sendDataHandler model.DataEntirePushHandler
+	emitter        *source.EventEmitter
+	leaderElection bool
+
+	// statusQueue consists of the rules whose status should be updated, which is processed by the leader.
+	statusQueue            workqueue.RateLimitingInterface
+	deliveryStatusProvider model.DeliveryStatusProvider
+	// deliveryReports aggregates the delivery status across replicas, which is only present with leader election.
+	deliveryReports *deliveryReports
+
+	// dependencies is the dependency graph between FaultToleranceRules and strategies.
+	dependencies *DependencyGraph
+
+	// watchCRDs indicates whether the CRDs are watched, which is false when the rules are only sourced from ConfigMaps.
+	watchCRDs bool
+	// configMapObjects represents a map: ConfigMap (namespace, name) -> OpenSergo objects decoded from the ConfigMap
+	configMapObjects map[types.NamespacedName][]client.Object
+	// configMapRevision is the largest resourceVersion of the observed ConfigMaps, shared by all watchers.
+	configMapRevision *observedRevision
 
 	controllerMux sync.RWMutex
 }
 
-// NewKubernetesOperator creates a OpenSergo Kubernetes operator.
+// KubernetesOperatorOptions represents the options of the OpenSergo Kubernetes operator.
+type KubernetesOperatorOptions struct {
+	// Clusters consists of the clusters which OpenSergo rules are sourced from.
+	// If empty, only the cluster of the default config will be watched.
+	Clusters []ClusterConfig
+
+	// LeaderElection enables leader election among the replicas of the control plane, which is done
+	// in the primary cluster. All replicas watch CRDs and serve subscriptions, while singleton duties
+	// (e.g. status updates and event recording) are only performed by the leader.
+	// As the instances of an app may connect to different replicas, each replica reports the delivery status of
+	// its instances in a Lease of the leader election namespace, which the leader aggregates in the status of CRDs.
+	// When enabled, the version of rules is derived from the resourceVersions of the observed objects,
+	// so that all replicas agree on it.
+	LeaderElection bool
+	// LeaderElectionNamespace is the namespace of the leader election resource.
+	// Defaults to the namespace of the control plane when running in a cluster.
+	LeaderElectionNamespace string
+	// LeaderElectionID is the name of the leader election resource. Defaults to DefaultLeaderElectionID.
+	LeaderElectionID string
+
+	// StrategyDeletionPolicy decides what happens when a strategy referenced by FaultToleranceRules is deleted.
+	// Defaults to StrategyDeletionPolicyWarn.
+	StrategyDeletionPolicy StrategyDeletionPolicy
+
+	// ConfigMapNamespaces consists of the namespaces of the primary cluster where the labeled ConfigMaps
+	// of OpenSergo manifests are watched. If empty, ConfigMaps are not watched.
+	// The objects in ConfigMaps are treated as the CRDs of the primary cluster, and the CRDs win on conflicts.
+	ConfigMapNamespaces []string
+	// ConfigMapSelector is the label selector of the ConfigMaps. Defaults to DefaultConfigMapSelector.
+	ConfigMapSelector string
+	// DisableCRDs disables watching CRDs, for the clusters where OpenSergo CRDs cannot be installed.
+	// The rules are then only sourced from ConfigMaps.
+	DisableCRDs bool
+}
+
+// DefaultLeaderElectionID is the default name of the leader election resource.
+const DefaultLeaderElectionID = "opensergo-control-plane-leader"
+
+// NewKubernetesOperator creates a OpenSergo Kubernetes operator which pushes the rules with the given handler.
+//
+// Deprecated: Use NewKubernetesSource, which creates the operator as a source.ConfigSource
+// whose rules are handled by the event handler.
 func NewKubernetesOperator(sendDataHandler model.DataEntirePushHandler) (*KubernetesOperator, error) {
+	k, err := NewKubernetesSource()
+	if err != nil {
+		return nil, err
+	}
+	k.SetEventHandler(pushingEventHandler(sendDataHandler))
+	return k, nil
+}
+
+// NewKubernetesSource creates a OpenSergo Kubernetes operator with the default options,
+// which serves as the source of rules of the control plane.
+func NewKubernetesSource() (*KubernetesOperator, error) {
+	return NewKubernetesOperatorWithOptions(KubernetesOperatorOptions{})
+}
+
+// pushingEventHandler adapts the handler which pushes the rules of a (namespace, app, kind) to the config events.
+func pushingEventHandler(sendDataHandler model.DataEntirePushHandler) source.ConfigEventHandler {
+	return func(event source.ConfigEvent) {
+		status := &trpb.Status{
+			Code:    int32(200),
+			Message: "Get and send rule success",
+			Details: nil,
+		}
+		target := event.Target
+		if err := sendDataHandler(target.Namespace, target.AppName, target.Kind, event.DataWithVersion, status, ""); err != nil {
+			setupLog.Error(err, "Failed to send rules", "kind", target.Kind, "namespace", target.Namespace, "app", target.AppName)
+		}
+	}
+}
+
+// NewKubernetesOperatorWithOptions creates a OpenSergo Kubernetes operator with the given options.
+// A manager is created for each of the clusters.
+func NewKubernetesOperatorWithOptions(options KubernetesOperatorOptions) (*KubernetesOperator, error) {
 	ctrl.SetLogger(&k8SLogger{
 		l:             logging.GetGlobalLogger(),
 		level:         logging.GetGlobalLoggerLevel(),
 		names:         make([]string, 0),
 		keysAndValues: make([]interface{}, 0),
 	})
-	k8sConfig, err := ctrl.GetConfig()
-	if err != nil {
-		return nil, err
-	}
-	mgr, err := ctrl.NewManager(k8sConfig, ctrl.Options{
+	mgrOptions := ctrl.Options{
 		Scheme: scheme,
 		// disable metric server
 		MetricsBindAddress:     "0",
 		HealthProbeBindAddress: "0",
 		LeaderElection:         false,
-	})
+	}
+	primaryOptions := mgrOptions
+	if options.LeaderElection {
+		primaryOptions.LeaderElection = true
+		primaryOptions.LeaderElectionNamespace = options.LeaderElectionNamespace
+		primaryOptions.LeaderElectionID = options.LeaderElectionID
+		if primaryOptions.LeaderElectionID == "" {
+			primaryOptions.LeaderElectionID = DefaultLeaderElectionID
+		}
+	}
+	if options.DisableCRDs {
+		if len(options.ConfigMapNamespaces) == 0 {
+			return nil, errors.New("no ConfigMap namespace to source rules from while CRDs are disabled")
+		}
+		if options.StrategyDeletionPolicy == StrategyDeletionPolicyBlock {
+			return nil, errors.New("the strategy deletion policy Block requires CRDs")
+		}
+	}
+	clusters, err := newClusters(options.Clusters, mgrOptions, primaryOptions)
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		return nil, err
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	k := &KubernetesOperator{
-		crdManager:      mgr,
-		controllers:     make(map[string]*CRDWatcher),
-		ctx:             ctx,
-		ctxCancel:       cancel,
-		sendDataHandler: sendDataHandler,
+		clusters:       clusters,
+		controllers:    make(map[string]*CRDWatcher),
+		ctx:            ctx,
+		ctxCancel:      cancel,
+		emitter:        source.NewEventEmitter(),
+		leaderElection: options.LeaderElection,
+		statusQueue:    workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "status"),
+		dependencies:   NewDependencyGraph(),
+		watchCRDs:      !options.DisableCRDs,
+
+		configMapObjects:  make(map[types.NamespacedName][]client.Object),
+		configMapRevision: &observedRevision{},
+	}
+	switch options.StrategyDeletionPolicy {
+	case "", StrategyDeletionPolicyWarn:
+	case StrategyDeletionPolicyBlock:
+		if err = setupStrategyProtection(clusters); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Errorf("unknown strategy deletion policy: %s", options.StrategyDeletionPolicy)
+	}
+	if len(options.ConfigMapNamespaces) > 0 {
+		if err = k.setupConfigMapWatch(options.ConfigMapNamespaces, options.ConfigMapSelector); err != nil {
+			return nil, err
+		}
+	}
+	if options.LeaderElection {
+		if err = k.setupDeliveryReports(options.LeaderElectionNamespace); err != nil {
+			return nil, err
+		}
+	}
+	if err = k.AddLeaderRunnable(manager.RunnableFunc(k.runStatusUpdater)); err != nil {
+		return nil, err
 	}
 	return k, nil
 }
 
+// Clusters returns all watched clusters. The primary cluster is always the first one.
+func (k *KubernetesOperator) Clusters() []*Cluster {
+	return k.clusters
+}
+
+// Elected is closed when the current replica becomes the leader, or when the operator starts
+// if leader election is disabled.
+func (k *KubernetesOperator) Elected() <-chan struct{} {
+	return k.clusters[0].manager.Elected()
+}
+
+// IsLeader checks whether the current replica is the leader.
+func (k *KubernetesOperator) IsLeader() bool {
+	select {
+	case <-k.Elected():
+		return true
+	default:
+		return false
+	}
+}
+
+// AddLeaderRunnable adds a singleton runnable (e.g. a global token server) which only runs on the leader.
+// The runnable will be started once the current replica becomes the leader, or when the operator starts
+// if leader election is disabled.
+func (k *KubernetesOperator) AddLeaderRunnable(runnable manager.Runnable) error {
+	return k.clusters[0].manager.Add(&leaderElectionRunnable{Runnable: runnable})
+}
+
+// leaderElectionRunnable wraps a runnable that only runs on the leader, even if the runnable itself
+// does not need leader election, e.g. a controller.
+type leaderElectionRunnable struct {
+	manager.Runnable
+}
+
+func (r *leaderElectionRunnable) NeedLeaderElection() bool {
+	return true
+}
+
+// SetDeliveryStatusProvider sets the provider of the delivery status of rules, which is reflected in the status of CRDs.
+func (k *KubernetesOperator) SetDeliveryStatusProvider(provider model.DeliveryStatusProvider) {
+	k.controllerMux.Lock()
+	defer k.controllerMux.Unlock()
+
+	k.deliveryStatusProvider = provider
+	if k.deliveryReports != nil {
+		k.deliveryReports.setLocalProvider(provider)
+	}
+}
+
+// UpdateStatus triggers the status update of the CRDs of the given (namespace, app, kind),
+// e.g. when the delivery status of the rules changes. The status is only updated by the leader.
+func (k *KubernetesOperator) UpdateStatus(namespace, app, kind string) {
+	n := model.NamespacedApp{Namespace: namespace, App: app}
+	if k.deliveryReports != nil {
+		k.deliveryReports.markChanged(statusKey{NamespacedApp: n, kind: kind})
+	}
+	k.enqueueStatusUpdate(n, kind)
+}
+
+func (k *KubernetesOperator) enqueueStatusUpdate(n model.NamespacedApp, kind model.SubscribeKind) {
+	k.statusQueue.Add(statusKey{NamespacedApp: n, kind: kind})
+}
+
+// runStatusUpdater updates the status of CRDs in the queue until the context is done.
+func (k *KubernetesOperator) runStatusUpdater(ctx context.Context) error {
+	go func() {
+		<-ctx.Done()
+		k.statusQueue.ShutDown()
+	}()
+	for k.processNextStatusUpdate(ctx) {
+	}
+	return nil
+}
+
+func (k *KubernetesOperator) processNextStatusUpdate(ctx context.Context) bool {
+	item, shutdown := k.statusQueue.Get()
+	if shutdown {
+		return false
+	}
+	defer k.statusQueue.Done(item)
+
+	key := item.(statusKey)
+	watcher, exists := k.GetWatcher(key.kind)
+	if !exists {
+		k.statusQueue.Forget(item)
+		return true
+	}
+	k.controllerMux.RLock()
+	provider := k.deliveryStatusProvider
+	if provider != nil && k.deliveryReports != nil {
+		provider = k.deliveryReports.Status
+	}
+	k.controllerMux.RUnlock()
+	var delivery model.DeliveryStatus
+	if provider != nil {
+		delivery = provider(key.Namespace, key.App, key.kind)
+	}
+	if err := watcher.updateStatus(ctx, key.NamespacedApp, delivery); err != nil {
+		setupLog.Error(err, "Failed to update the status of OpenSergo CRD", "kind", key.kind, "namespace", key.Namespace, "app", key.App)
+		k.statusQueue.AddRateLimited(item)
+		return true
+	}
+	k.statusQueue.Forget(item)
+	return true
+}
+
 func (k *KubernetesOperator) RegisterControllersAndStart(info model.SubscribeTarget) error {
 	_, err := k.RegisterWatcher(info)
 	if err != nil {
@@ -145,12 +396,7 @@ func (k *KubernetesOperator) RegisterWatcher(target model.SubscribeTarget) (*CRD
 			return nil, errors.New("CRD not supported: " + target.Kind)
 		}
 		// This kind of CRD has never been watched.
-		crdWatcher := NewCRDWatcher(k.crdManager, target.Kind, crdMetadata.Generator(), k.sendDataHandler)
-		err = crdWatcher.AddSubscribeTarget(target)
-		if err != nil {
-			return nil, err
-		}
-		err = crdWatcher.SetupWithManager(k.crdManager)
+		crdWatcher, err := k.newCRDWatcher(target, crdMetadata)
 		if err != nil {
 			return nil, err
 		}
@@ -178,21 +424,10 @@ func (k *KubernetesOperator) AddWatcher(target model.SubscribeTarget) error {
 		if !crdSupports {
 			return errors.New("CRD not supported: " + target.Kind)
 		}
-		crdWatcher := NewCRDWatcher(k.crdManager, target.Kind, crdMetadata.Generator(), k.sendDataHandler)
-		err = crdWatcher.AddSubscribeTarget(target)
-		if err != nil {
-			return err
-		}
-
-		crdRunnable, err := ctrl.NewControllerManagedBy(k.crdManager).For(crdMetadata.Generator()()).Build(crdWatcher)
-		if err != nil {
-			return err
-		}
-		err = k.crdManager.Add(crdRunnable)
+		crdWatcher, err := k.newCRDWatcher(target, crdMetadata)
 		if err != nil {
 			return err
 		}
-		// _ = crdRunnable.Start(k.ctx)
 		k.controllers[target.Kind] = crdWatcher
 
 	}
@@ -200,6 +435,52 @@ func (k *KubernetesOperator) AddWatcher(target model.SubscribeTarget) error {
 	return nil
 }
 
+// newCRDWatcher creates a watcher for the kind of the target, and registers it to the managers of all clusters.
+func (k *KubernetesOperator) newCRDWatcher(target model.SubscribeTarget, crdMetadata *CRDMetadata) (*CRDWatcher, error) {
+	crdWatcher := NewCRDWatcher(k.clusters, target.Kind, crdMetadata.Generator(), k.emitRules, k.leaderElection)
+	crdWatcher.statusUpdateHandler = k.enqueueStatusUpdate
+	crdWatcher.configMapRevision = k.configMapRevision
+	if target.Kind == FaultToleranceRuleKind {
+		crdWatcher.dependencies = k.dependencies
+	}
+	err := crdWatcher.AddSubscribeTarget(target)
+	if err != nil {
+		return nil, err
+	}
+	crdWatcher.setConfigMapObjects(k.configMapObjectsOfKind(target.Kind))
+	if !k.watchCRDs {
+		return crdWatcher, nil
+	}
+	err = crdWatcher.SetupWithClusters()
+	if err != nil {
+		return nil, err
+	}
+	return crdWatcher, nil
+}
+
+// SetEventHandler sets the handler of the config events, which implements source.ConfigSource.
+func (k *KubernetesOperator) SetEventHandler(handler source.ConfigEventHandler) {
+	k.emitter.SetHandler(handler)
+}
+
+// Subscribe registers the watcher of the target and returns the current rules of the target,
+// which implements source.ConfigSource.
+func (k *KubernetesOperator) Subscribe(target model.SubscribeTarget) (*trpb.DataWithVersion, error) {
+	crdWatcher, err := k.RegisterWatcher(target)
+	if err != nil {
+		return nil, err
+	}
+	data := crdWatcher.GetDataWithVersion(target.NamespacedApp())
+	k.emitter.Observe(target, data)
+	return data, nil
+}
+
+// emitRules emits the rules pushed by the watchers as config events.
+func (k *KubernetesOperator) emitRules(namespace, app, kind string, dataWithVersion *trpb.DataWithVersion, _ *trpb.Status, _ string) error {
+	k.emitter.Emit(model.SubscribeTarget{Namespace: namespace, AppName: app, Kind: kind}, dataWithVersion)
+	return nil
+}
+
 // Close exit the K8S KubernetesOperator
 func (k *KubernetesOperator) Close() error {
 	k.ctxCancel()
@@ -214,13 +495,16 @@ func (k *KubernetesOperator) ComponentName() string {
 func (k *KubernetesOperator) Run() error {
 
 	// +kubebuilder:scaffold:builder
-	go util.RunWithRecover(func() {
-		setupLog.Info("Starting OpenSergo operator")
-		if err := k.crdManager.Start(k.ctx); err != nil {
-			setupLog.Error(err, "problem running OpenSergo operator")
-		}
-		setupLog.Info("OpenSergo operator will be closed")
-	})
+	for _, c := range k.clusters {
+		c := c
+		go util.RunWithRecover(func() {
+			setupLog.Info("Starting OpenSergo operator", "cluster", c.name)
+			if err := c.manager.Start(k.ctx); err != nil {
+				setupLog.Error(err, "problem running OpenSergo operator", "cluster", c.name)
+			}
+			setupLog.Info("OpenSergo operator will be closed", "cluster", c.name)
+		})
+	}
 	return nil
 }
 
@@ -0,0 +1,222 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	"github.com/opensergo/opensergo-control-plane/pkg/source"
+	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+func TestPushingEventHandler(t *testing.T) {
+	type push struct {
+		namespace, app, kind string
+		version              int64
+		rules                int
+		code                 int32
+	}
+	var pushes []push
+	emitter := source.NewEventEmitter()
+	emitter.SetHandler(pushingEventHandler(func(namespace, app, kind string, dataWithVersion *trpb.DataWithVersion, status *trpb.Status, respId string) error {
+		pushes = append(pushes, push{namespace, app, kind, dataWithVersion.Version, len(dataWithVersion.Data), status.Code})
+		return nil
+	}))
+
+	target := model.SubscribeTarget{Namespace: "default", AppName: "foo-app", Kind: RateLimitStrategyKind}
+	emitter.Emit(target, &trpb.DataWithVersion{Version: 1})
+	emitter.Emit(target, &trpb.DataWithVersion{Version: 2, Data: []*anypb.Any{{}}})
+	emitter.Emit(target, &trpb.DataWithVersion{Version: 3})
+
+	want := []push{
+		{"default", "foo-app", RateLimitStrategyKind, 2, 1, 200},
+		{"default", "foo-app", RateLimitStrategyKind, 3, 0, 200},
+	}
+	if len(pushes) != len(want) {
+		t.Fatalf("pushes = %+v, want %+v", pushes, want)
+	}
+	for i := range want {
+		if pushes[i] != want[i] {
+			t.Errorf("push %d = %+v, want %+v", i, pushes[i], want[i])
+		}
+	}
+}
+
+// fakeLeaseServer serves the Leases of the leader election in memory, and accepts any other request,
+// e.g. the events of the leader election.
+type fakeLeaseServer struct {
+	leases  map[string][]byte
+	version int
+
+	mux sync.Mutex
+}
+
+func (f *fakeLeaseServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	body, _ := ioutil.ReadAll(r.Body)
+	if !strings.HasPrefix(r.URL.Path, "/apis/coordination.k8s.io/v1/") {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(body)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		lease, exists := f.leases[r.URL.Path]
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(metav1.Status{
+				TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+				Status:   metav1.StatusFailure,
+				Reason:   metav1.StatusReasonNotFound,
+				Code:     http.StatusNotFound,
+			})
+			return
+		}
+		_, _ = w.Write(lease)
+	case http.MethodPost, http.MethodPut:
+		var lease map[string]interface{}
+		if err := json.Unmarshal(body, &lease); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.version++
+		metadata, _ := lease["metadata"].(map[string]interface{})
+		metadata["resourceVersion"] = strconv.Itoa(f.version)
+		path := r.URL.Path
+		if r.Method == http.MethodPost {
+			path += "/" + metadata["name"].(string)
+		}
+		f.leases[path], _ = json.Marshal(lease)
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		_, _ = w.Write(f.leases[path])
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// newTestReplica creates an operator of a replica, whose primary manager runs the leader election
+// against the given API server.
+func newTestReplica(t *testing.T, host string) *KubernetesOperator {
+	t.Helper()
+	leaseDuration, renewDeadline, retryPeriod := 2*time.Second, 1500*time.Millisecond, 100*time.Millisecond
+	mgr, err := ctrl.NewManager(&rest.Config{Host: host}, ctrl.Options{
+		Scheme:                        scheme,
+		MetricsBindAddress:            "0",
+		HealthProbeBindAddress:        "0",
+		LeaderElection:                true,
+		LeaderElectionResourceLock:    "leases",
+		LeaderElectionNamespace:       "default",
+		LeaderElectionID:              DefaultLeaderElectionID,
+		LeaderElectionReleaseOnCancel: true,
+		LeaseDuration:                 &leaseDuration,
+		RenewDeadline:                 &renewDeadline,
+		RetryPeriod:                   &retryPeriod,
+		MapperProvider: func(*rest.Config) (meta.RESTMapper, error) {
+			return meta.NewDefaultRESTMapper(nil), nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &KubernetesOperator{clusters: []*Cluster{{name: DefaultClusterName, primary: true, manager: mgr}}}
+}
+
+func TestAddLeaderRunnable(t *testing.T) {
+	server := httptest.NewServer(&fakeLeaseServer{leases: make(map[string][]byte)})
+	defer server.Close()
+
+	type replica struct {
+		operator *KubernetesOperator
+		started  chan struct{}
+		cancel   context.CancelFunc
+		done     chan struct{}
+	}
+	start := func(name string) *replica {
+		r := &replica{operator: newTestReplica(t, server.URL), started: make(chan struct{}), done: make(chan struct{})}
+		// The runnable which does not need leader election by itself still only runs on the leader.
+		err := r.operator.AddLeaderRunnable(&nonLeaderElectionRunnable{Runnable: manager.RunnableFunc(func(ctx context.Context) error {
+			close(r.started)
+			<-ctx.Done()
+			return nil
+		})})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		r.cancel = cancel
+		go func() {
+			defer close(r.done)
+			if err := r.operator.clusters[0].manager.Start(ctx); err != nil {
+				t.Errorf("replica %s: %v", name, err)
+			}
+		}()
+		return r
+	}
+	stop := func(r *replica) {
+		r.cancel()
+		<-r.done
+	}
+
+	first := start("first")
+	defer stop(first)
+	select {
+	case <-first.started:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the leader runnable is not started on the elected replica")
+	}
+	if !first.operator.IsLeader() {
+		t.Error("the first replica is not the leader")
+	}
+
+	second := start("second")
+	defer stop(second)
+	select {
+	case <-second.started:
+		t.Fatal("the leader runnable is started on the replica which is not elected")
+	case <-time.After(time.Second):
+	}
+	if second.operator.IsLeader() {
+		t.Error("both replicas are the leader")
+	}
+
+	// The other replica takes over once the leader steps down.
+	stop(first)
+	select {
+	case <-second.started:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the leader runnable is not started on the newly elected replica")
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	commonpb "github.com/opensergo/opensergo-control-plane/pkg/proto/common/v1"
+)
+
+// Duration is a duration in the form of <value><unit> of OpenSergo CRDs, e.g. 10s.
+type Duration struct {
+	Value int64
+	Unit  commonpb.TimeUnit
+}
+
+var unitMillis = map[commonpb.TimeUnit]int64{
+	commonpb.TimeUnit_MILLISECOND: 1,
+	commonpb.TimeUnit_SECOND:      1000,
+	commonpb.TimeUnit_MINUTE:      60 * 1000,
+	commonpb.TimeUnit_HOUR:        60 * 60 * 1000,
+	commonpb.TimeUnit_DAY:         24 * 60 * 60 * 1000,
+}
+
+var unitSymbols = map[commonpb.TimeUnit]string{
+	commonpb.TimeUnit_MILLISECOND: "ms",
+	commonpb.TimeUnit_SECOND:      "s",
+	commonpb.TimeUnit_MINUTE:      "min",
+	commonpb.TimeUnit_HOUR:        "h",
+	commonpb.TimeUnit_DAY:         "d",
+}
+
+// canonicalUnits consists of all units from the largest to the smallest.
+var canonicalUnits = []commonpb.TimeUnit{
+	commonpb.TimeUnit_DAY,
+	commonpb.TimeUnit_HOUR,
+	commonpb.TimeUnit_MINUTE,
+	commonpb.TimeUnit_SECOND,
+	commonpb.TimeUnit_MILLISECOND,
+}
+
+func parseTimeUnit(unit string) (commonpb.TimeUnit, bool) {
+	switch unit {
+	case "ms":
+		return commonpb.TimeUnit_MILLISECOND, true
+	case "s":
+		return commonpb.TimeUnit_SECOND, true
+	case "m", "min", "minute":
+		return commonpb.TimeUnit_MINUTE, true
+	case "h":
+		return commonpb.TimeUnit_HOUR, true
+	case "d":
+		return commonpb.TimeUnit_DAY, true
+	default:
+		return commonpb.TimeUnit_UNKNOWN, false
+	}
+}
+
+// ParseDuration parses the duration of the field, where the unit is one of ms, s, m, min, minute, h and d.
+func ParseDuration(field, value string) (Duration, error) {
+	i := strings.IndexFunc(value, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if i < 0 {
+		return Duration{}, newConversionError(field, value, "missing time unit (ms, s, min, h or d)", nil)
+	}
+	if i == 0 {
+		return Duration{}, newConversionError(field, value, "expected a non-negative integer followed by a time unit", nil)
+	}
+	num, err := strconv.ParseInt(value[:i], 10, 64)
+	if err != nil {
+		return Duration{}, newConversionError(field, value, "invalid number", err)
+	}
+	unit, ok := parseTimeUnit(value[i:])
+	if !ok {
+		return Duration{}, newConversionError(field, value, "unknown time unit "+strconv.Quote(value[i:]), nil)
+	}
+	if num > math.MaxInt64/unitMillis[unit] {
+		return Duration{}, newConversionError(field, value, "duration overflows", nil)
+	}
+	return Duration{Value: num, Unit: unit}, nil
+}
+
+// ParsePositiveDuration parses the duration of the field, which must be larger than 0.
+func ParsePositiveDuration(field, value string) (Duration, error) {
+	d, err := ParseDuration(field, value)
+	if err != nil {
+		return d, err
+	}
+	if d.Value == 0 {
+		return d, newConversionError(field, value, "must be positive", nil)
+	}
+	return d, nil
+}
+
+// Millis returns the duration in milliseconds.
+func (d Duration) Millis() int64 {
+	return d.Value * unitMillis[d.Unit]
+}
+
+// ValueInt32 returns the value of the duration as int32, which is used in proto messages with a TimeUnit.
+func (d Duration) ValueInt32(field string) (int32, error) {
+	return ToInt32(field, d.Value)
+}
+
+// MillisInt32 returns the duration in milliseconds as int32.
+func (d Duration) MillisInt32(field string) (int32, error) {
+	return ToInt32(field, d.Millis())
+}
+
+// Canonical returns the canonical form of the duration, which uses the largest unit
+// that represents the duration exactly, e.g. 60s -> 1min.
+func (d Duration) Canonical() string {
+	millis := d.Millis()
+	if millis == 0 {
+		return "0ms"
+	}
+	for _, unit := range canonicalUnits {
+		if millis%unitMillis[unit] == 0 {
+			return strconv.FormatInt(millis/unitMillis[unit], 10) + unitSymbols[unit]
+		}
+	}
+	return strconv.FormatInt(millis, 10) + unitSymbols[commonpb.TimeUnit_MILLISECOND]
+}
+
+// NormalizeDuration converts the duration of the field to the canonical form.
+func NormalizeDuration(field, value string) (string, error) {
+	d, err := ParseDuration(field, value)
+	if err != nil {
+		return "", err
+	}
+	return d.Canonical(), nil
+}
+
+// ToInt32 converts the int64 value of the field to int32.
+func ToInt32(field string, value int64) (int32, error) {
+	if value > math.MaxInt32 || value < math.MinInt32 {
+		return 0, newConversionError(field, value, "overflows int32", nil)
+	}
+	return int32(value), nil
+}
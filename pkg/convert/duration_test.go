@@ -0,0 +1,206 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	commonpb "github.com/opensergo/opensergo-control-plane/pkg/proto/common/v1"
+)
+
+// assertConversionError asserts that err is a *ConversionError of the field.
+func assertConversionError(t *testing.T, err error, field string) {
+	t.Helper()
+	var convertErr *ConversionError
+	if !errors.As(err, &convertErr) {
+		t.Fatalf("error = %v, want a *ConversionError", err)
+	}
+	if convertErr.Field != field {
+		t.Errorf("field = %q, want %q", convertErr.Field, field)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Duration
+		millis  int64
+		wantErr bool
+	}{
+		{value: "0ms", want: Duration{Value: 0, Unit: commonpb.TimeUnit_MILLISECOND}, millis: 0},
+		{value: "500ms", want: Duration{Value: 500, Unit: commonpb.TimeUnit_MILLISECOND}, millis: 500},
+		{value: "10s", want: Duration{Value: 10, Unit: commonpb.TimeUnit_SECOND}, millis: 10000},
+		{value: "2m", want: Duration{Value: 2, Unit: commonpb.TimeUnit_MINUTE}, millis: 120000},
+		{value: "2min", want: Duration{Value: 2, Unit: commonpb.TimeUnit_MINUTE}, millis: 120000},
+		{value: "2minute", want: Duration{Value: 2, Unit: commonpb.TimeUnit_MINUTE}, millis: 120000},
+		{value: "3h", want: Duration{Value: 3, Unit: commonpb.TimeUnit_HOUR}, millis: 3 * 3600000},
+		{value: "1d", want: Duration{Value: 1, Unit: commonpb.TimeUnit_DAY}, millis: 86400000},
+		{value: "", wantErr: true},
+		{value: "10", wantErr: true},
+		{value: "s", wantErr: true},
+		{value: "-1s", wantErr: true},
+		{value: "1.5s", wantErr: true},
+		{value: "10 s", wantErr: true},
+		{value: "10S", wantErr: true},
+		{value: "1w", wantErr: true},
+		{value: "99999999999999999999ms", wantErr: true},
+		{value: "106751991167301d", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseDuration("spec.statDuration", tt.value)
+			if tt.wantErr {
+				assertConversionError(t, err, "spec.statDuration")
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDuration() = %+v, want %+v", got, tt.want)
+			}
+			if got.Millis() != tt.millis {
+				t.Errorf("Millis() = %d, want %d", got.Millis(), tt.millis)
+			}
+		})
+	}
+}
+
+func TestParsePositiveDuration(t *testing.T) {
+	if _, err := ParsePositiveDuration("spec.timeout", "1ms"); err != nil {
+		t.Errorf("1ms: %v", err)
+	}
+	for _, value := range []string{"0ms", "0d", "1x"} {
+		_, err := ParsePositiveDuration("spec.timeout", value)
+		assertConversionError(t, err, "spec.timeout")
+	}
+}
+
+func TestDurationCanonical(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "0s", want: "0ms"},
+		{value: "0d", want: "0ms"},
+		{value: "1500ms", want: "1500ms"},
+		{value: "1000ms", want: "1s"},
+		{value: "60s", want: "1min"},
+		{value: "90s", want: "90s"},
+		{value: "1m", want: "1min"},
+		{value: "120minute", want: "2h"},
+		{value: "24h", want: "1d"},
+		{value: "48h", want: "2d"},
+		{value: "36h", want: "36h"},
+		{value: "7d", want: "7d"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := NormalizeDuration("spec.statDuration", tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeDuration() = %q, want %q", got, tt.want)
+			}
+			// The canonical form is a fixed point and represents the same duration.
+			again, err := ParseDuration("spec.statDuration", got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			original, _ := ParseDuration("spec.statDuration", tt.value)
+			if again.Canonical() != got || again.Millis() != original.Millis() {
+				t.Errorf("canonical form %q is not stable", got)
+			}
+		})
+	}
+
+	if _, err := NormalizeDuration("spec.statDuration", "10"); err == nil {
+		t.Error("NormalizeDuration() of an invalid duration succeeds")
+	}
+}
+
+func TestDurationInt32(t *testing.T) {
+	tests := []struct {
+		name      string
+		duration  Duration
+		value     int32
+		valueErr  bool
+		millis    int32
+		millisErr bool
+	}{
+		{
+			name:     "seconds",
+			duration: Duration{Value: 30, Unit: commonpb.TimeUnit_SECOND},
+			value:    30,
+			millis:   30000,
+		},
+		{
+			name:      "millis overflow",
+			duration:  Duration{Value: 30, Unit: commonpb.TimeUnit_DAY},
+			value:     30,
+			millisErr: true,
+		},
+		{
+			name:      "value overflows",
+			duration:  Duration{Value: math.MaxInt32 + 1, Unit: commonpb.TimeUnit_MILLISECOND},
+			valueErr:  true,
+			millisErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := tt.duration.ValueInt32("spec.timeout")
+			if tt.valueErr {
+				assertConversionError(t, err, "spec.timeout")
+			} else if err != nil || value != tt.value {
+				t.Errorf("ValueInt32() = %d, %v, want %d", value, err, tt.value)
+			}
+			millis, err := tt.duration.MillisInt32("spec.timeout")
+			if tt.millisErr {
+				assertConversionError(t, err, "spec.timeout")
+			} else if err != nil || millis != tt.millis {
+				t.Errorf("MillisInt32() = %d, %v, want %d", millis, err, tt.millis)
+			}
+		})
+	}
+}
+
+func TestToInt32(t *testing.T) {
+	tests := []struct {
+		value   int64
+		want    int32
+		wantErr bool
+	}{
+		{value: 0, want: 0},
+		{value: math.MaxInt32, want: math.MaxInt32},
+		{value: math.MinInt32, want: math.MinInt32},
+		{value: math.MaxInt32 + 1, wantErr: true},
+		{value: math.MinInt32 - 1, wantErr: true},
+		{value: math.MaxInt64, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ToInt32("spec.threshold", tt.value)
+		if tt.wantErr {
+			assertConversionError(t, err, "spec.threshold")
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ToInt32(%d) = %d, %v, want %d", tt.value, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"strconv"
+	"strings"
+
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+)
+
+const (
+	MetricTypeRequestAmount = "RequestAmount"
+
+	LimitModeLocal  = "Local"
+	LimitModeGlobal = "Global"
+
+	ControlBehaviorReject         = "Reject"
+	ControlBehaviorWarmUp         = "WarmUp"
+	ControlBehaviorQueueing       = "Queueing"
+	ControlBehaviorWarmUpQueueing = "WarmUpQueueing"
+
+	MatchTypeExact = "Exact"
+	MatchTypeGlob  = "Glob"
+	MatchTypeRegex = "Regex"
+
+	AdaptiveAlgorithmGradient = "Gradient"
+	AdaptiveAlgorithmVegas    = "Vegas"
+
+	ParamSourceHeader     = "Header"
+	ParamSourceQueryParam = "QueryParam"
+	ParamSourceArgIndex   = "ArgIndex"
+
+	CircuitBreakerStrategySlowRequestRatio  = "SlowRequestRatio"
+	CircuitBreakerStrategyErrorRequestRatio = "ErrorRequestRatio"
+	CircuitBreakerStrategyErrorRequestCount = "ErrorRequestCount"
+)
+
+func unsupportedValueError(field, value string, supported ...string) *ConversionError {
+	quoted := make([]string, 0, len(supported))
+	for _, s := range supported {
+		quoted = append(quoted, strconv.Quote(s))
+	}
+	return newConversionError(field, value, "supported values: "+strings.Join(quoted, ", "), nil)
+}
+
+// ParseMetricType parses the metric type of a RateLimitStrategy.
+func ParseMetricType(field, value string) (pb.RateLimitStrategy_MetricType, error) {
+	if strings.EqualFold(value, MetricTypeRequestAmount) {
+		return pb.RateLimitStrategy_TYPE_REQUEST_AMOUNT, nil
+	}
+	return pb.RateLimitStrategy_TYPE_UNKNOWN, unsupportedValueError(field, value, MetricTypeRequestAmount)
+}
+
+// ParseRateLimitMode parses the limit mode of a RateLimitStrategy.
+func ParseRateLimitMode(field, value string) (pb.RateLimitStrategy_LimitMode, error) {
+	switch {
+	case strings.EqualFold(value, LimitModeLocal):
+		return pb.RateLimitStrategy_MODE_LOCAL, nil
+	case strings.EqualFold(value, LimitModeGlobal):
+		return pb.RateLimitStrategy_MODE_GLOBAL, nil
+	default:
+		return pb.RateLimitStrategy_MODE_UNKNOWN, unsupportedValueError(field, value, LimitModeLocal, LimitModeGlobal)
+	}
+}
+
+// ParseControlBehavior parses the control behavior of a RateLimitStrategy.
+func ParseControlBehavior(field, value string) (pb.RateLimitStrategy_ControlBehavior, error) {
+	switch {
+	case strings.EqualFold(value, ControlBehaviorReject):
+		return pb.RateLimitStrategy_BEHAVIOR_REJECT, nil
+	case strings.EqualFold(value, ControlBehaviorWarmUp):
+		return pb.RateLimitStrategy_BEHAVIOR_WARM_UP, nil
+	case strings.EqualFold(value, ControlBehaviorQueueing):
+		return pb.RateLimitStrategy_BEHAVIOR_QUEUEING, nil
+	case strings.EqualFold(value, ControlBehaviorWarmUpQueueing):
+		return pb.RateLimitStrategy_BEHAVIOR_WARM_UP_QUEUEING, nil
+	default:
+		return pb.RateLimitStrategy_BEHAVIOR_REJECT, unsupportedValueError(field, value,
+			ControlBehaviorReject, ControlBehaviorWarmUp, ControlBehaviorQueueing, ControlBehaviorWarmUpQueueing)
+	}
+}
+
+// ParseMatchType parses the match type of the targets of a FaultToleranceRule.
+func ParseMatchType(field, value string) (pb.FaultToleranceRule_MatchType, error) {
+	switch {
+	case strings.EqualFold(value, MatchTypeExact):
+		return pb.FaultToleranceRule_MATCH_EXACT, nil
+	case strings.EqualFold(value, MatchTypeGlob):
+		return pb.FaultToleranceRule_MATCH_GLOB, nil
+	case strings.EqualFold(value, MatchTypeRegex):
+		return pb.FaultToleranceRule_MATCH_REGEX, nil
+	default:
+		return pb.FaultToleranceRule_MATCH_EXACT, unsupportedValueError(field, value, MatchTypeExact, MatchTypeGlob, MatchTypeRegex)
+	}
+}
+
+// ParseConcurrencyLimitMode parses the limit mode of a ConcurrencyLimitStrategy.
+func ParseConcurrencyLimitMode(field, value string) (pb.ConcurrencyLimitStrategy_LimitMode, error) {
+	switch {
+	case strings.EqualFold(value, LimitModeLocal):
+		return pb.ConcurrencyLimitStrategy_MODE_LOCAL, nil
+	case strings.EqualFold(value, LimitModeGlobal):
+		return pb.ConcurrencyLimitStrategy_MODE_GLOBAL, nil
+	default:
+		return pb.ConcurrencyLimitStrategy_MODE_UNKNOWN, unsupportedValueError(field, value, LimitModeLocal, LimitModeGlobal)
+	}
+}
+
+// ParseAdaptiveAlgorithm parses the algorithm of the adaptive limit of a ConcurrencyLimitStrategy.
+func ParseAdaptiveAlgorithm(field, value string) (pb.ConcurrencyLimitStrategy_AdaptiveAlgorithm, error) {
+	switch {
+	case strings.EqualFold(value, AdaptiveAlgorithmGradient):
+		return pb.ConcurrencyLimitStrategy_ALGORITHM_GRADIENT, nil
+	case strings.EqualFold(value, AdaptiveAlgorithmVegas):
+		return pb.ConcurrencyLimitStrategy_ALGORITHM_VEGAS, nil
+	default:
+		return pb.ConcurrencyLimitStrategy_ALGORITHM_UNKNOWN, unsupportedValueError(field, value, AdaptiveAlgorithmGradient, AdaptiveAlgorithmVegas)
+	}
+}
+
+// ParseParamSource parses the source of the parameter of a ParamFlowStrategy.
+func ParseParamSource(field, value string) (pb.ParamFlowStrategy_ParamSource, error) {
+	switch {
+	case strings.EqualFold(value, ParamSourceHeader):
+		return pb.ParamFlowStrategy_SOURCE_HEADER, nil
+	case strings.EqualFold(value, ParamSourceQueryParam):
+		return pb.ParamFlowStrategy_SOURCE_QUERY_PARAM, nil
+	case strings.EqualFold(value, ParamSourceArgIndex):
+		return pb.ParamFlowStrategy_SOURCE_ARG_INDEX, nil
+	default:
+		return pb.ParamFlowStrategy_SOURCE_UNKNOWN, unsupportedValueError(field, value, ParamSourceHeader, ParamSourceQueryParam, ParamSourceArgIndex)
+	}
+}
+
+// ParseParamFlowLimitMode parses the limit mode of a ParamFlowStrategy.
+func ParseParamFlowLimitMode(field, value string) (pb.ParamFlowStrategy_LimitMode, error) {
+	switch {
+	case strings.EqualFold(value, LimitModeLocal):
+		return pb.ParamFlowStrategy_MODE_LOCAL, nil
+	case strings.EqualFold(value, LimitModeGlobal):
+		return pb.ParamFlowStrategy_MODE_GLOBAL, nil
+	default:
+		return pb.ParamFlowStrategy_MODE_UNKNOWN, unsupportedValueError(field, value, LimitModeLocal, LimitModeGlobal)
+	}
+}
+
+// ParseCircuitBreakerStrategy parses the strategy of a CircuitBreakerStrategy.
+func ParseCircuitBreakerStrategy(field, value string) (pb.CircuitBreakerStrategy_Strategy, error) {
+	switch {
+	case strings.EqualFold(value, CircuitBreakerStrategySlowRequestRatio):
+		return pb.CircuitBreakerStrategy_STRATEGY_SLOW_REQUEST_RATIO, nil
+	case strings.EqualFold(value, CircuitBreakerStrategyErrorRequestRatio):
+		return pb.CircuitBreakerStrategy_STRATEGY_ERROR_REQUEST_RATIO, nil
+	case strings.EqualFold(value, CircuitBreakerStrategyErrorRequestCount):
+		return pb.CircuitBreakerStrategy_STRATEGY_ERROR_REQUEST_COUNT, nil
+	default:
+		return pb.CircuitBreakerStrategy_STRATEGY_UNKNOWN, unsupportedValueError(field, value,
+			CircuitBreakerStrategySlowRequestRatio, CircuitBreakerStrategyErrorRequestRatio, CircuitBreakerStrategyErrorRequestCount)
+	}
+}
+
+// grpcCodes represents a map: the name of a canonical gRPC status code -> the code
+var grpcCodes = map[string]int32{
+	"OK":                  0,
+	"CANCELLED":           1,
+	"UNKNOWN":             2,
+	"INVALID_ARGUMENT":    3,
+	"DEADLINE_EXCEEDED":   4,
+	"NOT_FOUND":           5,
+	"ALREADY_EXISTS":      6,
+	"PERMISSION_DENIED":   7,
+	"RESOURCE_EXHAUSTED":  8,
+	"FAILED_PRECONDITION": 9,
+	"ABORTED":             10,
+	"OUT_OF_RANGE":        11,
+	"UNIMPLEMENTED":       12,
+	"INTERNAL":            13,
+	"UNAVAILABLE":         14,
+	"DATA_LOSS":           15,
+	"UNAUTHENTICATED":     16,
+}
+
+// ParseGrpcCode parses the name of a canonical gRPC status code, e.g. UNAVAILABLE, of a FallbackAction.
+func ParseGrpcCode(field, value string) (int32, error) {
+	if code, exists := grpcCodes[strings.ToUpper(value)]; exists {
+		return code, nil
+	}
+	return 0, newConversionError(field, value, "must be the name of a canonical gRPC status code, e.g. \"UNAVAILABLE\"", nil)
+}
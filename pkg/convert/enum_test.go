@@ -0,0 +1,139 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+)
+
+func TestParseEnums(t *testing.T) {
+	// parse adapts each parser so that the enums are compared as int32.
+	type parse func(field, value string) (int32, error)
+	tests := []struct {
+		name    string
+		parse   parse
+		value   string
+		want    int32
+		wantErr bool
+	}{
+		{name: "metric type", parse: func(f, v string) (int32, error) { r, err := ParseMetricType(f, v); return int32(r), err },
+			value: "requestAmount", want: int32(pb.RateLimitStrategy_TYPE_REQUEST_AMOUNT)},
+		{name: "metric type", parse: func(f, v string) (int32, error) { r, err := ParseMetricType(f, v); return int32(r), err },
+			value: "", wantErr: true},
+		{name: "rate limit mode", parse: func(f, v string) (int32, error) { r, err := ParseRateLimitMode(f, v); return int32(r), err },
+			value: "Global", want: int32(pb.RateLimitStrategy_MODE_GLOBAL)},
+		{name: "rate limit mode", parse: func(f, v string) (int32, error) { r, err := ParseRateLimitMode(f, v); return int32(r), err },
+			value: "Cluster", wantErr: true},
+		{name: "control behavior", parse: func(f, v string) (int32, error) { r, err := ParseControlBehavior(f, v); return int32(r), err },
+			value: "warmupqueueing", want: int32(pb.RateLimitStrategy_BEHAVIOR_WARM_UP_QUEUEING)},
+		{name: "control behavior", parse: func(f, v string) (int32, error) { r, err := ParseControlBehavior(f, v); return int32(r), err },
+			value: "Throttling", wantErr: true},
+		{name: "match type", parse: func(f, v string) (int32, error) { r, err := ParseMatchType(f, v); return int32(r), err },
+			value: "Glob", want: int32(pb.FaultToleranceRule_MATCH_GLOB)},
+		{name: "match type", parse: func(f, v string) (int32, error) { r, err := ParseMatchType(f, v); return int32(r), err },
+			value: "Prefix", wantErr: true},
+		{name: "concurrency limit mode", parse: func(f, v string) (int32, error) { r, err := ParseConcurrencyLimitMode(f, v); return int32(r), err },
+			value: "LOCAL", want: int32(pb.ConcurrencyLimitStrategy_MODE_LOCAL)},
+		{name: "concurrency limit mode", parse: func(f, v string) (int32, error) { r, err := ParseConcurrencyLimitMode(f, v); return int32(r), err },
+			value: "", wantErr: true},
+		{name: "adaptive algorithm", parse: func(f, v string) (int32, error) { r, err := ParseAdaptiveAlgorithm(f, v); return int32(r), err },
+			value: "Vegas", want: int32(pb.ConcurrencyLimitStrategy_ALGORITHM_VEGAS)},
+		{name: "adaptive algorithm", parse: func(f, v string) (int32, error) { r, err := ParseAdaptiveAlgorithm(f, v); return int32(r), err },
+			value: "AIMD", wantErr: true},
+		{name: "param source", parse: func(f, v string) (int32, error) { r, err := ParseParamSource(f, v); return int32(r), err },
+			value: "queryParam", want: int32(pb.ParamFlowStrategy_SOURCE_QUERY_PARAM)},
+		{name: "param source", parse: func(f, v string) (int32, error) { r, err := ParseParamSource(f, v); return int32(r), err },
+			value: "Cookie", wantErr: true},
+		{name: "param flow limit mode", parse: func(f, v string) (int32, error) { r, err := ParseParamFlowLimitMode(f, v); return int32(r), err },
+			value: "Local", want: int32(pb.ParamFlowStrategy_MODE_LOCAL)},
+		{name: "param flow limit mode", parse: func(f, v string) (int32, error) { r, err := ParseParamFlowLimitMode(f, v); return int32(r), err },
+			value: "Remote", wantErr: true},
+		{name: "circuit breaker strategy", parse: func(f, v string) (int32, error) { r, err := ParseCircuitBreakerStrategy(f, v); return int32(r), err },
+			value: "ErrorRequestRatio", want: int32(pb.CircuitBreakerStrategy_STRATEGY_ERROR_REQUEST_RATIO)},
+		{name: "circuit breaker strategy", parse: func(f, v string) (int32, error) { r, err := ParseCircuitBreakerStrategy(f, v); return int32(r), err },
+			value: "ErrorRequestCount", want: int32(pb.CircuitBreakerStrategy_STRATEGY_ERROR_REQUEST_COUNT)},
+		{name: "circuit breaker strategy", parse: func(f, v string) (int32, error) { r, err := ParseCircuitBreakerStrategy(f, v); return int32(r), err },
+			value: "SlowRequestCount", wantErr: true},
+		{name: "gRPC code", parse: ParseGrpcCode, value: "unavailable", want: 14},
+		{name: "gRPC code", parse: ParseGrpcCode, value: "OK", want: 0},
+		{name: "gRPC code", parse: ParseGrpcCode, value: "14", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"/"+tt.value, func(t *testing.T) {
+			got, err := tt.parse("spec.field", tt.value)
+			if tt.wantErr {
+				assertConversionError(t, err, "spec.field")
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("got %d, %v, want %d", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnsupportedValueError(t *testing.T) {
+	_, err := ParseRateLimitMode("spec.limitMode", "Cluster")
+	want := `invalid value "Cluster" of spec.limitMode: supported values: "Local", "Global"`
+	if err == nil || err.Error() != want {
+		t.Errorf("error = %v, want %s", err, want)
+	}
+}
+
+func TestParseHttpStatusCodeRange(t *testing.T) {
+	tests := []struct {
+		value    string
+		min, max int32
+		wantErr  bool
+	}{
+		{value: "503", min: 503, max: 503},
+		{value: "500-599", min: 500, max: 599},
+		{value: " 500 - 504 ", min: 500, max: 504},
+		{value: "100-100", min: 100, max: 100},
+		{value: "", wantErr: true},
+		{value: "5xx", wantErr: true},
+		{value: "99", wantErr: true},
+		{value: "600", wantErr: true},
+		{value: "599-500", wantErr: true},
+		{value: "500-", wantErr: true},
+		{value: "500-504-599", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			min, max, err := ParseHttpStatusCodeRange("spec.httpStatusCodes[0]", tt.value)
+			if tt.wantErr {
+				assertConversionError(t, err, "spec.httpStatusCodes[0]")
+				return
+			}
+			if err != nil || min != tt.min || max != tt.max {
+				t.Errorf("got %d-%d, %v, want %d-%d", min, max, err, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestConversionErrorUnwrap(t *testing.T) {
+	_, err := ParseRatio("spec.triggerRatio", "x")
+	convertErr := err.(*ConversionError)
+	if convertErr.Unwrap() == nil {
+		t.Fatal("the error of strconv is not kept")
+	}
+	if !strings.HasSuffix(err.Error(), ": "+convertErr.Unwrap().Error()) {
+		t.Errorf("error = %q, want the underlying error appended", err.Error())
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package convert converts the fields of OpenSergo CRDs to the values of proto messages.
+// All conversions are strict: invalid values are reported as *ConversionError rather than
+// being replaced with sentinel values.
+package convert
+
+import (
+	"fmt"
+)
+
+// ConversionError describes a field of a CRD which cannot be converted.
+type ConversionError struct {
+	// Field is the path of the field, e.g. spec.statDuration.
+	Field string
+	// Value is the original value of the field.
+	Value interface{}
+	// Reason describes why the value is invalid.
+	Reason string
+	// Err is the underlying error, which may be nil.
+	Err error
+}
+
+func (e *ConversionError) Error() string {
+	msg := fmt.Sprintf("invalid value %#v of %s: %s", e.Value, e.Field, e.Reason)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+func newConversionError(field string, value interface{}, reason string, err error) *ConversionError {
+	return &ConversionError{
+		Field:  field,
+		Value:  value,
+		Reason: reason,
+		Err:    err,
+	}
+}
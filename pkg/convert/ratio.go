@@ -0,0 +1,69 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseRatio parses the ratio of the field in the form of percentage (e.g. 60%) or decimal (e.g. 0.6),
+// which must be a whole percentage between 0% and 100%, as the CRD schema only accepts whole percentages.
+func ParseRatio(field, value string) (float64, error) {
+	numStr := strings.TrimSuffix(value, "%")
+	ratio, err := strconv.ParseFloat(numStr, 64)
+	if err != nil || math.IsNaN(ratio) || math.IsInf(ratio, 0) {
+		return 0, newConversionError(field, value, "expected a percentage like 60% or a decimal like 0.6", err)
+	}
+	if numStr != value {
+		ratio /= 100
+	}
+	if ratio < 0 || ratio > 1 {
+		return 0, newConversionError(field, value, "must be between 0% and 100%", nil)
+	}
+	if percentage := roundPercentage(ratio); percentage != math.Trunc(percentage) {
+		return 0, newConversionError(field, value,
+			"must be a whole percentage like 55% or 0.55, the fractions of a percent are not supported", nil)
+	}
+	return ratio, nil
+}
+
+// NormalizeRatio converts the ratio of the field to the canonical form of percentage, e.g. 0.6 -> 60%.
+func NormalizeRatio(field, value string) (string, error) {
+	ratio, err := ParseRatio(field, value)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(int64(roundPercentage(ratio)), 10) + "%", nil
+}
+
+// roundPercentage converts the ratio to percentage, which is rounded to avoid the floating-point error,
+// e.g. 0.07 * 100 = 7.000000000000001.
+func roundPercentage(ratio float64) float64 {
+	return math.Round(ratio*100*1e6) / 1e6
+}
+
+// ParseDecimal parses the non-negative decimal of the field, e.g. 2.5.
+func ParseDecimal(field, value string) (float64, error) {
+	decimal, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(decimal) || math.IsInf(decimal, 0) {
+		return 0, newConversionError(field, value, "expected a decimal like 2.5", err)
+	}
+	if decimal < 0 {
+		return 0, newConversionError(field, value, "must be non-negative", nil)
+	}
+	return decimal, nil
+}
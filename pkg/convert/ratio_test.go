@@ -0,0 +1,103 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"testing"
+)
+
+func TestParseRatio(t *testing.T) {
+	tests := []struct {
+		value      string
+		want       float64
+		normalized string
+		wantErr    bool
+	}{
+		{value: "0", want: 0, normalized: "0%"},
+		{value: "0%", want: 0, normalized: "0%"},
+		{value: "60%", want: 0.6, normalized: "60%"},
+		{value: "0.6", want: 0.6, normalized: "60%"},
+		{value: "0.07", want: 0.07, normalized: "7%"},
+		{value: "7%", want: 0.07, normalized: "7%"},
+		{value: "55.0%", want: 0.55, normalized: "55%"},
+		{value: "0.550", want: 0.55, normalized: "55%"},
+		{value: "1", want: 1, normalized: "100%"},
+		{value: "100%", want: 1, normalized: "100%"},
+		{value: "", wantErr: true},
+		{value: "%", wantErr: true},
+		{value: "abc", wantErr: true},
+		{value: "60%%", wantErr: true},
+		{value: "101%", wantErr: true},
+		{value: "1.01", wantErr: true},
+		{value: "12.5%", wantErr: true},
+		{value: "0.555", wantErr: true},
+		{value: "0.001", wantErr: true},
+		{value: "-1%", wantErr: true},
+		{value: "-0.1", wantErr: true},
+		{value: "NaN", wantErr: true},
+		{value: "NaN%", wantErr: true},
+		{value: "Inf", wantErr: true},
+		{value: "-Inf%", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseRatio("spec.triggerRatio", tt.value)
+			normalized, normalizeErr := NormalizeRatio("spec.triggerRatio", tt.value)
+			if tt.wantErr {
+				assertConversionError(t, err, "spec.triggerRatio")
+				assertConversionError(t, normalizeErr, "spec.triggerRatio")
+				return
+			}
+			if err != nil || normalizeErr != nil {
+				t.Fatal(err, normalizeErr)
+			}
+			if diff := got - tt.want; diff > 1e-12 || diff < -1e-12 {
+				t.Errorf("ParseRatio() = %v, want %v", got, tt.want)
+			}
+			if normalized != tt.normalized {
+				t.Errorf("NormalizeRatio() = %q, want %q", normalized, tt.normalized)
+			}
+		})
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    float64
+		wantErr bool
+	}{
+		{value: "0", want: 0},
+		{value: "2.5", want: 2.5},
+		{value: "16", want: 16},
+		{value: "", wantErr: true},
+		{value: "2.5x", wantErr: true},
+		{value: "-0.5", wantErr: true},
+		{value: "NaN", wantErr: true},
+		{value: "+Inf", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseDecimal("spec.maxSystemLoad", tt.value)
+			if tt.wantErr {
+				assertConversionError(t, err, "spec.maxSystemLoad")
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("ParseDecimal() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseHttpStatusCodeRange parses an HTTP status code (e.g. 503) or an inclusive range of status codes
+// (e.g. 500-599), and returns the lower and upper bounds of the range.
+func ParseHttpStatusCodeRange(field, value string) (int32, int32, error) {
+	minStr, maxStr := value, value
+	if i := strings.Index(value, "-"); i >= 0 {
+		minStr, maxStr = value[:i], value[i+1:]
+	}
+	min, err := parseHttpStatusCode(minStr)
+	if err != nil {
+		return 0, 0, newConversionError(field, value, "expected a status code like 503 or a range like 500-599", err)
+	}
+	max, err := parseHttpStatusCode(maxStr)
+	if err != nil {
+		return 0, 0, newConversionError(field, value, "expected a status code like 503 or a range like 500-599", err)
+	}
+	if min > max {
+		return 0, 0, newConversionError(field, value, "the lower bound must not be larger than the upper bound", nil)
+	}
+	return min, max, nil
+}
+
+func parseHttpStatusCode(value string) (int32, error) {
+	code, err := strconv.ParseInt(strings.TrimSpace(value), 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	if code < 100 || code > 599 {
+		return 0, fmt.Errorf("status code %d out of range [100, 599]", code)
+	}
+	return int32(code), nil
+}
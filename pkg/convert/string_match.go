@@ -0,0 +1,134 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"regexp"
+	"strings"
+
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+)
+
+// StringMatch is implemented by the StringMatch of TrafficRouter and the StringMatch of the fault-tolerance rules,
+// which share the semantics: exactly one of exact, prefix and regex is set, the match is case-sensitive,
+// and the regex must match the whole string, as the safe_regex matchers of Envoy which TrafficRouter is translated to.
+type StringMatch interface {
+	GetExact() string
+	GetPrefix() string
+	GetRegex() string
+}
+
+// ValidateStringMatch checks that exactly one of exact, prefix and regex is set,
+// and compiles the regex in advance so that the data plane never receives a malformed RE2 expression.
+func ValidateStringMatch(field string, match StringMatch) error {
+	exact, prefix, regex := match.GetExact(), match.GetPrefix(), match.GetRegex()
+	set := 0
+	for _, value := range []string{exact, prefix, regex} {
+		if value != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return newConversionError(field, map[string]string{"exact": exact, "prefix": prefix, "regex": regex},
+			"exactly one of exact, prefix and regex must be set", nil)
+	}
+	_, err := CompileRegex(field+".regex", regex)
+	return err
+}
+
+// ParseStringMatch validates a StringMatch and builds the StringMatch of the fault-tolerance rules from it.
+func ParseStringMatch(field string, match StringMatch) (*pb.StringMatch, error) {
+	if err := ValidateStringMatch(field, match); err != nil {
+		return nil, err
+	}
+	return &pb.StringMatch{
+		Exact:  match.GetExact(),
+		Prefix: match.GetPrefix(),
+		Regex:  match.GetRegex(),
+	}, nil
+}
+
+// MatchString reports whether the value matches a valid StringMatch. It is the reference of the semantics
+// for the data planes, and the regex is anchored at both ends, as CompileResourcePattern does.
+func MatchString(match StringMatch, value string) bool {
+	switch {
+	case match.GetExact() != "":
+		return value == match.GetExact()
+	case match.GetPrefix() != "":
+		return strings.HasPrefix(value, match.GetPrefix())
+	case match.GetRegex() != "":
+		re, err := regexp.Compile(anchorRegex(match.GetRegex()))
+		return err == nil && re.MatchString(value)
+	default:
+		return false
+	}
+}
+
+// CompileRegex compiles an RE2 style regular expression.
+func CompileRegex(field, value string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile(value)
+	if err != nil {
+		// The syntax error is kept in the reason, which is all that the field errors of validation report.
+		return nil, newConversionError(field, value, "expected an RE2 regular expression: "+err.Error(), nil)
+	}
+	return re, nil
+}
+
+// CompileResourcePattern compiles the name or the pattern of a target resource of the given match type
+// to a regular expression which matches the whole names of the resources.
+func CompileResourcePattern(field string, matchType pb.FaultToleranceRule_MatchType, value string) (*regexp.Regexp, error) {
+	switch matchType {
+	case pb.FaultToleranceRule_MATCH_GLOB:
+		return regexp.MustCompile("^" + globToRegex(value) + "$"), nil
+	case pb.FaultToleranceRule_MATCH_REGEX:
+		if _, err := CompileRegex(field, value); err != nil {
+			return nil, err
+		}
+		return regexp.MustCompile(anchorRegex(value)), nil
+	default:
+		return regexp.MustCompile("^" + regexp.QuoteMeta(value) + "$"), nil
+	}
+}
+
+// anchorRegex makes a regular expression match the whole string, keeping the alternations within the anchors.
+func anchorRegex(regex string) string {
+	return "^(?:" + regex + ")$"
+}
+
+// globToRegex translates a glob pattern, where * matches any sequence of characters except /,
+// ** matches any sequence of characters and ? matches any single character except /.
+func globToRegex(glob string) string {
+	var b strings.Builder
+	for i := 0; i < len(glob); i++ {
+		switch {
+		case strings.HasPrefix(glob[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case glob[i] == '*':
+			b.WriteString("[^/]*")
+		case glob[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			// The literal characters up to the next wildcard are quoted as a whole, which keeps multi-byte characters intact.
+			j := i + 1
+			for j < len(glob) && glob[j] != '*' && glob[j] != '?' {
+				j++
+			}
+			b.WriteString(regexp.QuoteMeta(glob[i:j]))
+			i = j - 1
+		}
+	}
+	return b.String()
+}
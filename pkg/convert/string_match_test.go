@@ -0,0 +1,211 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"testing"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1/traffic"
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// stringMatches returns the same StringMatch as each of the types sharing the semantics.
+func stringMatches(exact, prefix, regex string) map[string]StringMatch {
+	return map[string]StringMatch{
+		"fault tolerance CRD":   &crdv1alpha1.StringMatch{Exact: exact, Prefix: prefix, Regex: regex},
+		"fault tolerance proto": &pb.StringMatch{Exact: exact, Prefix: prefix, Regex: regex},
+		"TrafficRouter":         &traffic.StringMatch{Exact: exact, Prefix: prefix, Regex: regex},
+	}
+}
+
+func TestParseStringMatch(t *testing.T) {
+	tests := []struct {
+		name                 string
+		exact, prefix, regex string
+		wantErrField         string
+	}{
+		{name: "exact", exact: "vip"},
+		{name: "prefix", prefix: "prod-"},
+		{name: "regex", regex: "GET|HEAD"},
+		{name: "none", wantErrField: "spec.app"},
+		{name: "exact and prefix", exact: "vip", prefix: "v", wantErrField: "spec.app"},
+		{name: "prefix and regex", prefix: "v", regex: "v.*", wantErrField: "spec.app"},
+		{name: "malformed regex", regex: "(GET", wantErrField: "spec.app.regex"},
+	}
+	for _, tt := range tests {
+		for typ, match := range stringMatches(tt.exact, tt.prefix, tt.regex) {
+			t.Run(tt.name+"/"+typ, func(t *testing.T) {
+				got, err := ParseStringMatch("spec.app", match)
+				if validateErr := ValidateStringMatch("spec.app", match); (validateErr == nil) != (err == nil) {
+					t.Errorf("ValidateStringMatch() = %v, ParseStringMatch() = %v", validateErr, err)
+				}
+				if tt.wantErrField != "" {
+					assertConversionError(t, err, tt.wantErrField)
+					return
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := (&pb.StringMatch{Exact: tt.exact, Prefix: tt.prefix, Regex: tt.regex}); !proto.Equal(got, want) {
+					t.Errorf("ParseStringMatch() = %v, want %v", got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestMatchString(t *testing.T) {
+	tests := []struct {
+		name                 string
+		exact, prefix, regex string
+		value                string
+		want                 bool
+	}{
+		{name: "exact", exact: "vip", value: "vip", want: true},
+		{name: "exact is case-sensitive", exact: "vip", value: "VIP"},
+		{name: "exact is not a prefix", exact: "vip", value: "vip-user"},
+		{name: "prefix", prefix: "prod-", value: "prod-east", want: true},
+		{name: "prefix of itself", prefix: "prod-", value: "prod-", want: true},
+		{name: "prefix mismatch", prefix: "prod-", value: "staging-prod-"},
+		{name: "regex", regex: "GET|HEAD", value: "HEAD", want: true},
+		{name: "regex matches the whole string", regex: "GET", value: "GETX"},
+		{name: "regex is anchored at the start", regex: "GET", value: "XGET"},
+		{name: "regex alternation is anchored", regex: "GET|HEAD", value: "GETHEAD"},
+		{name: "regex with explicit anchors", regex: "^v[0-9]+$", value: "v12", want: true},
+		{name: "empty value", regex: ".*", value: "", want: true},
+		{name: "no matcher", value: "vip"},
+	}
+	for _, tt := range tests {
+		for typ, match := range stringMatches(tt.exact, tt.prefix, tt.regex) {
+			t.Run(tt.name+"/"+typ, func(t *testing.T) {
+				if got := MatchString(match, tt.value); got != tt.want {
+					t.Errorf("MatchString(%q) = %v, want %v", tt.value, got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestCompileResourcePattern(t *testing.T) {
+	tests := []struct {
+		name      string
+		matchType pb.FaultToleranceRule_MatchType
+		pattern   string
+		matches   []string
+		others    []string
+	}{
+		{
+			name:      "exact quotes the metacharacters",
+			matchType: pb.FaultToleranceRule_MATCH_EXACT,
+			pattern:   "GET:/a.b/*",
+			matches:   []string{"GET:/a.b/*"},
+			others:    []string{"GET:/aXb/*", "GET:/a.b/c", "GET:/a.b/*/"},
+		},
+		{
+			name:      "glob star within a segment",
+			matchType: pb.FaultToleranceRule_MATCH_GLOB,
+			pattern:   "GET:/api/v1/orders/*",
+			matches:   []string{"GET:/api/v1/orders/1", "GET:/api/v1/orders/"},
+			others:    []string{"GET:/api/v1/orders/1/items", "GET:/api/v1/orders", "POST:/api/v1/orders/1"},
+		},
+		{
+			name:      "glob double star across segments",
+			matchType: pb.FaultToleranceRule_MATCH_GLOB,
+			pattern:   "GET:/api/**",
+			matches:   []string{"GET:/api/", "GET:/api/v1/orders/1"},
+			others:    []string{"GET:/api", "GET:/apis/v1"},
+		},
+		{
+			name:      "glob triple star",
+			matchType: pb.FaultToleranceRule_MATCH_GLOB,
+			pattern:   "/a/***",
+			matches:   []string{"/a/", "/a/b/c"},
+			others:    []string{"/b/c"},
+		},
+		{
+			name:      "glob question mark",
+			matchType: pb.FaultToleranceRule_MATCH_GLOB,
+			pattern:   "GET:/api/v?/x",
+			matches:   []string{"GET:/api/v1/x", "GET:/api/vv/x"},
+			others:    []string{"GET:/api/v/x", "GET:/api/v12/x", "GET:/api/v//x"},
+		},
+		{
+			name:      "glob quotes the regex metacharacters",
+			matchType: pb.FaultToleranceRule_MATCH_GLOB,
+			pattern:   "POST:/a.b+(c)|[d]{2}$^",
+			matches:   []string{"POST:/a.b+(c)|[d]{2}$^"},
+			others:    []string{"POST:/aXbb(c)", "POST:/a.b+c", "POST:/d", "POST:/a.b+(c)|dd"},
+		},
+		{
+			name:      "glob keeps a backslash literal",
+			matchType: pb.FaultToleranceRule_MATCH_GLOB,
+			pattern:   `/a\*`,
+			matches:   []string{`/a\`, `/a\xyz`},
+			others:    []string{"/a*", "/axyz"},
+		},
+		{
+			name:      "glob with multi-byte characters",
+			matchType: pb.FaultToleranceRule_MATCH_GLOB,
+			pattern:   "GET:/订单/?/详情",
+			matches:   []string{"GET:/订单/一/详情", "GET:/订单/1/详情"},
+			others:    []string{"GET:/订单/12/详情", "GET:/订单//详情"},
+		},
+		{
+			name:      "regex matches the whole name",
+			matchType: pb.FaultToleranceRule_MATCH_REGEX,
+			pattern:   "GET:/api/v[0-9]+/.*",
+			matches:   []string{"GET:/api/v1/orders", "GET:/api/v12/"},
+			others:    []string{"XGET:/api/v1/orders", "GET:/api/v/orders"},
+		},
+		{
+			name:      "regex alternation is anchored",
+			matchType: pb.FaultToleranceRule_MATCH_REGEX,
+			pattern:   "GET:/a|POST:/b",
+			matches:   []string{"GET:/a", "POST:/b"},
+			others:    []string{"GET:/ab", "GET:/aPOST:/b", "XPOST:/b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			re, err := CompileResourcePattern("spec.targets[0].targetResourceName", tt.matchType, tt.pattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, name := range tt.matches {
+				if !re.MatchString(name) {
+					t.Errorf("%s does not match %q", re, name)
+				}
+			}
+			for _, name := range tt.others {
+				if re.MatchString(name) {
+					t.Errorf("%s matches %q", re, name)
+				}
+			}
+		})
+	}
+}
+
+func TestCompileResourcePatternError(t *testing.T) {
+	_, err := CompileResourcePattern("spec.targets[0].targetResourceName", pb.FaultToleranceRule_MATCH_REGEX, "GET:/(a")
+	assertConversionError(t, err, "spec.targets[0].targetResourceName")
+	// The glob patterns have no syntax errors, as all the characters other than the wildcards are literal.
+	for _, glob := range []string{"GET:/(a", "[", `\`} {
+		if _, err := CompileResourcePattern("spec.targets[0].targetResourceName", pb.FaultToleranceRule_MATCH_GLOB, glob); err != nil {
+			t.Errorf("CompileResourcePattern(%q) = %v", glob, err)
+		}
+	}
+}
@@ -15,18 +15,211 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
 	"log"
+	"strings"
 
 	"github.com/opensergo/opensergo-control-plane"
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	"github.com/opensergo/opensergo-control-plane/pkg/source"
+	"github.com/opensergo/opensergo-control-plane/pkg/source/etcd"
+	"github.com/opensergo/opensergo-control-plane/pkg/source/file"
+	"github.com/opensergo/opensergo-control-plane/pkg/source/git"
+	"github.com/opensergo/opensergo-control-plane/pkg/source/rest"
+	"github.com/opensergo/opensergo-control-plane/pkg/webhook"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	configSourceKubernetes = "kubernetes"
+	configSourceFile       = "file"
+	configSourceEtcd       = "etcd"
+	configSourceREST       = "rest"
+	configSourceGit        = "git"
+)
+
+// clusterFlags collects the extra clusters in the form of `name=kubeconfigPath[,mergePolicy]`.
+type clusterFlags []controller.ClusterConfig
+
+func (f *clusterFlags) String() string {
+	names := make([]string, 0, len(*f))
+	for _, c := range *f {
+		names = append(names, c.Name)
+	}
+	return strings.Join(names, ",")
+}
+
+func (f *clusterFlags) Set(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("invalid cluster %q, expected name=kubeconfigPath[,mergePolicy]", value)
+	}
+	conf := controller.ClusterConfig{Name: parts[0]}
+	pathAndPolicy := strings.SplitN(parts[1], ",", 2)
+	conf.KubeConfigPath = pathAndPolicy[0]
+	if len(pathAndPolicy) == 2 {
+		conf.MergePolicy = controller.MergePolicy(pathAndPolicy[1])
+	}
+	*f = append(*f, conf)
+	return nil
+}
+
 func main() {
-	cp, err := opensergo.NewControlPlane()
+	var clusters clusterFlags
+	flag.Var(&clusters, "cluster", "an extra cluster to source rules from, in the form of name=kubeconfigPath[,mergePolicy], can be repeated")
+	clusterSecretNamespace := flag.String("cluster-secret-namespace", "", "the namespace of the cluster secrets of the extra clusters")
+	leaderElection := flag.Bool("leader-elect", false, "enable leader election for running multiple replicas of the control plane")
+	leaderElectionNamespace := flag.String("leader-election-namespace", "", "the namespace of the leader election resource and the delivery reports of replicas")
+	leaderElectionID := flag.String("leader-election-id", controller.DefaultLeaderElectionID, "the name of the leader election resource")
+	strategyDeletionPolicy := flag.String("strategy-deletion-policy", string(controller.StrategyDeletionPolicyWarn),
+		"what happens when a strategy referenced by FaultToleranceRules is deleted, Warn or Block")
+	webhookPort := flag.Int("webhook-port", 0, "the port of the admission webhook server, 0 disables the webhook server")
+	webhookCertDir := flag.String("webhook-cert-dir", "", "the directory that contains tls.crt and tls.key of the admission webhook server")
+	configMapNamespaces := flag.String("configmap-namespaces", "", "the comma-separated namespaces where the labeled ConfigMaps of OpenSergo rules are watched")
+	configMapSelector := flag.String("configmap-selector", controller.DefaultConfigMapSelector, "the label selector of the ConfigMaps of OpenSergo rules")
+	disableCRDs := flag.Bool("disable-crds", false, "disable watching OpenSergo CRDs, and source rules from ConfigMaps only")
+	configSource := flag.String("config-source", configSourceKubernetes, "the source of OpenSergo rules, kubernetes, file, etcd, rest or git")
+	configDir := flag.String("config-dir", "", "the directory of the YAML manifests of OpenSergo rules, required by the file source")
+	etcdEndpoints := flag.String("etcd-endpoints", "", "the comma-separated client URLs of etcd, required by the etcd source")
+	etcdPrefix := flag.String("etcd-prefix", etcd.DefaultPrefix, "the prefix of the keys of OpenSergo objects in etcd")
+	restPort := flag.Int("rest-port", rest.DefaultPort, "the port of the HTTP API of the rest source")
+	restDBPath := flag.String("rest-db", "opensergo.db", "the path of the database file of the rest source")
+	restTokenFile := flag.String("rest-token-file", "", "the file of the bearer tokens of the HTTP API, one token per line, required by the rest source")
+	restCertDir := flag.String("rest-cert-dir", "", "the directory that contains tls.crt and tls.key of the HTTP API, required by the rest source unless -rest-insecure is set")
+	restInsecure := flag.Bool("rest-insecure", false, "serve the HTTP API of the rest source over plain HTTP if -rest-cert-dir is empty, where the tokens are sent in clear text")
+	gitRepo := flag.String("git-repo", "", "the path of the local git repository of OpenSergo rules, required by the git source")
+	gitBranch := flag.String("git-branch", "", "the branch of the git repository, defaults to the HEAD of the repository")
+	gitDir := flag.String("git-dir", "", "the subdirectory of the manifests in the git repository, defaults to the root")
+	gitPollInterval := flag.Duration("git-poll-interval", git.DefaultPollInterval, "the interval to check for new commits of the git repository")
+	flag.Parse()
+
+	var configSrc source.ConfigSource
+	switch *configSource {
+	case configSourceKubernetes:
+		options := controller.KubernetesOperatorOptions{
+			LeaderElection:          *leaderElection,
+			LeaderElectionNamespace: *leaderElectionNamespace,
+			LeaderElectionID:        *leaderElectionID,
+			StrategyDeletionPolicy:  controller.StrategyDeletionPolicy(*strategyDeletionPolicy),
+			ConfigMapSelector:       *configMapSelector,
+			DisableCRDs:             *disableCRDs,
+		}
+		for _, namespace := range strings.Split(*configMapNamespaces, ",") {
+			if namespace = strings.TrimSpace(namespace); namespace != "" {
+				options.ConfigMapNamespaces = append(options.ConfigMapNamespaces, namespace)
+			}
+		}
+		if len(clusters) > 0 || *clusterSecretNamespace != "" {
+			options.Clusters = append(options.Clusters, controller.ClusterConfig{Name: controller.DefaultClusterName, Primary: true})
+			options.Clusters = append(options.Clusters, clusters...)
+		}
+		if *clusterSecretNamespace != "" {
+			secretClusters, err := loadClusterSecrets(*clusterSecretNamespace)
+			if err != nil {
+				log.Fatal(err)
+			}
+			options.Clusters = append(options.Clusters, secretClusters...)
+		}
+		operator, err := controller.NewKubernetesOperatorWithOptions(options)
+		if err != nil {
+			log.Fatal(err)
+		}
+		configSrc = operator
+	case configSourceFile:
+		fileSource, err := file.NewSource(*configDir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		configSrc = fileSource
+	case configSourceEtcd:
+		etcdSource, err := etcd.NewSource(etcd.Options{
+			Endpoints: strings.Split(*etcdEndpoints, ","),
+			Prefix:    *etcdPrefix,
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		configSrc = etcdSource
+	case configSourceREST:
+		tokens, err := loadTokens(*restTokenFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		restSource, err := rest.NewSource(rest.Options{
+			Port:     *restPort,
+			DBPath:   *restDBPath,
+			Tokens:   tokens,
+			CertDir:  *restCertDir,
+			Insecure: *restInsecure,
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		configSrc = restSource
+	case configSourceGit:
+		gitSource, err := git.NewSource(git.Options{
+			RepoPath:     *gitRepo,
+			Branch:       *gitBranch,
+			Dir:          *gitDir,
+			PollInterval: *gitPollInterval,
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		configSrc = gitSource
+	default:
+		log.Fatalf("unknown config source: %s", *configSource)
+	}
+
+	cp, err := opensergo.NewControlPlaneWithSource(configSrc)
 	if err != nil {
 		log.Fatal(err)
 	}
+	if *webhookPort > 0 {
+		webhookServer, err := webhook.NewServer(webhook.ServerOptions{Port: *webhookPort, CertDir: *webhookCertDir})
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err = webhookServer.Run(); err != nil {
+			log.Fatal(err)
+		}
+	}
 	err = cp.Start()
 	if err != nil {
 		log.Fatal(err)
 	}
 }
+
+func loadClusterSecrets(namespace string) ([]controller.ClusterConfig, error) {
+	k8sConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	reader, err := client.New(k8sConfig, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+	return controller.ClusterConfigsFromSecrets(context.Background(), reader, namespace)
+}
+
+// loadTokens reads the bearer tokens from the file, one token per line.
+func loadTokens(path string) ([]string, error) {
+	if path == "" {
+		return nil, fmt.Errorf("the token file is required")
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var tokens []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if token := strings.TrimSpace(line); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens, nil
+}
@@ -31,3 +31,23 @@ type OpenSergoTransportStream = trpb.OpenSergoUniversalTransportService_Subscrib
 type SubscribeRequestHandler func(ClientIdentifier, *trpb.SubscribeRequest, OpenSergoTransportStream) error
 
 type DataEntirePushHandler func(namespace, app, kind string, dataWithVersion *trpb.DataWithVersion, status *trpb.Status, respId string) error
+
+// DeliveryStatus represents the delivery status of the rules of a (namespace, app, kind) to the connected instances.
+type DeliveryStatus struct {
+	// Version is the latest version of the rules pushed to the connected instances.
+	Version int64
+	// ConnectedInstances is the number of connected instances which the rules have been pushed to.
+	ConnectedInstances int32
+	// AckedInstances is the number of connected instances which have ACKed the latest pushed rules.
+	AckedInstances int32
+	// NackedInstances is the number of connected instances which have NACKed the latest pushed rules.
+	NackedInstances int32
+	// LastNackMessage is the message of the last NACK of the latest pushed rules.
+	LastNackMessage string
+}
+
+// DeliveryStatusProvider provides the delivery status of the rules of the given (namespace, app, kind).
+type DeliveryStatusProvider func(namespace, app, kind string) DeliveryStatus
+
+// DeliveryChangedHandler is called when the delivery status of the rules of the given (namespace, app, kind) changes.
+type DeliveryChangedHandler func(namespace, app, kind string)
@@ -16,7 +16,7 @@
 // versions:
 // 	protoc-gen-go v1.28.0
 // 	protoc        v3.19.4
-// source: fault_tolerance/v1/fault_tolerance.proto
+// source: fault_tolerance.proto
 
 package v1
 
@@ -36,6 +36,60 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// MatchType is how the target_resource_name of a target matches the names of the resources.
+// MATCH_EXACT is the default, so that the clients unaware of it keep the same behavior.
+type FaultToleranceRule_MatchType int32
+
+const (
+	FaultToleranceRule_MATCH_EXACT FaultToleranceRule_MatchType = 0
+	// Glob pattern, where * matches any sequence of characters except /, ** matches any sequence
+	// of characters and ? matches any single character except /.
+	FaultToleranceRule_MATCH_GLOB FaultToleranceRule_MatchType = 1
+	// RE2 style regex (https://github.com/google/re2/wiki/Syntax), which must match the whole name.
+	FaultToleranceRule_MATCH_REGEX FaultToleranceRule_MatchType = 2
+)
+
+// Enum value maps for FaultToleranceRule_MatchType.
+var (
+	FaultToleranceRule_MatchType_name = map[int32]string{
+		0: "MATCH_EXACT",
+		1: "MATCH_GLOB",
+		2: "MATCH_REGEX",
+	}
+	FaultToleranceRule_MatchType_value = map[string]int32{
+		"MATCH_EXACT": 0,
+		"MATCH_GLOB":  1,
+		"MATCH_REGEX": 2,
+	}
+)
+
+func (x FaultToleranceRule_MatchType) Enum() *FaultToleranceRule_MatchType {
+	p := new(FaultToleranceRule_MatchType)
+	*p = x
+	return p
+}
+
+func (x FaultToleranceRule_MatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FaultToleranceRule_MatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_fault_tolerance_proto_enumTypes[0].Descriptor()
+}
+
+func (FaultToleranceRule_MatchType) Type() protoreflect.EnumType {
+	return &file_fault_tolerance_proto_enumTypes[0]
+}
+
+func (x FaultToleranceRule_MatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FaultToleranceRule_MatchType.Descriptor instead.
+func (FaultToleranceRule_MatchType) EnumDescriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{1, 0}
+}
+
 type RateLimitStrategy_MetricType int32
 
 const (
@@ -66,11 +120,11 @@ func (x RateLimitStrategy_MetricType) String() string {
 }
 
 func (RateLimitStrategy_MetricType) Descriptor() protoreflect.EnumDescriptor {
-	return file_fault_tolerance_v1_fault_tolerance_proto_enumTypes[0].Descriptor()
+	return file_fault_tolerance_proto_enumTypes[1].Descriptor()
 }
 
 func (RateLimitStrategy_MetricType) Type() protoreflect.EnumType {
-	return &file_fault_tolerance_v1_fault_tolerance_proto_enumTypes[0]
+	return &file_fault_tolerance_proto_enumTypes[1]
 }
 
 func (x RateLimitStrategy_MetricType) Number() protoreflect.EnumNumber {
@@ -79,7 +133,7 @@ func (x RateLimitStrategy_MetricType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RateLimitStrategy_MetricType.Descriptor instead.
 func (RateLimitStrategy_MetricType) EnumDescriptor() ([]byte, []int) {
-	return file_fault_tolerance_v1_fault_tolerance_proto_rawDescGZIP(), []int{1, 0}
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{2, 0}
 }
 
 type RateLimitStrategy_LimitMode int32
@@ -115,11 +169,11 @@ func (x RateLimitStrategy_LimitMode) String() string {
 }
 
 func (RateLimitStrategy_LimitMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_fault_tolerance_v1_fault_tolerance_proto_enumTypes[1].Descriptor()
+	return file_fault_tolerance_proto_enumTypes[2].Descriptor()
 }
 
 func (RateLimitStrategy_LimitMode) Type() protoreflect.EnumType {
-	return &file_fault_tolerance_v1_fault_tolerance_proto_enumTypes[1]
+	return &file_fault_tolerance_proto_enumTypes[2]
 }
 
 func (x RateLimitStrategy_LimitMode) Number() protoreflect.EnumNumber {
@@ -128,7 +182,162 @@ func (x RateLimitStrategy_LimitMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RateLimitStrategy_LimitMode.Descriptor instead.
 func (RateLimitStrategy_LimitMode) EnumDescriptor() ([]byte, []int) {
-	return file_fault_tolerance_v1_fault_tolerance_proto_rawDescGZIP(), []int{1, 1}
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{2, 1}
+}
+
+// ControlBehavior is how the requests exceeding the threshold are handled.
+// BEHAVIOR_REJECT is the default, so that the clients unaware of it keep the same behavior.
+type RateLimitStrategy_ControlBehavior int32
+
+const (
+	RateLimitStrategy_BEHAVIOR_REJECT           RateLimitStrategy_ControlBehavior = 0
+	RateLimitStrategy_BEHAVIOR_WARM_UP          RateLimitStrategy_ControlBehavior = 1
+	RateLimitStrategy_BEHAVIOR_QUEUEING         RateLimitStrategy_ControlBehavior = 2
+	RateLimitStrategy_BEHAVIOR_WARM_UP_QUEUEING RateLimitStrategy_ControlBehavior = 3
+)
+
+// Enum value maps for RateLimitStrategy_ControlBehavior.
+var (
+	RateLimitStrategy_ControlBehavior_name = map[int32]string{
+		0: "BEHAVIOR_REJECT",
+		1: "BEHAVIOR_WARM_UP",
+		2: "BEHAVIOR_QUEUEING",
+		3: "BEHAVIOR_WARM_UP_QUEUEING",
+	}
+	RateLimitStrategy_ControlBehavior_value = map[string]int32{
+		"BEHAVIOR_REJECT":           0,
+		"BEHAVIOR_WARM_UP":          1,
+		"BEHAVIOR_QUEUEING":         2,
+		"BEHAVIOR_WARM_UP_QUEUEING": 3,
+	}
+)
+
+func (x RateLimitStrategy_ControlBehavior) Enum() *RateLimitStrategy_ControlBehavior {
+	p := new(RateLimitStrategy_ControlBehavior)
+	*p = x
+	return p
+}
+
+func (x RateLimitStrategy_ControlBehavior) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RateLimitStrategy_ControlBehavior) Descriptor() protoreflect.EnumDescriptor {
+	return file_fault_tolerance_proto_enumTypes[3].Descriptor()
+}
+
+func (RateLimitStrategy_ControlBehavior) Type() protoreflect.EnumType {
+	return &file_fault_tolerance_proto_enumTypes[3]
+}
+
+func (x RateLimitStrategy_ControlBehavior) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RateLimitStrategy_ControlBehavior.Descriptor instead.
+func (RateLimitStrategy_ControlBehavior) EnumDescriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{2, 2}
+}
+
+type ParamFlowStrategy_ParamSource int32
+
+const (
+	ParamFlowStrategy_SOURCE_UNKNOWN     ParamFlowStrategy_ParamSource = 0
+	ParamFlowStrategy_SOURCE_HEADER      ParamFlowStrategy_ParamSource = 1
+	ParamFlowStrategy_SOURCE_QUERY_PARAM ParamFlowStrategy_ParamSource = 2
+	ParamFlowStrategy_SOURCE_ARG_INDEX   ParamFlowStrategy_ParamSource = 3
+)
+
+// Enum value maps for ParamFlowStrategy_ParamSource.
+var (
+	ParamFlowStrategy_ParamSource_name = map[int32]string{
+		0: "SOURCE_UNKNOWN",
+		1: "SOURCE_HEADER",
+		2: "SOURCE_QUERY_PARAM",
+		3: "SOURCE_ARG_INDEX",
+	}
+	ParamFlowStrategy_ParamSource_value = map[string]int32{
+		"SOURCE_UNKNOWN":     0,
+		"SOURCE_HEADER":      1,
+		"SOURCE_QUERY_PARAM": 2,
+		"SOURCE_ARG_INDEX":   3,
+	}
+)
+
+func (x ParamFlowStrategy_ParamSource) Enum() *ParamFlowStrategy_ParamSource {
+	p := new(ParamFlowStrategy_ParamSource)
+	*p = x
+	return p
+}
+
+func (x ParamFlowStrategy_ParamSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParamFlowStrategy_ParamSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_fault_tolerance_proto_enumTypes[4].Descriptor()
+}
+
+func (ParamFlowStrategy_ParamSource) Type() protoreflect.EnumType {
+	return &file_fault_tolerance_proto_enumTypes[4]
+}
+
+func (x ParamFlowStrategy_ParamSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParamFlowStrategy_ParamSource.Descriptor instead.
+func (ParamFlowStrategy_ParamSource) EnumDescriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type ParamFlowStrategy_LimitMode int32
+
+const (
+	ParamFlowStrategy_MODE_UNKNOWN ParamFlowStrategy_LimitMode = 0
+	ParamFlowStrategy_MODE_LOCAL   ParamFlowStrategy_LimitMode = 1
+	ParamFlowStrategy_MODE_GLOBAL  ParamFlowStrategy_LimitMode = 2
+)
+
+// Enum value maps for ParamFlowStrategy_LimitMode.
+var (
+	ParamFlowStrategy_LimitMode_name = map[int32]string{
+		0: "MODE_UNKNOWN",
+		1: "MODE_LOCAL",
+		2: "MODE_GLOBAL",
+	}
+	ParamFlowStrategy_LimitMode_value = map[string]int32{
+		"MODE_UNKNOWN": 0,
+		"MODE_LOCAL":   1,
+		"MODE_GLOBAL":  2,
+	}
+)
+
+func (x ParamFlowStrategy_LimitMode) Enum() *ParamFlowStrategy_LimitMode {
+	p := new(ParamFlowStrategy_LimitMode)
+	*p = x
+	return p
+}
+
+func (x ParamFlowStrategy_LimitMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParamFlowStrategy_LimitMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_fault_tolerance_proto_enumTypes[5].Descriptor()
+}
+
+func (ParamFlowStrategy_LimitMode) Type() protoreflect.EnumType {
+	return &file_fault_tolerance_proto_enumTypes[5]
+}
+
+func (x ParamFlowStrategy_LimitMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParamFlowStrategy_LimitMode.Descriptor instead.
+func (ParamFlowStrategy_LimitMode) EnumDescriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{3, 1}
 }
 
 type ConcurrencyLimitStrategy_LimitMode int32
@@ -164,11 +373,11 @@ func (x ConcurrencyLimitStrategy_LimitMode) String() string {
 }
 
 func (ConcurrencyLimitStrategy_LimitMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_fault_tolerance_v1_fault_tolerance_proto_enumTypes[2].Descriptor()
+	return file_fault_tolerance_proto_enumTypes[6].Descriptor()
 }
 
 func (ConcurrencyLimitStrategy_LimitMode) Type() protoreflect.EnumType {
-	return &file_fault_tolerance_v1_fault_tolerance_proto_enumTypes[2]
+	return &file_fault_tolerance_proto_enumTypes[6]
 }
 
 func (x ConcurrencyLimitStrategy_LimitMode) Number() protoreflect.EnumNumber {
@@ -177,7 +386,56 @@ func (x ConcurrencyLimitStrategy_LimitMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ConcurrencyLimitStrategy_LimitMode.Descriptor instead.
 func (ConcurrencyLimitStrategy_LimitMode) EnumDescriptor() ([]byte, []int) {
-	return file_fault_tolerance_v1_fault_tolerance_proto_rawDescGZIP(), []int{3, 0}
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type ConcurrencyLimitStrategy_AdaptiveAlgorithm int32
+
+const (
+	ConcurrencyLimitStrategy_ALGORITHM_UNKNOWN  ConcurrencyLimitStrategy_AdaptiveAlgorithm = 0
+	ConcurrencyLimitStrategy_ALGORITHM_GRADIENT ConcurrencyLimitStrategy_AdaptiveAlgorithm = 1
+	ConcurrencyLimitStrategy_ALGORITHM_VEGAS    ConcurrencyLimitStrategy_AdaptiveAlgorithm = 2
+)
+
+// Enum value maps for ConcurrencyLimitStrategy_AdaptiveAlgorithm.
+var (
+	ConcurrencyLimitStrategy_AdaptiveAlgorithm_name = map[int32]string{
+		0: "ALGORITHM_UNKNOWN",
+		1: "ALGORITHM_GRADIENT",
+		2: "ALGORITHM_VEGAS",
+	}
+	ConcurrencyLimitStrategy_AdaptiveAlgorithm_value = map[string]int32{
+		"ALGORITHM_UNKNOWN":  0,
+		"ALGORITHM_GRADIENT": 1,
+		"ALGORITHM_VEGAS":    2,
+	}
+)
+
+func (x ConcurrencyLimitStrategy_AdaptiveAlgorithm) Enum() *ConcurrencyLimitStrategy_AdaptiveAlgorithm {
+	p := new(ConcurrencyLimitStrategy_AdaptiveAlgorithm)
+	*p = x
+	return p
+}
+
+func (x ConcurrencyLimitStrategy_AdaptiveAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConcurrencyLimitStrategy_AdaptiveAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_fault_tolerance_proto_enumTypes[7].Descriptor()
+}
+
+func (ConcurrencyLimitStrategy_AdaptiveAlgorithm) Type() protoreflect.EnumType {
+	return &file_fault_tolerance_proto_enumTypes[7]
+}
+
+func (x ConcurrencyLimitStrategy_AdaptiveAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConcurrencyLimitStrategy_AdaptiveAlgorithm.Descriptor instead.
+func (ConcurrencyLimitStrategy_AdaptiveAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{5, 1}
 }
 
 type CircuitBreakerStrategy_Strategy int32
@@ -186,6 +444,7 @@ const (
 	CircuitBreakerStrategy_STRATEGY_UNKNOWN             CircuitBreakerStrategy_Strategy = 0
 	CircuitBreakerStrategy_STRATEGY_SLOW_REQUEST_RATIO  CircuitBreakerStrategy_Strategy = 1
 	CircuitBreakerStrategy_STRATEGY_ERROR_REQUEST_RATIO CircuitBreakerStrategy_Strategy = 2
+	CircuitBreakerStrategy_STRATEGY_ERROR_REQUEST_COUNT CircuitBreakerStrategy_Strategy = 3
 )
 
 // Enum value maps for CircuitBreakerStrategy_Strategy.
@@ -194,11 +453,13 @@ var (
 		0: "STRATEGY_UNKNOWN",
 		1: "STRATEGY_SLOW_REQUEST_RATIO",
 		2: "STRATEGY_ERROR_REQUEST_RATIO",
+		3: "STRATEGY_ERROR_REQUEST_COUNT",
 	}
 	CircuitBreakerStrategy_Strategy_value = map[string]int32{
 		"STRATEGY_UNKNOWN":             0,
 		"STRATEGY_SLOW_REQUEST_RATIO":  1,
 		"STRATEGY_ERROR_REQUEST_RATIO": 2,
+		"STRATEGY_ERROR_REQUEST_COUNT": 3,
 	}
 )
 
@@ -213,11 +474,11 @@ func (x CircuitBreakerStrategy_Strategy) String() string {
 }
 
 func (CircuitBreakerStrategy_Strategy) Descriptor() protoreflect.EnumDescriptor {
-	return file_fault_tolerance_v1_fault_tolerance_proto_enumTypes[3].Descriptor()
+	return file_fault_tolerance_proto_enumTypes[8].Descriptor()
 }
 
 func (CircuitBreakerStrategy_Strategy) Type() protoreflect.EnumType {
-	return &file_fault_tolerance_v1_fault_tolerance_proto_enumTypes[3]
+	return &file_fault_tolerance_proto_enumTypes[8]
 }
 
 func (x CircuitBreakerStrategy_Strategy) Number() protoreflect.EnumNumber {
@@ -226,7 +487,74 @@ func (x CircuitBreakerStrategy_Strategy) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CircuitBreakerStrategy_Strategy.Descriptor instead.
 func (CircuitBreakerStrategy_Strategy) EnumDescriptor() ([]byte, []int) {
-	return file_fault_tolerance_v1_fault_tolerance_proto_rawDescGZIP(), []int{4, 0}
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{6, 0}
+}
+
+// StringMatch describes how to match a string, with the same semantics as the StringMatch of TrafficRouter.
+// Exactly one of the fields is set, and the match is case-sensitive.
+type StringMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exact  string `protobuf:"bytes,1,opt,name=exact,proto3" json:"exact,omitempty"`
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// RE2 style regex-based match (https://github.com/google/re2/wiki/Syntax), which must match
+	// the whole string.
+	Regex string `protobuf:"bytes,3,opt,name=regex,proto3" json:"regex,omitempty"`
+}
+
+func (x *StringMatch) Reset() {
+	*x = StringMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringMatch) ProtoMessage() {}
+
+func (x *StringMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringMatch.ProtoReflect.Descriptor instead.
+func (*StringMatch) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StringMatch) GetExact() string {
+	if x != nil {
+		return x.Exact
+	}
+	return ""
+}
+
+func (x *StringMatch) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *StringMatch) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
 }
 
 // FaultToleranceRule
@@ -243,7 +571,7 @@ type FaultToleranceRule struct {
 func (x *FaultToleranceRule) Reset() {
 	*x = FaultToleranceRule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[0]
+		mi := &file_fault_tolerance_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -256,7 +584,7 @@ func (x *FaultToleranceRule) String() string {
 func (*FaultToleranceRule) ProtoMessage() {}
 
 func (x *FaultToleranceRule) ProtoReflect() protoreflect.Message {
-	mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[0]
+	mi := &file_fault_tolerance_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,7 +597,7 @@ func (x *FaultToleranceRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FaultToleranceRule.ProtoReflect.Descriptor instead.
 func (*FaultToleranceRule) Descriptor() ([]byte, []int) {
-	return file_fault_tolerance_v1_fault_tolerance_proto_rawDescGZIP(), []int{0}
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *FaultToleranceRule) GetTargets() []*FaultToleranceRule_FaultToleranceRuleTargetRef {
@@ -299,18 +627,23 @@ type RateLimitStrategy struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name                 string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	MetricType           RateLimitStrategy_MetricType `protobuf:"varint,2,opt,name=metric_type,json=metricType,proto3,enum=io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy_MetricType" json:"metric_type,omitempty"`
-	LimitMode            RateLimitStrategy_LimitMode  `protobuf:"varint,3,opt,name=limit_mode,json=limitMode,proto3,enum=io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy_LimitMode" json:"limit_mode,omitempty"`
-	Threshold            int64                        `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	StatDuration         int32                        `protobuf:"varint,5,opt,name=stat_duration,json=statDuration,proto3" json:"stat_duration,omitempty"`
-	StatDurationTimeUnit v1.TimeUnit                  `protobuf:"varint,6,opt,name=stat_duration_time_unit,json=statDurationTimeUnit,proto3,enum=io.opensergo.proto.common.v1.TimeUnit" json:"stat_duration_time_unit,omitempty"`
+	Name                 string                            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MetricType           RateLimitStrategy_MetricType      `protobuf:"varint,2,opt,name=metric_type,json=metricType,proto3,enum=io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy_MetricType" json:"metric_type,omitempty"`
+	LimitMode            RateLimitStrategy_LimitMode       `protobuf:"varint,3,opt,name=limit_mode,json=limitMode,proto3,enum=io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy_LimitMode" json:"limit_mode,omitempty"`
+	Threshold            int64                             `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	StatDuration         int32                             `protobuf:"varint,5,opt,name=stat_duration,json=statDuration,proto3" json:"stat_duration,omitempty"`
+	StatDurationTimeUnit v1.TimeUnit                       `protobuf:"varint,6,opt,name=stat_duration_time_unit,json=statDurationTimeUnit,proto3,enum=io.opensergo.proto.common.v1.TimeUnit" json:"stat_duration_time_unit,omitempty"`
+	ControlBehavior      RateLimitStrategy_ControlBehavior `protobuf:"varint,7,opt,name=control_behavior,json=controlBehavior,proto3,enum=io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy_ControlBehavior" json:"control_behavior,omitempty"`
+	// Used by BEHAVIOR_WARM_UP and BEHAVIOR_WARM_UP_QUEUEING.
+	WarmUp *RateLimitStrategy_WarmUp `protobuf:"bytes,8,opt,name=warm_up,json=warmUp,proto3" json:"warm_up,omitempty"`
+	// Used by BEHAVIOR_QUEUEING and BEHAVIOR_WARM_UP_QUEUEING.
+	Queueing *RateLimitStrategy_Queueing `protobuf:"bytes,9,opt,name=queueing,proto3" json:"queueing,omitempty"`
 }
 
 func (x *RateLimitStrategy) Reset() {
 	*x = RateLimitStrategy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[1]
+		mi := &file_fault_tolerance_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -323,7 +656,7 @@ func (x *RateLimitStrategy) String() string {
 func (*RateLimitStrategy) ProtoMessage() {}
 
 func (x *RateLimitStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[1]
+	mi := &file_fault_tolerance_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,7 +669,7 @@ func (x *RateLimitStrategy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RateLimitStrategy.ProtoReflect.Descriptor instead.
 func (*RateLimitStrategy) Descriptor() ([]byte, []int) {
-	return file_fault_tolerance_v1_fault_tolerance_proto_rawDescGZIP(), []int{1}
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *RateLimitStrategy) GetName() string {
@@ -381,34 +714,64 @@ func (x *RateLimitStrategy) GetStatDurationTimeUnit() v1.TimeUnit {
 	return v1.TimeUnit(0)
 }
 
-// ThrottlingStrategy
-type ThrottlingStrategy struct {
+func (x *RateLimitStrategy) GetControlBehavior() RateLimitStrategy_ControlBehavior {
+	if x != nil {
+		return x.ControlBehavior
+	}
+	return RateLimitStrategy_BEHAVIOR_REJECT
+}
+
+func (x *RateLimitStrategy) GetWarmUp() *RateLimitStrategy_WarmUp {
+	if x != nil {
+		return x.WarmUp
+	}
+	return nil
+}
+
+func (x *RateLimitStrategy) GetQueueing() *RateLimitStrategy_Queueing {
+	if x != nil {
+		return x.Queueing
+	}
+	return nil
+}
+
+// ParamFlowStrategy limits the request amount per value of a request parameter (hotspot parameter rate limiting).
+type ParamFlowStrategy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name                        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	MinIntervalMillisOfRequests int64  `protobuf:"varint,2,opt,name=min_interval_millis_of_requests,json=minIntervalMillisOfRequests,proto3" json:"min_interval_millis_of_requests,omitempty"`
-	QueueTimeoutMillis          int64  `protobuf:"varint,3,opt,name=queue_timeout_millis,json=queueTimeoutMillis,proto3" json:"queue_timeout_millis,omitempty"`
-}
-
-func (x *ThrottlingStrategy) Reset() {
-	*x = ThrottlingStrategy{}
+	Name        string                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ParamSource ParamFlowStrategy_ParamSource `protobuf:"varint,2,opt,name=param_source,json=paramSource,proto3,enum=io.opensergo.proto.fault_tolerance.v1.ParamFlowStrategy_ParamSource" json:"param_source,omitempty"`
+	// The name of the header or the query parameter, used by SOURCE_HEADER and SOURCE_QUERY_PARAM.
+	ParamKey string `protobuf:"bytes,3,opt,name=param_key,json=paramKey,proto3" json:"param_key,omitempty"`
+	// The index of the argument of the invocation (e.g. Dubbo), used by SOURCE_ARG_INDEX.
+	ParamIndex int32                       `protobuf:"varint,4,opt,name=param_index,json=paramIndex,proto3" json:"param_index,omitempty"`
+	LimitMode  ParamFlowStrategy_LimitMode `protobuf:"varint,5,opt,name=limit_mode,json=limitMode,proto3,enum=io.opensergo.proto.fault_tolerance.v1.ParamFlowStrategy_LimitMode" json:"limit_mode,omitempty"`
+	// The threshold of the request amount of each value of the parameter.
+	Threshold            int64                               `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	StatDuration         int32                               `protobuf:"varint,7,opt,name=stat_duration,json=statDuration,proto3" json:"stat_duration,omitempty"`
+	StatDurationTimeUnit v1.TimeUnit                         `protobuf:"varint,8,opt,name=stat_duration_time_unit,json=statDurationTimeUnit,proto3,enum=io.opensergo.proto.common.v1.TimeUnit" json:"stat_duration_time_unit,omitempty"`
+	Exceptions           []*ParamFlowStrategy_ParamException `protobuf:"bytes,9,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+}
+
+func (x *ParamFlowStrategy) Reset() {
+	*x = ParamFlowStrategy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[2]
+		mi := &file_fault_tolerance_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ThrottlingStrategy) String() string {
+func (x *ParamFlowStrategy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ThrottlingStrategy) ProtoMessage() {}
+func (*ParamFlowStrategy) ProtoMessage() {}
 
-func (x *ThrottlingStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[2]
+func (x *ParamFlowStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,60 +782,169 @@ func (x *ThrottlingStrategy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ThrottlingStrategy.ProtoReflect.Descriptor instead.
-func (*ThrottlingStrategy) Descriptor() ([]byte, []int) {
-	return file_fault_tolerance_v1_fault_tolerance_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ParamFlowStrategy.ProtoReflect.Descriptor instead.
+func (*ParamFlowStrategy) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ThrottlingStrategy) GetName() string {
+func (x *ParamFlowStrategy) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ThrottlingStrategy) GetMinIntervalMillisOfRequests() int64 {
+func (x *ParamFlowStrategy) GetParamSource() ParamFlowStrategy_ParamSource {
 	if x != nil {
-		return x.MinIntervalMillisOfRequests
+		return x.ParamSource
+	}
+	return ParamFlowStrategy_SOURCE_UNKNOWN
+}
+
+func (x *ParamFlowStrategy) GetParamKey() string {
+	if x != nil {
+		return x.ParamKey
+	}
+	return ""
+}
+
+func (x *ParamFlowStrategy) GetParamIndex() int32 {
+	if x != nil {
+		return x.ParamIndex
 	}
 	return 0
 }
 
-func (x *ThrottlingStrategy) GetQueueTimeoutMillis() int64 {
+func (x *ParamFlowStrategy) GetLimitMode() ParamFlowStrategy_LimitMode {
 	if x != nil {
-		return x.QueueTimeoutMillis
+		return x.LimitMode
+	}
+	return ParamFlowStrategy_MODE_UNKNOWN
+}
+
+func (x *ParamFlowStrategy) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
 	}
 	return 0
 }
 
-// ConcurrencyLimitStrategy
-type ConcurrencyLimitStrategy struct {
+func (x *ParamFlowStrategy) GetStatDuration() int32 {
+	if x != nil {
+		return x.StatDuration
+	}
+	return 0
+}
+
+func (x *ParamFlowStrategy) GetStatDurationTimeUnit() v1.TimeUnit {
+	if x != nil {
+		return x.StatDurationTimeUnit
+	}
+	return v1.TimeUnit(0)
+}
+
+func (x *ParamFlowStrategy) GetExceptions() []*ParamFlowStrategy_ParamException {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+// ThrottlingStrategy
+type ThrottlingStrategy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name           string                             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	LimitMode      ConcurrencyLimitStrategy_LimitMode `protobuf:"varint,2,opt,name=limit_mode,json=limitMode,proto3,enum=io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy_LimitMode" json:"limit_mode,omitempty"`
-	MaxConcurrency int64                              `protobuf:"varint,3,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	Name                        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MinIntervalMillisOfRequests int64  `protobuf:"varint,2,opt,name=min_interval_millis_of_requests,json=minIntervalMillisOfRequests,proto3" json:"min_interval_millis_of_requests,omitempty"`
+	QueueTimeoutMillis          int64  `protobuf:"varint,3,opt,name=queue_timeout_millis,json=queueTimeoutMillis,proto3" json:"queue_timeout_millis,omitempty"`
 }
 
-func (x *ConcurrencyLimitStrategy) Reset() {
-	*x = ConcurrencyLimitStrategy{}
+func (x *ThrottlingStrategy) Reset() {
+	*x = ThrottlingStrategy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[3]
+		mi := &file_fault_tolerance_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConcurrencyLimitStrategy) String() string {
+func (x *ThrottlingStrategy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConcurrencyLimitStrategy) ProtoMessage() {}
+func (*ThrottlingStrategy) ProtoMessage() {}
 
-func (x *ConcurrencyLimitStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[3]
+func (x *ThrottlingStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThrottlingStrategy.ProtoReflect.Descriptor instead.
+func (*ThrottlingStrategy) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ThrottlingStrategy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ThrottlingStrategy) GetMinIntervalMillisOfRequests() int64 {
+	if x != nil {
+		return x.MinIntervalMillisOfRequests
+	}
+	return 0
+}
+
+func (x *ThrottlingStrategy) GetQueueTimeoutMillis() int64 {
+	if x != nil {
+		return x.QueueTimeoutMillis
+	}
+	return 0
+}
+
+// ConcurrencyLimitStrategy
+type ConcurrencyLimitStrategy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string                             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LimitMode ConcurrencyLimitStrategy_LimitMode `protobuf:"varint,2,opt,name=limit_mode,json=limitMode,proto3,enum=io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy_LimitMode" json:"limit_mode,omitempty"`
+	// The static max concurrency. With the adaptive limit, it is the upper bound of the adaptive limit,
+	// so that the clients unaware of the adaptive limit fall back to a static limit.
+	MaxConcurrency int64                                   `protobuf:"varint,3,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	Adaptive       *ConcurrencyLimitStrategy_AdaptiveLimit `protobuf:"bytes,4,opt,name=adaptive,proto3" json:"adaptive,omitempty"`
+}
+
+func (x *ConcurrencyLimitStrategy) Reset() {
+	*x = ConcurrencyLimitStrategy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConcurrencyLimitStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConcurrencyLimitStrategy) ProtoMessage() {}
+
+func (x *ConcurrencyLimitStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,7 +957,7 @@ func (x *ConcurrencyLimitStrategy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConcurrencyLimitStrategy.ProtoReflect.Descriptor instead.
 func (*ConcurrencyLimitStrategy) Descriptor() ([]byte, []int) {
-	return file_fault_tolerance_v1_fault_tolerance_proto_rawDescGZIP(), []int{3}
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ConcurrencyLimitStrategy) GetName() string {
@@ -509,6 +981,13 @@ func (x *ConcurrencyLimitStrategy) GetMaxConcurrency() int64 {
 	return 0
 }
 
+func (x *ConcurrencyLimitStrategy) GetAdaptive() *ConcurrencyLimitStrategy_AdaptiveLimit {
+	if x != nil {
+		return x.Adaptive
+	}
+	return nil
+}
+
 // CircuitBreakerStrategy
 type CircuitBreakerStrategy struct {
 	state         protoimpl.MessageState
@@ -525,12 +1004,14 @@ type CircuitBreakerStrategy struct {
 	MinRequestAmount        int32                                                `protobuf:"varint,8,opt,name=min_request_amount,json=minRequestAmount,proto3" json:"min_request_amount,omitempty"`
 	SlowCondition           *CircuitBreakerStrategy_CircuitBreakerSlowCondition  `protobuf:"bytes,9,opt,name=slow_condition,json=slowCondition,proto3" json:"slow_condition,omitempty"`
 	ErrorCondition          *CircuitBreakerStrategy_CircuitBreakerErrorCondition `protobuf:"bytes,10,opt,name=error_condition,json=errorCondition,proto3" json:"error_condition,omitempty"`
+	// The threshold of the error amount in the stat duration, which is used by STRATEGY_ERROR_REQUEST_COUNT.
+	TriggerCount int64 `protobuf:"varint,11,opt,name=trigger_count,json=triggerCount,proto3" json:"trigger_count,omitempty"`
 }
 
 func (x *CircuitBreakerStrategy) Reset() {
 	*x = CircuitBreakerStrategy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[4]
+		mi := &file_fault_tolerance_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -543,7 +1024,7 @@ func (x *CircuitBreakerStrategy) String() string {
 func (*CircuitBreakerStrategy) ProtoMessage() {}
 
 func (x *CircuitBreakerStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[4]
+	mi := &file_fault_tolerance_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -556,7 +1037,7 @@ func (x *CircuitBreakerStrategy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CircuitBreakerStrategy.ProtoReflect.Descriptor instead.
 func (*CircuitBreakerStrategy) Descriptor() ([]byte, []int) {
-	return file_fault_tolerance_v1_fault_tolerance_proto_rawDescGZIP(), []int{4}
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CircuitBreakerStrategy) GetName() string {
@@ -629,31 +1110,46 @@ func (x *CircuitBreakerStrategy) GetErrorCondition() *CircuitBreakerStrategy_Cir
 	return nil
 }
 
-type FaultToleranceRule_FaultToleranceRuleTargetRef struct {
+func (x *CircuitBreakerStrategy) GetTriggerCount() int64 {
+	if x != nil {
+		return x.TriggerCount
+	}
+	return 0
+}
+
+// RetryStrategy retries the failed requests of the outbound resources.
+type RetryStrategy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TargetResourceName string `protobuf:"bytes,1,opt,name=target_resource_name,json=targetResourceName,proto3" json:"target_resource_name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The max attempts of a request, including the first attempt.
+	MaxAttempts int32 `protobuf:"varint,2,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// The timeout of each attempt, 0 means no timeout.
+	PerTryTimeoutMillis int64                         `protobuf:"varint,3,opt,name=per_try_timeout_millis,json=perTryTimeoutMillis,proto3" json:"per_try_timeout_millis,omitempty"`
+	Backoff             *RetryStrategy_RetryBackoff   `protobuf:"bytes,4,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	RetryOn             *RetryStrategy_RetryCondition `protobuf:"bytes,5,opt,name=retry_on,json=retryOn,proto3" json:"retry_on,omitempty"`
+	Budget              *RetryStrategy_RetryBudget    `protobuf:"bytes,6,opt,name=budget,proto3" json:"budget,omitempty"`
 }
 
-func (x *FaultToleranceRule_FaultToleranceRuleTargetRef) Reset() {
-	*x = FaultToleranceRule_FaultToleranceRuleTargetRef{}
+func (x *RetryStrategy) Reset() {
+	*x = RetryStrategy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[5]
+		mi := &file_fault_tolerance_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FaultToleranceRule_FaultToleranceRuleTargetRef) String() string {
+func (x *RetryStrategy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FaultToleranceRule_FaultToleranceRuleTargetRef) ProtoMessage() {}
+func (*RetryStrategy) ProtoMessage() {}
 
-func (x *FaultToleranceRule_FaultToleranceRuleTargetRef) ProtoReflect() protoreflect.Message {
-	mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[5]
+func (x *RetryStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,44 +1160,80 @@ func (x *FaultToleranceRule_FaultToleranceRuleTargetRef) ProtoReflect() protoref
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FaultToleranceRule_FaultToleranceRuleTargetRef.ProtoReflect.Descriptor instead.
-func (*FaultToleranceRule_FaultToleranceRuleTargetRef) Descriptor() ([]byte, []int) {
-	return file_fault_tolerance_v1_fault_tolerance_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use RetryStrategy.ProtoReflect.Descriptor instead.
+func (*RetryStrategy) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *FaultToleranceRule_FaultToleranceRuleTargetRef) GetTargetResourceName() string {
+func (x *RetryStrategy) GetName() string {
 	if x != nil {
-		return x.TargetResourceName
+		return x.Name
 	}
 	return ""
 }
 
-type FaultToleranceRule_FaultToleranceStrategyRef struct {
+func (x *RetryStrategy) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryStrategy) GetPerTryTimeoutMillis() int64 {
+	if x != nil {
+		return x.PerTryTimeoutMillis
+	}
+	return 0
+}
+
+func (x *RetryStrategy) GetBackoff() *RetryStrategy_RetryBackoff {
+	if x != nil {
+		return x.Backoff
+	}
+	return nil
+}
+
+func (x *RetryStrategy) GetRetryOn() *RetryStrategy_RetryCondition {
+	if x != nil {
+		return x.RetryOn
+	}
+	return nil
+}
+
+func (x *RetryStrategy) GetBudget() *RetryStrategy_RetryBudget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+// TimeoutStrategy limits the time of the requests of the outbound resources.
+type TimeoutStrategy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TimeoutMillis int64  `protobuf:"varint,2,opt,name=timeout_millis,json=timeoutMillis,proto3" json:"timeout_millis,omitempty"`
 }
 
-func (x *FaultToleranceRule_FaultToleranceStrategyRef) Reset() {
-	*x = FaultToleranceRule_FaultToleranceStrategyRef{}
+func (x *TimeoutStrategy) Reset() {
+	*x = TimeoutStrategy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[6]
+		mi := &file_fault_tolerance_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FaultToleranceRule_FaultToleranceStrategyRef) String() string {
+func (x *TimeoutStrategy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FaultToleranceRule_FaultToleranceStrategyRef) ProtoMessage() {}
+func (*TimeoutStrategy) ProtoMessage() {}
 
-func (x *FaultToleranceRule_FaultToleranceStrategyRef) ProtoReflect() protoreflect.Message {
-	mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[6]
+func (x *TimeoutStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,51 +1244,62 @@ func (x *FaultToleranceRule_FaultToleranceStrategyRef) ProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FaultToleranceRule_FaultToleranceStrategyRef.ProtoReflect.Descriptor instead.
-func (*FaultToleranceRule_FaultToleranceStrategyRef) Descriptor() ([]byte, []int) {
-	return file_fault_tolerance_v1_fault_tolerance_proto_rawDescGZIP(), []int{0, 1}
+// Deprecated: Use TimeoutStrategy.ProtoReflect.Descriptor instead.
+func (*TimeoutStrategy) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *FaultToleranceRule_FaultToleranceStrategyRef) GetName() string {
+func (x *TimeoutStrategy) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *FaultToleranceRule_FaultToleranceStrategyRef) GetKind() string {
+func (x *TimeoutStrategy) GetTimeoutMillis() int64 {
 	if x != nil {
-		return x.Kind
+		return x.TimeoutMillis
 	}
-	return ""
+	return 0
 }
 
-type FaultToleranceRule_FaultToleranceActionRef struct {
+// SystemAdaptiveStrategy protects each instance of an app from overload regardless of the resources,
+// which blocks the inbound requests once any of the thresholds is exceeded. A threshold of 0 means no limit.
+type SystemAdaptiveStrategy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
-}
-
-func (x *FaultToleranceRule_FaultToleranceActionRef) Reset() {
-	*x = FaultToleranceRule_FaultToleranceActionRef{}
+	// The threshold of the CPU usage of the instance, between 0 and 1.
+	MaxCpuUsage float64 `protobuf:"fixed64,2,opt,name=max_cpu_usage,json=maxCpuUsage,proto3" json:"max_cpu_usage,omitempty"`
+	// The threshold of the system load (load1) of the instance.
+	MaxSystemLoad float64 `protobuf:"fixed64,3,opt,name=max_system_load,json=maxSystemLoad,proto3" json:"max_system_load,omitempty"`
+	// The threshold of the average RT of the inbound requests.
+	MaxAvgRtMillis int64 `protobuf:"varint,4,opt,name=max_avg_rt_millis,json=maxAvgRtMillis,proto3" json:"max_avg_rt_millis,omitempty"`
+	// The threshold of the QPS of the inbound requests.
+	MaxInboundQps int64 `protobuf:"varint,5,opt,name=max_inbound_qps,json=maxInboundQps,proto3" json:"max_inbound_qps,omitempty"`
+	// The threshold of the inbound requests (or threads) being processed concurrently.
+	MaxConcurrency int64 `protobuf:"varint,6,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+}
+
+func (x *SystemAdaptiveStrategy) Reset() {
+	*x = SystemAdaptiveStrategy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[7]
+		mi := &file_fault_tolerance_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FaultToleranceRule_FaultToleranceActionRef) String() string {
+func (x *SystemAdaptiveStrategy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FaultToleranceRule_FaultToleranceActionRef) ProtoMessage() {}
+func (*SystemAdaptiveStrategy) ProtoMessage() {}
 
-func (x *FaultToleranceRule_FaultToleranceActionRef) ProtoReflect() protoreflect.Message {
-	mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[7]
+func (x *SystemAdaptiveStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -767,50 +1310,84 @@ func (x *FaultToleranceRule_FaultToleranceActionRef) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FaultToleranceRule_FaultToleranceActionRef.ProtoReflect.Descriptor instead.
-func (*FaultToleranceRule_FaultToleranceActionRef) Descriptor() ([]byte, []int) {
-	return file_fault_tolerance_v1_fault_tolerance_proto_rawDescGZIP(), []int{0, 2}
+// Deprecated: Use SystemAdaptiveStrategy.ProtoReflect.Descriptor instead.
+func (*SystemAdaptiveStrategy) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *FaultToleranceRule_FaultToleranceActionRef) GetName() string {
+func (x *SystemAdaptiveStrategy) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *FaultToleranceRule_FaultToleranceActionRef) GetKind() string {
+func (x *SystemAdaptiveStrategy) GetMaxCpuUsage() float64 {
 	if x != nil {
-		return x.Kind
+		return x.MaxCpuUsage
 	}
-	return ""
+	return 0
 }
 
-type CircuitBreakerStrategy_CircuitBreakerSlowCondition struct {
+func (x *SystemAdaptiveStrategy) GetMaxSystemLoad() float64 {
+	if x != nil {
+		return x.MaxSystemLoad
+	}
+	return 0
+}
+
+func (x *SystemAdaptiveStrategy) GetMaxAvgRtMillis() int64 {
+	if x != nil {
+		return x.MaxAvgRtMillis
+	}
+	return 0
+}
+
+func (x *SystemAdaptiveStrategy) GetMaxInboundQps() int64 {
+	if x != nil {
+		return x.MaxInboundQps
+	}
+	return 0
+}
+
+func (x *SystemAdaptiveStrategy) GetMaxConcurrency() int64 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+// FallbackAction describes the response of the requests blocked by the fault-tolerance strategies.
+// At most one of the responses should be set.
+type FallbackAction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MaxAllowedRtMillis int32 `protobuf:"varint,1,opt,name=max_allowed_rt_millis,json=maxAllowedRtMillis,proto3" json:"max_allowed_rt_millis,omitempty"`
+	Name         string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	HttpResponse *FallbackAction_HttpResponse `protobuf:"bytes,2,opt,name=http_response,json=httpResponse,proto3" json:"http_response,omitempty"`
+	GrpcResponse *FallbackAction_GrpcResponse `protobuf:"bytes,3,opt,name=grpc_response,json=grpcResponse,proto3" json:"grpc_response,omitempty"`
+	// The resource which the blocked requests are redirected to.
+	FallbackResource *FallbackAction_FallbackResourceRef `protobuf:"bytes,4,opt,name=fallback_resource,json=fallbackResource,proto3" json:"fallback_resource,omitempty"`
 }
 
-func (x *CircuitBreakerStrategy_CircuitBreakerSlowCondition) Reset() {
-	*x = CircuitBreakerStrategy_CircuitBreakerSlowCondition{}
+func (x *FallbackAction) Reset() {
+	*x = FallbackAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[8]
+		mi := &file_fault_tolerance_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CircuitBreakerStrategy_CircuitBreakerSlowCondition) String() string {
+func (x *FallbackAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CircuitBreakerStrategy_CircuitBreakerSlowCondition) ProtoMessage() {}
+func (*FallbackAction) ProtoMessage() {}
 
-func (x *CircuitBreakerStrategy_CircuitBreakerSlowCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[8]
+func (x *FallbackAction) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,41 +1398,72 @@ func (x *CircuitBreakerStrategy_CircuitBreakerSlowCondition) ProtoReflect() prot
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CircuitBreakerStrategy_CircuitBreakerSlowCondition.ProtoReflect.Descriptor instead.
-func (*CircuitBreakerStrategy_CircuitBreakerSlowCondition) Descriptor() ([]byte, []int) {
-	return file_fault_tolerance_v1_fault_tolerance_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use FallbackAction.ProtoReflect.Descriptor instead.
+func (*FallbackAction) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CircuitBreakerStrategy_CircuitBreakerSlowCondition) GetMaxAllowedRtMillis() int32 {
+func (x *FallbackAction) GetName() string {
 	if x != nil {
-		return x.MaxAllowedRtMillis
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type CircuitBreakerStrategy_CircuitBreakerErrorCondition struct {
+func (x *FallbackAction) GetHttpResponse() *FallbackAction_HttpResponse {
+	if x != nil {
+		return x.HttpResponse
+	}
+	return nil
+}
+
+func (x *FallbackAction) GetGrpcResponse() *FallbackAction_GrpcResponse {
+	if x != nil {
+		return x.GrpcResponse
+	}
+	return nil
+}
+
+func (x *FallbackAction) GetFallbackResource() *FallbackAction_FallbackResourceRef {
+	if x != nil {
+		return x.FallbackResource
+	}
+	return nil
+}
+
+// FaultToleranceRuleSource matches the requests by the caller and the attributes of the requests.
+// All the set matchers must match.
+type FaultToleranceRule_FaultToleranceRuleSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The app of the caller.
+	App *StringMatch `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	// The namespace of the caller.
+	Namespace   *StringMatch            `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Method      *StringMatch            `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Headers     map[string]*StringMatch `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	QueryParams map[string]*StringMatch `protobuf:"bytes,5,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *CircuitBreakerStrategy_CircuitBreakerErrorCondition) Reset() {
-	*x = CircuitBreakerStrategy_CircuitBreakerErrorCondition{}
+func (x *FaultToleranceRule_FaultToleranceRuleSource) Reset() {
+	*x = FaultToleranceRule_FaultToleranceRuleSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[9]
+		mi := &file_fault_tolerance_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CircuitBreakerStrategy_CircuitBreakerErrorCondition) String() string {
+func (x *FaultToleranceRule_FaultToleranceRuleSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CircuitBreakerStrategy_CircuitBreakerErrorCondition) ProtoMessage() {}
+func (*FaultToleranceRule_FaultToleranceRuleSource) ProtoMessage() {}
 
-func (x *CircuitBreakerStrategy_CircuitBreakerErrorCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[9]
+func (x *FaultToleranceRule_FaultToleranceRuleSource) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -866,257 +1474,1733 @@ func (x *CircuitBreakerStrategy_CircuitBreakerErrorCondition) ProtoReflect() pro
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CircuitBreakerStrategy_CircuitBreakerErrorCondition.ProtoReflect.Descriptor instead.
-func (*CircuitBreakerStrategy_CircuitBreakerErrorCondition) Descriptor() ([]byte, []int) {
-	return file_fault_tolerance_v1_fault_tolerance_proto_rawDescGZIP(), []int{4, 1}
+// Deprecated: Use FaultToleranceRule_FaultToleranceRuleSource.ProtoReflect.Descriptor instead.
+func (*FaultToleranceRule_FaultToleranceRuleSource) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{1, 0}
 }
 
-var File_fault_tolerance_v1_fault_tolerance_proto protoreflect.FileDescriptor
+func (x *FaultToleranceRule_FaultToleranceRuleSource) GetApp() *StringMatch {
+	if x != nil {
+		return x.App
+	}
+	return nil
+}
 
-var file_fault_tolerance_v1_fault_tolerance_proto_rawDesc = []byte{
-	0x0a, 0x28, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72,
-	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x25, 0x69, 0x6f, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76,
-	0x31, 0x1a, 0x16, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x04, 0x0a, 0x12, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54,
-	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x6f, 0x0a, 0x07,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x55, 0x2e,
-	0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f,
-	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x52, 0x65, 0x66, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x73, 0x0a,
-	0x0a, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x53, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c,
-	0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54,
-	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x46, 0x61, 0x75,
-	0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74,
-	0x65, 0x67, 0x79, 0x52, 0x65, 0x66, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69,
-	0x65, 0x73, 0x12, 0x69, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x51, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f,
-	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74,
-	0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x46, 0x61,
-	0x75, 0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x4f, 0x0a,
-	0x1b, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x75, 0x6c, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x66, 0x12, 0x30, 0x0a, 0x14,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x43,
-	0x0a, 0x19, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
-	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
-	0x69, 0x6e, 0x64, 0x1a, 0x41, 0x0a, 0x17, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65,
-	0x72, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x9d, 0x04, 0x0a, 0x11, 0x52, 0x61, 0x74, 0x65, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x64, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x43, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73,
-	0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x61, 0x0a, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x42, 0x2e, 0x69, 0x6f, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x68, 0x72,
-	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
-	0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x12, 0x2c, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00,
-	0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5d,
-	0x0a, 0x17, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x26, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x14, 0x73, 0x74, 0x61, 0x74, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x22, 0x37, 0x0a,
-	0x0a, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a,
-	0x13, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x41, 0x4d,
-	0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x22, 0x3e, 0x0a, 0x09, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d,
-	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x4f,
-	0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x47, 0x4c,
-	0x4f, 0x42, 0x41, 0x4c, 0x10, 0x02, 0x22, 0xa0, 0x01, 0x0a, 0x12, 0x54, 0x68, 0x72, 0x6f, 0x74,
-	0x74, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x44, 0x0a, 0x1f, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1b, 0x6d, 0x69, 0x6e, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x4f, 0x66, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x18, 0x43, 0x6f,
-	0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74,
-	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x68, 0x0a, 0x0a, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x49,
-	0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61,
-	0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d,
-	0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x3e, 0x0a,
-	0x09, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x4f,
-	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
-	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b,
-	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x02, 0x22, 0x95, 0x08,
-	0x0a, 0x16, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
-	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x28, 0x80, 0x08,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x62, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
-	0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x46, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x53,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
-	0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x3c, 0x0a, 0x0d, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0,
-	0x3f, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x2c, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74,
-	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x17, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52,
-	0x14, 0x73, 0x74, 0x61, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
-	0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x63, 0x0a, 0x1a, 0x72, 0x65, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e,
-	0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x17, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x35,
-	0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a,
-	0x02, 0x20, 0x00, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x0e, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x63,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x59,
-	0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61,
-	0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72,
-	0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x43, 0x69,
-	0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x77,
-	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6c, 0x6f, 0x77, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x83, 0x01, 0x0a, 0x0f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x5a, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f,
-	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75,
-	0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x50,
-	0x0a, 0x1b, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
-	0x53, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a,
-	0x15, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x72, 0x74, 0x5f,
-	0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6d, 0x61,
-	0x78, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
-	0x1a, 0x1e, 0x0a, 0x1c, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b,
-	0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x63, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x14, 0x0a, 0x10,
-	0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x53,
-	0x4c, 0x4f, 0x57, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x41, 0x54, 0x49,
-	0x4f, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x41,
-	0x54, 0x49, 0x4f, 0x10, 0x02, 0x42, 0x85, 0x01, 0x0a, 0x25, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x42,
-	0x13, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2f, 0x6f, 0x70, 0x65,
-	0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2d, 0x70,
-	0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *FaultToleranceRule_FaultToleranceRuleSource) GetNamespace() *StringMatch {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
 }
 
-var (
-	file_fault_tolerance_v1_fault_tolerance_proto_rawDescOnce sync.Once
-	file_fault_tolerance_v1_fault_tolerance_proto_rawDescData = file_fault_tolerance_v1_fault_tolerance_proto_rawDesc
-)
+func (x *FaultToleranceRule_FaultToleranceRuleSource) GetMethod() *StringMatch {
+	if x != nil {
+		return x.Method
+	}
+	return nil
+}
 
-func file_fault_tolerance_v1_fault_tolerance_proto_rawDescGZIP() []byte {
-	file_fault_tolerance_v1_fault_tolerance_proto_rawDescOnce.Do(func() {
-		file_fault_tolerance_v1_fault_tolerance_proto_rawDescData = protoimpl.X.CompressGZIP(file_fault_tolerance_v1_fault_tolerance_proto_rawDescData)
-	})
-	return file_fault_tolerance_v1_fault_tolerance_proto_rawDescData
-}
-
-var file_fault_tolerance_v1_fault_tolerance_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_fault_tolerance_v1_fault_tolerance_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_fault_tolerance_v1_fault_tolerance_proto_goTypes = []interface{}{
-	(RateLimitStrategy_MetricType)(0),                           // 0: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.MetricType
-	(RateLimitStrategy_LimitMode)(0),                            // 1: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.LimitMode
-	(ConcurrencyLimitStrategy_LimitMode)(0),                     // 2: io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy.LimitMode
-	(CircuitBreakerStrategy_Strategy)(0),                        // 3: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.Strategy
-	(*FaultToleranceRule)(nil),                                  // 4: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule
-	(*RateLimitStrategy)(nil),                                   // 5: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy
-	(*ThrottlingStrategy)(nil),                                  // 6: io.opensergo.proto.fault_tolerance.v1.ThrottlingStrategy
-	(*ConcurrencyLimitStrategy)(nil),                            // 7: io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy
-	(*CircuitBreakerStrategy)(nil),                              // 8: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy
-	(*FaultToleranceRule_FaultToleranceRuleTargetRef)(nil),      // 9: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleTargetRef
-	(*FaultToleranceRule_FaultToleranceStrategyRef)(nil),        // 10: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceStrategyRef
-	(*FaultToleranceRule_FaultToleranceActionRef)(nil),          // 11: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceActionRef
-	(*CircuitBreakerStrategy_CircuitBreakerSlowCondition)(nil),  // 12: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.CircuitBreakerSlowCondition
-	(*CircuitBreakerStrategy_CircuitBreakerErrorCondition)(nil), // 13: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.CircuitBreakerErrorCondition
-	(v1.TimeUnit)(0),                                            // 14: io.opensergo.proto.common.v1.TimeUnit
-}
-var file_fault_tolerance_v1_fault_tolerance_proto_depIdxs = []int32{
-	9,  // 0: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.targets:type_name -> io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleTargetRef
-	10, // 1: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.strategies:type_name -> io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceStrategyRef
-	11, // 2: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.action:type_name -> io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceActionRef
-	0,  // 3: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.metric_type:type_name -> io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.MetricType
-	1,  // 4: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.limit_mode:type_name -> io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.LimitMode
-	14, // 5: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.stat_duration_time_unit:type_name -> io.opensergo.proto.common.v1.TimeUnit
-	2,  // 6: io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy.limit_mode:type_name -> io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy.LimitMode
-	3,  // 7: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.strategy:type_name -> io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.Strategy
-	14, // 8: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.stat_duration_time_unit:type_name -> io.opensergo.proto.common.v1.TimeUnit
-	14, // 9: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.recovery_timeout_time_unit:type_name -> io.opensergo.proto.common.v1.TimeUnit
-	12, // 10: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.slow_condition:type_name -> io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.CircuitBreakerSlowCondition
-	13, // 11: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.error_condition:type_name -> io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.CircuitBreakerErrorCondition
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
-}
-
-func init() { file_fault_tolerance_v1_fault_tolerance_proto_init() }
-func file_fault_tolerance_v1_fault_tolerance_proto_init() {
-	if File_fault_tolerance_v1_fault_tolerance_proto != nil {
-		return
+func (x *FaultToleranceRule_FaultToleranceRuleSource) GetHeaders() map[string]*StringMatch {
+	if x != nil {
+		return x.Headers
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FaultToleranceRule); i {
-			case 0:
-				return &v.state
+	return nil
+}
+
+func (x *FaultToleranceRule_FaultToleranceRuleSource) GetQueryParams() map[string]*StringMatch {
+	if x != nil {
+		return x.QueryParams
+	}
+	return nil
+}
+
+type FaultToleranceRule_FaultToleranceRuleTargetRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetResourceName string `protobuf:"bytes,1,opt,name=target_resource_name,json=targetResourceName,proto3" json:"target_resource_name,omitempty"`
+	// The rule applies to the requests matching any of the sources, or all requests if empty.
+	Sources   []*FaultToleranceRule_FaultToleranceRuleSource `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	MatchType FaultToleranceRule_MatchType                   `protobuf:"varint,3,opt,name=match_type,json=matchType,proto3,enum=io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule_MatchType" json:"match_type,omitempty"`
+	// Among the targets of the rules of an app which match a resource with the same sources, only the
+	// targets of the highest priority apply. At the same priority, exact targets take precedence over
+	// patterns, and the targets of the same precedence all apply.
+	Priority int32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (x *FaultToleranceRule_FaultToleranceRuleTargetRef) Reset() {
+	*x = FaultToleranceRule_FaultToleranceRuleTargetRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FaultToleranceRule_FaultToleranceRuleTargetRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaultToleranceRule_FaultToleranceRuleTargetRef) ProtoMessage() {}
+
+func (x *FaultToleranceRule_FaultToleranceRuleTargetRef) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaultToleranceRule_FaultToleranceRuleTargetRef.ProtoReflect.Descriptor instead.
+func (*FaultToleranceRule_FaultToleranceRuleTargetRef) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *FaultToleranceRule_FaultToleranceRuleTargetRef) GetTargetResourceName() string {
+	if x != nil {
+		return x.TargetResourceName
+	}
+	return ""
+}
+
+func (x *FaultToleranceRule_FaultToleranceRuleTargetRef) GetSources() []*FaultToleranceRule_FaultToleranceRuleSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *FaultToleranceRule_FaultToleranceRuleTargetRef) GetMatchType() FaultToleranceRule_MatchType {
+	if x != nil {
+		return x.MatchType
+	}
+	return FaultToleranceRule_MATCH_EXACT
+}
+
+func (x *FaultToleranceRule_FaultToleranceRuleTargetRef) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type FaultToleranceRule_FaultToleranceStrategyRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *FaultToleranceRule_FaultToleranceStrategyRef) Reset() {
+	*x = FaultToleranceRule_FaultToleranceStrategyRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FaultToleranceRule_FaultToleranceStrategyRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaultToleranceRule_FaultToleranceStrategyRef) ProtoMessage() {}
+
+func (x *FaultToleranceRule_FaultToleranceStrategyRef) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaultToleranceRule_FaultToleranceStrategyRef.ProtoReflect.Descriptor instead.
+func (*FaultToleranceRule_FaultToleranceStrategyRef) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *FaultToleranceRule_FaultToleranceStrategyRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FaultToleranceRule_FaultToleranceStrategyRef) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type FaultToleranceRule_FaultToleranceActionRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *FaultToleranceRule_FaultToleranceActionRef) Reset() {
+	*x = FaultToleranceRule_FaultToleranceActionRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FaultToleranceRule_FaultToleranceActionRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaultToleranceRule_FaultToleranceActionRef) ProtoMessage() {}
+
+func (x *FaultToleranceRule_FaultToleranceActionRef) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaultToleranceRule_FaultToleranceActionRef.ProtoReflect.Descriptor instead.
+func (*FaultToleranceRule_FaultToleranceActionRef) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *FaultToleranceRule_FaultToleranceActionRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FaultToleranceRule_FaultToleranceActionRef) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+// WarmUp raises the threshold gradually from threshold / cold_factor to the threshold in the warm-up period.
+type RateLimitStrategy_WarmUp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ColdFactor         int32 `protobuf:"varint,1,opt,name=cold_factor,json=coldFactor,proto3" json:"cold_factor,omitempty"`
+	WarmUpPeriodMillis int64 `protobuf:"varint,2,opt,name=warm_up_period_millis,json=warmUpPeriodMillis,proto3" json:"warm_up_period_millis,omitempty"`
+}
+
+func (x *RateLimitStrategy_WarmUp) Reset() {
+	*x = RateLimitStrategy_WarmUp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimitStrategy_WarmUp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitStrategy_WarmUp) ProtoMessage() {}
+
+func (x *RateLimitStrategy_WarmUp) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitStrategy_WarmUp.ProtoReflect.Descriptor instead.
+func (*RateLimitStrategy_WarmUp) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *RateLimitStrategy_WarmUp) GetColdFactor() int32 {
+	if x != nil {
+		return x.ColdFactor
+	}
+	return 0
+}
+
+func (x *RateLimitStrategy_WarmUp) GetWarmUpPeriodMillis() int64 {
+	if x != nil {
+		return x.WarmUpPeriodMillis
+	}
+	return 0
+}
+
+// Queueing lets the requests pass at uniform intervals, and the requests waiting longer than
+// max_queueing_time_millis are rejected.
+type RateLimitStrategy_Queueing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxQueueingTimeMillis int64 `protobuf:"varint,1,opt,name=max_queueing_time_millis,json=maxQueueingTimeMillis,proto3" json:"max_queueing_time_millis,omitempty"`
+}
+
+func (x *RateLimitStrategy_Queueing) Reset() {
+	*x = RateLimitStrategy_Queueing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimitStrategy_Queueing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitStrategy_Queueing) ProtoMessage() {}
+
+func (x *RateLimitStrategy_Queueing) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitStrategy_Queueing.ProtoReflect.Descriptor instead.
+func (*RateLimitStrategy_Queueing) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *RateLimitStrategy_Queueing) GetMaxQueueingTimeMillis() int64 {
+	if x != nil {
+		return x.MaxQueueingTimeMillis
+	}
+	return 0
+}
+
+// ParamException overrides the threshold of a specific value of the parameter.
+type ParamFlowStrategy_ParamException struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value     string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Threshold int64  `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *ParamFlowStrategy_ParamException) Reset() {
+	*x = ParamFlowStrategy_ParamException{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParamFlowStrategy_ParamException) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParamFlowStrategy_ParamException) ProtoMessage() {}
+
+func (x *ParamFlowStrategy_ParamException) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParamFlowStrategy_ParamException.ProtoReflect.Descriptor instead.
+func (*ParamFlowStrategy_ParamException) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *ParamFlowStrategy_ParamException) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ParamFlowStrategy_ParamException) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// AdaptiveLimit asks the SDKs to discover the max concurrency dynamically from the latency,
+// which is kept between min_concurrency and max_concurrency.
+type ConcurrencyLimitStrategy_AdaptiveLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Algorithm      ConcurrencyLimitStrategy_AdaptiveAlgorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy_AdaptiveAlgorithm" json:"algorithm,omitempty"`
+	MinConcurrency int64                                      `protobuf:"varint,2,opt,name=min_concurrency,json=minConcurrency,proto3" json:"min_concurrency,omitempty"`
+	MaxConcurrency int64                                      `protobuf:"varint,3,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	// The factor of the exponential smoothing of the limit, in (0, 1].
+	Smoothing float64 `protobuf:"fixed64,4,opt,name=smoothing,proto3" json:"smoothing,omitempty"`
+	// The interval to probe for the latency without load.
+	ProbeIntervalMillis int64 `protobuf:"varint,5,opt,name=probe_interval_millis,json=probeIntervalMillis,proto3" json:"probe_interval_millis,omitempty"`
+}
+
+func (x *ConcurrencyLimitStrategy_AdaptiveLimit) Reset() {
+	*x = ConcurrencyLimitStrategy_AdaptiveLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConcurrencyLimitStrategy_AdaptiveLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConcurrencyLimitStrategy_AdaptiveLimit) ProtoMessage() {}
+
+func (x *ConcurrencyLimitStrategy_AdaptiveLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConcurrencyLimitStrategy_AdaptiveLimit.ProtoReflect.Descriptor instead.
+func (*ConcurrencyLimitStrategy_AdaptiveLimit) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *ConcurrencyLimitStrategy_AdaptiveLimit) GetAlgorithm() ConcurrencyLimitStrategy_AdaptiveAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ConcurrencyLimitStrategy_ALGORITHM_UNKNOWN
+}
+
+func (x *ConcurrencyLimitStrategy_AdaptiveLimit) GetMinConcurrency() int64 {
+	if x != nil {
+		return x.MinConcurrency
+	}
+	return 0
+}
+
+func (x *ConcurrencyLimitStrategy_AdaptiveLimit) GetMaxConcurrency() int64 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *ConcurrencyLimitStrategy_AdaptiveLimit) GetSmoothing() float64 {
+	if x != nil {
+		return x.Smoothing
+	}
+	return 0
+}
+
+func (x *ConcurrencyLimitStrategy_AdaptiveLimit) GetProbeIntervalMillis() int64 {
+	if x != nil {
+		return x.ProbeIntervalMillis
+	}
+	return 0
+}
+
+type CircuitBreakerStrategy_CircuitBreakerSlowCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxAllowedRtMillis int32 `protobuf:"varint,1,opt,name=max_allowed_rt_millis,json=maxAllowedRtMillis,proto3" json:"max_allowed_rt_millis,omitempty"`
+}
+
+func (x *CircuitBreakerStrategy_CircuitBreakerSlowCondition) Reset() {
+	*x = CircuitBreakerStrategy_CircuitBreakerSlowCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreakerStrategy_CircuitBreakerSlowCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreakerStrategy_CircuitBreakerSlowCondition) ProtoMessage() {}
+
+func (x *CircuitBreakerStrategy_CircuitBreakerSlowCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitBreakerStrategy_CircuitBreakerSlowCondition.ProtoReflect.Descriptor instead.
+func (*CircuitBreakerStrategy_CircuitBreakerSlowCondition) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *CircuitBreakerStrategy_CircuitBreakerSlowCondition) GetMaxAllowedRtMillis() int32 {
+	if x != nil {
+		return x.MaxAllowedRtMillis
+	}
+	return 0
+}
+
+// CircuitBreakerErrorCondition describes which completed requests are counted as errors.
+// The conditions are ORed, and all errors are counted if no condition is set.
+type CircuitBreakerStrategy_CircuitBreakerErrorCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HttpStatusCodes []*CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange `protobuf:"bytes,1,rep,name=http_status_codes,json=httpStatusCodes,proto3" json:"http_status_codes,omitempty"`
+	// The canonical gRPC status codes, e.g. 14 for UNAVAILABLE.
+	GrpcCodes []int32 `protobuf:"varint,2,rep,packed,name=grpc_codes,json=grpcCodes,proto3" json:"grpc_codes,omitempty"`
+	// The names of the exception classes or error types, which are matched by the SDKs of each language.
+	ErrorTypes []string `protobuf:"bytes,3,rep,name=error_types,json=errorTypes,proto3" json:"error_types,omitempty"`
+}
+
+func (x *CircuitBreakerStrategy_CircuitBreakerErrorCondition) Reset() {
+	*x = CircuitBreakerStrategy_CircuitBreakerErrorCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreakerStrategy_CircuitBreakerErrorCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreakerStrategy_CircuitBreakerErrorCondition) ProtoMessage() {}
+
+func (x *CircuitBreakerStrategy_CircuitBreakerErrorCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitBreakerStrategy_CircuitBreakerErrorCondition.ProtoReflect.Descriptor instead.
+func (*CircuitBreakerStrategy_CircuitBreakerErrorCondition) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *CircuitBreakerStrategy_CircuitBreakerErrorCondition) GetHttpStatusCodes() []*CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange {
+	if x != nil {
+		return x.HttpStatusCodes
+	}
+	return nil
+}
+
+func (x *CircuitBreakerStrategy_CircuitBreakerErrorCondition) GetGrpcCodes() []int32 {
+	if x != nil {
+		return x.GrpcCodes
+	}
+	return nil
+}
+
+func (x *CircuitBreakerStrategy_CircuitBreakerErrorCondition) GetErrorTypes() []string {
+	if x != nil {
+		return x.ErrorTypes
+	}
+	return nil
+}
+
+type CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min int32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max int32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange) Reset() {
+	*x = CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange) ProtoMessage() {}
+
+func (x *CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange.ProtoReflect.Descriptor instead.
+func (*CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{6, 1, 0}
+}
+
+func (x *CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange) GetMin() int32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// RetryBackoff is the exponential backoff between the attempts.
+type RetryStrategy_RetryBackoff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseIntervalMillis int64 `protobuf:"varint,1,opt,name=base_interval_millis,json=baseIntervalMillis,proto3" json:"base_interval_millis,omitempty"`
+	// The upper bound of the interval, 0 means no bound.
+	MaxIntervalMillis int64   `protobuf:"varint,2,opt,name=max_interval_millis,json=maxIntervalMillis,proto3" json:"max_interval_millis,omitempty"`
+	Multiplier        float64 `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (x *RetryStrategy_RetryBackoff) Reset() {
+	*x = RetryStrategy_RetryBackoff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryStrategy_RetryBackoff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryStrategy_RetryBackoff) ProtoMessage() {}
+
+func (x *RetryStrategy_RetryBackoff) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryStrategy_RetryBackoff.ProtoReflect.Descriptor instead.
+func (*RetryStrategy_RetryBackoff) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *RetryStrategy_RetryBackoff) GetBaseIntervalMillis() int64 {
+	if x != nil {
+		return x.BaseIntervalMillis
+	}
+	return 0
+}
+
+func (x *RetryStrategy_RetryBackoff) GetMaxIntervalMillis() int64 {
+	if x != nil {
+		return x.MaxIntervalMillis
+	}
+	return 0
+}
+
+func (x *RetryStrategy_RetryBackoff) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+// RetryCondition describes which failed requests are retried. The conditions are ORed.
+type RetryStrategy_RetryCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HttpStatusCodes []*RetryStrategy_RetryCondition_HttpStatusCodeRange `protobuf:"bytes,1,rep,name=http_status_codes,json=httpStatusCodes,proto3" json:"http_status_codes,omitempty"`
+	// The canonical gRPC status codes, e.g. 14 for UNAVAILABLE.
+	GrpcCodes []int32 `protobuf:"varint,2,rep,packed,name=grpc_codes,json=grpcCodes,proto3" json:"grpc_codes,omitempty"`
+	// The names of the exception classes or error types, which are matched by the SDKs of each language.
+	ErrorTypes []string `protobuf:"bytes,3,rep,name=error_types,json=errorTypes,proto3" json:"error_types,omitempty"`
+	// Whether the attempts which time out are retried.
+	OnTimeout bool `protobuf:"varint,4,opt,name=on_timeout,json=onTimeout,proto3" json:"on_timeout,omitempty"`
+}
+
+func (x *RetryStrategy_RetryCondition) Reset() {
+	*x = RetryStrategy_RetryCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryStrategy_RetryCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryStrategy_RetryCondition) ProtoMessage() {}
+
+func (x *RetryStrategy_RetryCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryStrategy_RetryCondition.ProtoReflect.Descriptor instead.
+func (*RetryStrategy_RetryCondition) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *RetryStrategy_RetryCondition) GetHttpStatusCodes() []*RetryStrategy_RetryCondition_HttpStatusCodeRange {
+	if x != nil {
+		return x.HttpStatusCodes
+	}
+	return nil
+}
+
+func (x *RetryStrategy_RetryCondition) GetGrpcCodes() []int32 {
+	if x != nil {
+		return x.GrpcCodes
+	}
+	return nil
+}
+
+func (x *RetryStrategy_RetryCondition) GetErrorTypes() []string {
+	if x != nil {
+		return x.ErrorTypes
+	}
+	return nil
+}
+
+func (x *RetryStrategy_RetryCondition) GetOnTimeout() bool {
+	if x != nil {
+		return x.OnTimeout
+	}
+	return false
+}
+
+// RetryBudget limits the retries to a ratio of the requests, so that retries never amplify an outage.
+type RetryStrategy_RetryBudget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ratio float64 `protobuf:"fixed64,1,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	// The retries which are always allowed per second regardless of the ratio.
+	MinRetriesPerSecond int32 `protobuf:"varint,2,opt,name=min_retries_per_second,json=minRetriesPerSecond,proto3" json:"min_retries_per_second,omitempty"`
+}
+
+func (x *RetryStrategy_RetryBudget) Reset() {
+	*x = RetryStrategy_RetryBudget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryStrategy_RetryBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryStrategy_RetryBudget) ProtoMessage() {}
+
+func (x *RetryStrategy_RetryBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryStrategy_RetryBudget.ProtoReflect.Descriptor instead.
+func (*RetryStrategy_RetryBudget) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{7, 2}
+}
+
+func (x *RetryStrategy_RetryBudget) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+func (x *RetryStrategy_RetryBudget) GetMinRetriesPerSecond() int32 {
+	if x != nil {
+		return x.MinRetriesPerSecond
+	}
+	return 0
+}
+
+type RetryStrategy_RetryCondition_HttpStatusCodeRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min int32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max int32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *RetryStrategy_RetryCondition_HttpStatusCodeRange) Reset() {
+	*x = RetryStrategy_RetryCondition_HttpStatusCodeRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryStrategy_RetryCondition_HttpStatusCodeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryStrategy_RetryCondition_HttpStatusCodeRange) ProtoMessage() {}
+
+func (x *RetryStrategy_RetryCondition_HttpStatusCodeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryStrategy_RetryCondition_HttpStatusCodeRange.ProtoReflect.Descriptor instead.
+func (*RetryStrategy_RetryCondition_HttpStatusCodeRange) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{7, 1, 0}
+}
+
+func (x *RetryStrategy_RetryCondition_HttpStatusCodeRange) GetMin() int32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *RetryStrategy_RetryCondition_HttpStatusCodeRange) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type FallbackAction_HttpResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32             `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Headers    map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Body       string            `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *FallbackAction_HttpResponse) Reset() {
+	*x = FallbackAction_HttpResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FallbackAction_HttpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FallbackAction_HttpResponse) ProtoMessage() {}
+
+func (x *FallbackAction_HttpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FallbackAction_HttpResponse.ProtoReflect.Descriptor instead.
+func (*FallbackAction_HttpResponse) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *FallbackAction_HttpResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *FallbackAction_HttpResponse) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *FallbackAction_HttpResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type FallbackAction_GrpcResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The canonical gRPC status code, e.g. 14 for UNAVAILABLE.
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *FallbackAction_GrpcResponse) Reset() {
+	*x = FallbackAction_GrpcResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FallbackAction_GrpcResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FallbackAction_GrpcResponse) ProtoMessage() {}
+
+func (x *FallbackAction_GrpcResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FallbackAction_GrpcResponse.ProtoReflect.Descriptor instead.
+func (*FallbackAction_GrpcResponse) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *FallbackAction_GrpcResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *FallbackAction_GrpcResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type FallbackAction_FallbackResourceRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetResourceName string `protobuf:"bytes,1,opt,name=target_resource_name,json=targetResourceName,proto3" json:"target_resource_name,omitempty"`
+}
+
+func (x *FallbackAction_FallbackResourceRef) Reset() {
+	*x = FallbackAction_FallbackResourceRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fault_tolerance_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FallbackAction_FallbackResourceRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FallbackAction_FallbackResourceRef) ProtoMessage() {}
+
+func (x *FallbackAction_FallbackResourceRef) ProtoReflect() protoreflect.Message {
+	mi := &file_fault_tolerance_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FallbackAction_FallbackResourceRef.ProtoReflect.Descriptor instead.
+func (*FallbackAction_FallbackResourceRef) Descriptor() ([]byte, []int) {
+	return file_fault_tolerance_proto_rawDescGZIP(), []int{10, 2}
+}
+
+func (x *FallbackAction_FallbackResourceRef) GetTargetResourceName() string {
+	if x != nil {
+		return x.TargetResourceName
+	}
+	return ""
+}
+
+var File_fault_tolerance_proto protoreflect.FileDescriptor
+
+var file_fault_tolerance_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x25, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x16,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x51, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x65, 0x67, 0x65, 0x78, 0x22, 0xd5, 0x0c, 0x0a, 0x12, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54,
+	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x6f, 0x0a, 0x07,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x55, 0x2e,
+	0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f,
+	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x52, 0x65, 0x66, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x73, 0x0a,
+	0x0a, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x53, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c,
+	0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54,
+	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x46, 0x61, 0x75,
+	0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x52, 0x65, 0x66, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69,
+	0x65, 0x73, 0x12, 0x69, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x51, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f,
+	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74,
+	0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xe6, 0x05,
+	0x0a, 0x18, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x03, 0x61, 0x70,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x03, 0x61, 0x70, 0x70,
+	0x12, 0x50, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74,
+	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f,
+	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x79,
+	0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x5f, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x6c,
+	0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74,
+	0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x0c, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x63, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65,
+	0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f,
+	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x46, 0x61, 0x75, 0x6c,
+	0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x1a, 0x6e, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74,
+	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x72, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xbd, 0x02, 0x0a, 0x1b, 0x46, 0x61, 0x75, 0x6c, 0x74,
+	0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x52, 0x65, 0x66, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x6c, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x52, 0x2e, 0x69, 0x6f, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x62, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x43, 0x2e, 0x69, 0x6f, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x43, 0x0a, 0x19, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54,
+	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x1a, 0x41, 0x0a, 0x17, 0x46,
+	0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x3d,
+	0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x4d,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x02, 0x22, 0xfd, 0x08,
+	0x0a, 0x11, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x64, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x43, 0x2e, 0x69,
+	0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x61, 0x0a,
+	0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x42, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c,
+	0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x25, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x17, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x14,
+	0x73, 0x74, 0x61, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x55, 0x6e, 0x69, 0x74, 0x12, 0x73, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f,
+	0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x48,
+	0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61,
+	0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x58, 0x0a, 0x07, 0x77, 0x61, 0x72,
+	0x6d, 0x5f, 0x75, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x2e, 0x57, 0x61, 0x72, 0x6d, 0x55, 0x70, 0x52, 0x06, 0x77, 0x61, 0x72,
+	0x6d, 0x55, 0x70, 0x12, 0x5d, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x69, 0x6e, 0x67, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73,
+	0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x69,
+	0x6e, 0x67, 0x1a, 0x6e, 0x0a, 0x06, 0x57, 0x61, 0x72, 0x6d, 0x55, 0x70, 0x12, 0x28, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6c, 0x64, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x64,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x15, 0x77, 0x61, 0x72, 0x6d, 0x5f, 0x75,
+	0x70, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x12,
+	0x77, 0x61, 0x72, 0x6d, 0x55, 0x70, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x1a, 0x4c, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x75, 0x65, 0x69, 0x6e, 0x67, 0x12, 0x40,
+	0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x22, 0x37, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10,
+	0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x17, 0x0a, 0x13, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x22, 0x3e, 0x0a, 0x09, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x02, 0x22, 0x72, 0x0a, 0x0f, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x13, 0x0a, 0x0f,
+	0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10,
+	0x00, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x57, 0x41,
+	0x52, 0x4d, 0x5f, 0x55, 0x50, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x42, 0x45, 0x48, 0x41, 0x56,
+	0x49, 0x4f, 0x52, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1d,
+	0x0a, 0x19, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x57, 0x41, 0x52, 0x4d, 0x5f,
+	0x55, 0x50, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x22, 0xca, 0x06,
+	0x0a, 0x11, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x67, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x44, 0x2e,
+	0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x46, 0x6c, 0x6f, 0x77, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a,
+	0x0b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x61, 0x0a, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x42, 0x2e, 0x69, 0x6f,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x09, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x2c, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20,
+	0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x5d, 0x0a, 0x17, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x14, 0x73, 0x74, 0x61, 0x74, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x67,
+	0x0a, 0x0a, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x47, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f,
+	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x46, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x4d, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x25, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x62, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x52,
+	0x41, 0x4d, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41,
+	0x52, 0x47, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x03, 0x22, 0x3e, 0x0a, 0x09, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x02, 0x22, 0xa0, 0x01, 0x0a, 0x12, 0x54,
+	0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x1f, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x5f, 0x6f, 0x66, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1b,
+	0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x4f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x22, 0xa0, 0x06,
+	0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x68,
+	0x0a, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x49, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f,
+	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x69, 0x0a, 0x08, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74,
+	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x52, 0x08, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x1a, 0xd8, 0x02, 0x0a,
+	0x0d, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x6f,
+	0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x51, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c,
+	0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12,
+	0x30, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20,
+	0x00, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x30, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
+	0x02, 0x20, 0x00, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x6d, 0x6f, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52,
+	0x09, 0x73, 0x6d, 0x6f, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x15, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x22, 0x3e, 0x0a, 0x09, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4c,
+	0x4f, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x47,
+	0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x02, 0x22, 0x57, 0x0a, 0x11, 0x41, 0x64, 0x61, 0x70, 0x74,
+	0x69, 0x76, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x15, 0x0a, 0x11,
+	0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d,
+	0x5f, 0x47, 0x52, 0x41, 0x44, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41,
+	0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x56, 0x45, 0x47, 0x41, 0x53, 0x10, 0x02,
+	0x22, 0xa7, 0x0b, 0x0a, 0x16, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1c, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
+	0x28, 0x80, 0x08, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x62, 0x0a, 0x08, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x46, 0x2e, 0x69, 0x6f,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x3c, 0x0a,
+	0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x29, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x52, 0x0c, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x2c, 0x0a, 0x0d, 0x73,
+	0x74, 0x61, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61,
+	0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x17, 0x73, 0x74, 0x61,
+	0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x75, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x69, 0x6f, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e,
+	0x69, 0x74, 0x52, 0x14, 0x73, 0x74, 0x61, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x63, 0x0a, 0x1a,
+	0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x17, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69,
+	0x74, 0x12, 0x35, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x0e, 0x73, 0x6c, 0x6f,
+	0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x59, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c,
+	0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x53,
+	0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6c,
+	0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x83, 0x01, 0x0a, 0x0f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x5a, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73,
+	0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2c, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28,
+	0x00, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
+	0x50, 0x0a, 0x1b, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x53, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31,
+	0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x72, 0x74,
+	0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6d,
+	0x61, 0x78, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x1a, 0xde, 0x02, 0x0a, 0x1c, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x9a, 0x01, 0x0a, 0x11, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x6e,
+	0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61,
+	0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x43, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0f,
+	0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x2d, 0x0a, 0x0a, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x05, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x92, 0x01, 0x08, 0x22, 0x06, 0x1a, 0x04, 0x18,
+	0x10, 0x28, 0x00, 0x52, 0x09, 0x67, 0x72, 0x70, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x1a,
+	0x51, 0x0a, 0x13, 0x48, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x28, 0x64, 0x18, 0xd7, 0x04, 0x52,
+	0x03, 0x6d, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x28, 0x64, 0x18, 0xd7, 0x04, 0x52, 0x03, 0x6d,
+	0x61, 0x78, 0x22, 0x85, 0x01, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
+	0x14, 0x0a, 0x10, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47,
+	0x59, 0x5f, 0x53, 0x4c, 0x4f, 0x57, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45,
+	0x47, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x5f, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x52, 0x41,
+	0x54, 0x45, 0x47, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45,
+	0x53, 0x54, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x03, 0x22, 0xb0, 0x08, 0x0a, 0x0d, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x16,
+	0x70, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
+	0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x13, 0x70, 0x65, 0x72, 0x54, 0x72, 0x79, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x5b, 0x0a, 0x07, 0x62, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x69, 0x6f,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x52, 0x07,
+	0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x5e, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x5f, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x69, 0x6f, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x12, 0x58, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x1a, 0xb2, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x6f,
+	0x66, 0x66, 0x12, 0x39, 0x0a, 0x14, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x12, 0x62, 0x61, 0x73, 0x65, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x37, 0x0a,
+	0x13, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
+	0x02, 0x28, 0x00, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12,
+	0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x1a, 0xd8, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x83, 0x01, 0x0a, 0x11, 0x68, 0x74,
+	0x74, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x57, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73,
+	0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x2e, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0f,
+	0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x2d, 0x0a, 0x0a, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x05, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x92, 0x01, 0x08, 0x22, 0x06, 0x1a, 0x04, 0x28,
+	0x00, 0x18, 0x10, 0x52, 0x09, 0x67, 0x72, 0x70, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x51,
+	0x0a, 0x13, 0x48, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xd7, 0x04, 0x28, 0x64, 0x52, 0x03,
+	0x6d, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xd7, 0x04, 0x28, 0x64, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x1a, 0x7a, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x12, 0x2d, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x19,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x52, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x12,
+	0x3c, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x13, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0x55, 0x0a,
+	0x0f, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
+	0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x22, 0xb8, 0x02, 0x0a, 0x16, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x41,
+	0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12,
+	0x12, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0xf0, 0x3f, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x43, 0x70, 0x75, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x36, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09,
+	0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x32, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x76, 0x67, 0x5f, 0x72, 0x74, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0e, 0x6d, 0x61,
+	0x78, 0x41, 0x76, 0x67, 0x52, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x2f, 0x0a, 0x0f,
+	0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x71, 0x70, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0d,
+	0x6d, 0x61, 0x78, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x70, 0x73, 0x12, 0x30, 0x0a,
+	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52,
+	0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22,
+	0xf9, 0x05, 0x0a, 0x0e, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x67, 0x0a, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e,
+	0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x67, 0x0a, 0x0d, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x72,
+	0x70, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x67, 0x72, 0x70, 0x63,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x11, 0x66, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74,
+	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x10,
+	0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x1a, 0xf6, 0x01, 0x0a, 0x0c, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x28, 0x64, 0x18,
+	0xd7, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x69,
+	0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x4f, 0x2e, 0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x0c, 0x47, 0x72, 0x70,
+	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x18, 0x10,
+	0x28, 0x00, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x1a, 0x47, 0x0a, 0x13, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x85, 0x01, 0x0a, 0x25,
+	0x69, 0x6f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x13, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x6f, 0x6c, 0x65,
+	0x72, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x45, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72,
+	0x67, 0x6f, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2d, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fault_tolerance_proto_rawDescOnce sync.Once
+	file_fault_tolerance_proto_rawDescData = file_fault_tolerance_proto_rawDesc
+)
+
+func file_fault_tolerance_proto_rawDescGZIP() []byte {
+	file_fault_tolerance_proto_rawDescOnce.Do(func() {
+		file_fault_tolerance_proto_rawDescData = protoimpl.X.CompressGZIP(file_fault_tolerance_proto_rawDescData)
+	})
+	return file_fault_tolerance_proto_rawDescData
+}
+
+var file_fault_tolerance_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_fault_tolerance_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_fault_tolerance_proto_goTypes = []interface{}{
+	(FaultToleranceRule_MatchType)(0),                      // 0: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.MatchType
+	(RateLimitStrategy_MetricType)(0),                      // 1: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.MetricType
+	(RateLimitStrategy_LimitMode)(0),                       // 2: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.LimitMode
+	(RateLimitStrategy_ControlBehavior)(0),                 // 3: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.ControlBehavior
+	(ParamFlowStrategy_ParamSource)(0),                     // 4: io.opensergo.proto.fault_tolerance.v1.ParamFlowStrategy.ParamSource
+	(ParamFlowStrategy_LimitMode)(0),                       // 5: io.opensergo.proto.fault_tolerance.v1.ParamFlowStrategy.LimitMode
+	(ConcurrencyLimitStrategy_LimitMode)(0),                // 6: io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy.LimitMode
+	(ConcurrencyLimitStrategy_AdaptiveAlgorithm)(0),        // 7: io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy.AdaptiveAlgorithm
+	(CircuitBreakerStrategy_Strategy)(0),                   // 8: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.Strategy
+	(*StringMatch)(nil),                                    // 9: io.opensergo.proto.fault_tolerance.v1.StringMatch
+	(*FaultToleranceRule)(nil),                             // 10: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule
+	(*RateLimitStrategy)(nil),                              // 11: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy
+	(*ParamFlowStrategy)(nil),                              // 12: io.opensergo.proto.fault_tolerance.v1.ParamFlowStrategy
+	(*ThrottlingStrategy)(nil),                             // 13: io.opensergo.proto.fault_tolerance.v1.ThrottlingStrategy
+	(*ConcurrencyLimitStrategy)(nil),                       // 14: io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy
+	(*CircuitBreakerStrategy)(nil),                         // 15: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy
+	(*RetryStrategy)(nil),                                  // 16: io.opensergo.proto.fault_tolerance.v1.RetryStrategy
+	(*TimeoutStrategy)(nil),                                // 17: io.opensergo.proto.fault_tolerance.v1.TimeoutStrategy
+	(*SystemAdaptiveStrategy)(nil),                         // 18: io.opensergo.proto.fault_tolerance.v1.SystemAdaptiveStrategy
+	(*FallbackAction)(nil),                                 // 19: io.opensergo.proto.fault_tolerance.v1.FallbackAction
+	(*FaultToleranceRule_FaultToleranceRuleSource)(nil),    // 20: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleSource
+	(*FaultToleranceRule_FaultToleranceRuleTargetRef)(nil), // 21: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleTargetRef
+	(*FaultToleranceRule_FaultToleranceStrategyRef)(nil),   // 22: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceStrategyRef
+	(*FaultToleranceRule_FaultToleranceActionRef)(nil),     // 23: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceActionRef
+	nil,                                      // 24: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleSource.HeadersEntry
+	nil,                                      // 25: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleSource.QueryParamsEntry
+	(*RateLimitStrategy_WarmUp)(nil),         // 26: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.WarmUp
+	(*RateLimitStrategy_Queueing)(nil),       // 27: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.Queueing
+	(*ParamFlowStrategy_ParamException)(nil), // 28: io.opensergo.proto.fault_tolerance.v1.ParamFlowStrategy.ParamException
+	(*ConcurrencyLimitStrategy_AdaptiveLimit)(nil),                                  // 29: io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy.AdaptiveLimit
+	(*CircuitBreakerStrategy_CircuitBreakerSlowCondition)(nil),                      // 30: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.CircuitBreakerSlowCondition
+	(*CircuitBreakerStrategy_CircuitBreakerErrorCondition)(nil),                     // 31: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.CircuitBreakerErrorCondition
+	(*CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange)(nil), // 32: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.CircuitBreakerErrorCondition.HttpStatusCodeRange
+	(*RetryStrategy_RetryBackoff)(nil),                                              // 33: io.opensergo.proto.fault_tolerance.v1.RetryStrategy.RetryBackoff
+	(*RetryStrategy_RetryCondition)(nil),                                            // 34: io.opensergo.proto.fault_tolerance.v1.RetryStrategy.RetryCondition
+	(*RetryStrategy_RetryBudget)(nil),                                               // 35: io.opensergo.proto.fault_tolerance.v1.RetryStrategy.RetryBudget
+	(*RetryStrategy_RetryCondition_HttpStatusCodeRange)(nil),                        // 36: io.opensergo.proto.fault_tolerance.v1.RetryStrategy.RetryCondition.HttpStatusCodeRange
+	(*FallbackAction_HttpResponse)(nil),                                             // 37: io.opensergo.proto.fault_tolerance.v1.FallbackAction.HttpResponse
+	(*FallbackAction_GrpcResponse)(nil),                                             // 38: io.opensergo.proto.fault_tolerance.v1.FallbackAction.GrpcResponse
+	(*FallbackAction_FallbackResourceRef)(nil),                                      // 39: io.opensergo.proto.fault_tolerance.v1.FallbackAction.FallbackResourceRef
+	nil,              // 40: io.opensergo.proto.fault_tolerance.v1.FallbackAction.HttpResponse.HeadersEntry
+	(v1.TimeUnit)(0), // 41: io.opensergo.proto.common.v1.TimeUnit
+}
+var file_fault_tolerance_proto_depIdxs = []int32{
+	21, // 0: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.targets:type_name -> io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleTargetRef
+	22, // 1: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.strategies:type_name -> io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceStrategyRef
+	23, // 2: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.action:type_name -> io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceActionRef
+	1,  // 3: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.metric_type:type_name -> io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.MetricType
+	2,  // 4: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.limit_mode:type_name -> io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.LimitMode
+	41, // 5: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.stat_duration_time_unit:type_name -> io.opensergo.proto.common.v1.TimeUnit
+	3,  // 6: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.control_behavior:type_name -> io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.ControlBehavior
+	26, // 7: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.warm_up:type_name -> io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.WarmUp
+	27, // 8: io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.queueing:type_name -> io.opensergo.proto.fault_tolerance.v1.RateLimitStrategy.Queueing
+	4,  // 9: io.opensergo.proto.fault_tolerance.v1.ParamFlowStrategy.param_source:type_name -> io.opensergo.proto.fault_tolerance.v1.ParamFlowStrategy.ParamSource
+	5,  // 10: io.opensergo.proto.fault_tolerance.v1.ParamFlowStrategy.limit_mode:type_name -> io.opensergo.proto.fault_tolerance.v1.ParamFlowStrategy.LimitMode
+	41, // 11: io.opensergo.proto.fault_tolerance.v1.ParamFlowStrategy.stat_duration_time_unit:type_name -> io.opensergo.proto.common.v1.TimeUnit
+	28, // 12: io.opensergo.proto.fault_tolerance.v1.ParamFlowStrategy.exceptions:type_name -> io.opensergo.proto.fault_tolerance.v1.ParamFlowStrategy.ParamException
+	6,  // 13: io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy.limit_mode:type_name -> io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy.LimitMode
+	29, // 14: io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy.adaptive:type_name -> io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy.AdaptiveLimit
+	8,  // 15: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.strategy:type_name -> io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.Strategy
+	41, // 16: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.stat_duration_time_unit:type_name -> io.opensergo.proto.common.v1.TimeUnit
+	41, // 17: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.recovery_timeout_time_unit:type_name -> io.opensergo.proto.common.v1.TimeUnit
+	30, // 18: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.slow_condition:type_name -> io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.CircuitBreakerSlowCondition
+	31, // 19: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.error_condition:type_name -> io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.CircuitBreakerErrorCondition
+	33, // 20: io.opensergo.proto.fault_tolerance.v1.RetryStrategy.backoff:type_name -> io.opensergo.proto.fault_tolerance.v1.RetryStrategy.RetryBackoff
+	34, // 21: io.opensergo.proto.fault_tolerance.v1.RetryStrategy.retry_on:type_name -> io.opensergo.proto.fault_tolerance.v1.RetryStrategy.RetryCondition
+	35, // 22: io.opensergo.proto.fault_tolerance.v1.RetryStrategy.budget:type_name -> io.opensergo.proto.fault_tolerance.v1.RetryStrategy.RetryBudget
+	37, // 23: io.opensergo.proto.fault_tolerance.v1.FallbackAction.http_response:type_name -> io.opensergo.proto.fault_tolerance.v1.FallbackAction.HttpResponse
+	38, // 24: io.opensergo.proto.fault_tolerance.v1.FallbackAction.grpc_response:type_name -> io.opensergo.proto.fault_tolerance.v1.FallbackAction.GrpcResponse
+	39, // 25: io.opensergo.proto.fault_tolerance.v1.FallbackAction.fallback_resource:type_name -> io.opensergo.proto.fault_tolerance.v1.FallbackAction.FallbackResourceRef
+	9,  // 26: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleSource.app:type_name -> io.opensergo.proto.fault_tolerance.v1.StringMatch
+	9,  // 27: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleSource.namespace:type_name -> io.opensergo.proto.fault_tolerance.v1.StringMatch
+	9,  // 28: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleSource.method:type_name -> io.opensergo.proto.fault_tolerance.v1.StringMatch
+	24, // 29: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleSource.headers:type_name -> io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleSource.HeadersEntry
+	25, // 30: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleSource.query_params:type_name -> io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleSource.QueryParamsEntry
+	20, // 31: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleTargetRef.sources:type_name -> io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleSource
+	0,  // 32: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleTargetRef.match_type:type_name -> io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.MatchType
+	9,  // 33: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleSource.HeadersEntry.value:type_name -> io.opensergo.proto.fault_tolerance.v1.StringMatch
+	9,  // 34: io.opensergo.proto.fault_tolerance.v1.FaultToleranceRule.FaultToleranceRuleSource.QueryParamsEntry.value:type_name -> io.opensergo.proto.fault_tolerance.v1.StringMatch
+	7,  // 35: io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy.AdaptiveLimit.algorithm:type_name -> io.opensergo.proto.fault_tolerance.v1.ConcurrencyLimitStrategy.AdaptiveAlgorithm
+	32, // 36: io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.CircuitBreakerErrorCondition.http_status_codes:type_name -> io.opensergo.proto.fault_tolerance.v1.CircuitBreakerStrategy.CircuitBreakerErrorCondition.HttpStatusCodeRange
+	36, // 37: io.opensergo.proto.fault_tolerance.v1.RetryStrategy.RetryCondition.http_status_codes:type_name -> io.opensergo.proto.fault_tolerance.v1.RetryStrategy.RetryCondition.HttpStatusCodeRange
+	40, // 38: io.opensergo.proto.fault_tolerance.v1.FallbackAction.HttpResponse.headers:type_name -> io.opensergo.proto.fault_tolerance.v1.FallbackAction.HttpResponse.HeadersEntry
+	39, // [39:39] is the sub-list for method output_type
+	39, // [39:39] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
+}
+
+func init() { file_fault_tolerance_proto_init() }
+func file_fault_tolerance_proto_init() {
+	if File_fault_tolerance_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_fault_tolerance_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaultToleranceRule); i {
+			case 0:
+				return &v.state
 			case 1:
 				return &v.sizeCache
 			case 2:
@@ -1125,7 +3209,7 @@ func file_fault_tolerance_v1_fault_tolerance_proto_init() {
 				return nil
 			}
 		}
-		file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_fault_tolerance_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RateLimitStrategy); i {
 			case 0:
 				return &v.state
@@ -1137,7 +3221,19 @@ func file_fault_tolerance_v1_fault_tolerance_proto_init() {
 				return nil
 			}
 		}
-		file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_fault_tolerance_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParamFlowStrategy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ThrottlingStrategy); i {
 			case 0:
 				return &v.state
@@ -1149,7 +3245,7 @@ func file_fault_tolerance_v1_fault_tolerance_proto_init() {
 				return nil
 			}
 		}
-		file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_fault_tolerance_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConcurrencyLimitStrategy); i {
 			case 0:
 				return &v.state
@@ -1161,7 +3257,7 @@ func file_fault_tolerance_v1_fault_tolerance_proto_init() {
 				return nil
 			}
 		}
-		file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_fault_tolerance_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CircuitBreakerStrategy); i {
 			case 0:
 				return &v.state
@@ -1173,7 +3269,67 @@ func file_fault_tolerance_v1_fault_tolerance_proto_init() {
 				return nil
 			}
 		}
-		file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_fault_tolerance_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryStrategy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeoutStrategy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemAdaptiveStrategy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FallbackAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaultToleranceRule_FaultToleranceRuleSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FaultToleranceRule_FaultToleranceRuleTargetRef); i {
 			case 0:
 				return &v.state
@@ -1185,7 +3341,7 @@ func file_fault_tolerance_v1_fault_tolerance_proto_init() {
 				return nil
 			}
 		}
-		file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_fault_tolerance_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FaultToleranceRule_FaultToleranceStrategyRef); i {
 			case 0:
 				return &v.state
@@ -1197,7 +3353,7 @@ func file_fault_tolerance_v1_fault_tolerance_proto_init() {
 				return nil
 			}
 		}
-		file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_fault_tolerance_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FaultToleranceRule_FaultToleranceActionRef); i {
 			case 0:
 				return &v.state
@@ -1209,7 +3365,55 @@ func file_fault_tolerance_v1_fault_tolerance_proto_init() {
 				return nil
 			}
 		}
-		file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_fault_tolerance_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimitStrategy_WarmUp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimitStrategy_Queueing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParamFlowStrategy_ParamException); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConcurrencyLimitStrategy_AdaptiveLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CircuitBreakerStrategy_CircuitBreakerSlowCondition); i {
 			case 0:
 				return &v.state
@@ -1221,7 +3425,7 @@ func file_fault_tolerance_v1_fault_tolerance_proto_init() {
 				return nil
 			}
 		}
-		file_fault_tolerance_v1_fault_tolerance_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_fault_tolerance_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CircuitBreakerStrategy_CircuitBreakerErrorCondition); i {
 			case 0:
 				return &v.state
@@ -1233,24 +3437,120 @@ func file_fault_tolerance_v1_fault_tolerance_proto_init() {
 				return nil
 			}
 		}
+		file_fault_tolerance_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitBreakerStrategy_CircuitBreakerErrorCondition_HttpStatusCodeRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryStrategy_RetryBackoff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryStrategy_RetryCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryStrategy_RetryBudget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryStrategy_RetryCondition_HttpStatusCodeRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FallbackAction_HttpResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FallbackAction_GrpcResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fault_tolerance_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FallbackAction_FallbackResourceRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_fault_tolerance_v1_fault_tolerance_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   10,
+			RawDescriptor: file_fault_tolerance_proto_rawDesc,
+			NumEnums:      9,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
-		GoTypes:           file_fault_tolerance_v1_fault_tolerance_proto_goTypes,
-		DependencyIndexes: file_fault_tolerance_v1_fault_tolerance_proto_depIdxs,
-		EnumInfos:         file_fault_tolerance_v1_fault_tolerance_proto_enumTypes,
-		MessageInfos:      file_fault_tolerance_v1_fault_tolerance_proto_msgTypes,
+		GoTypes:           file_fault_tolerance_proto_goTypes,
+		DependencyIndexes: file_fault_tolerance_proto_depIdxs,
+		EnumInfos:         file_fault_tolerance_proto_enumTypes,
+		MessageInfos:      file_fault_tolerance_proto_msgTypes,
 	}.Build()
-	File_fault_tolerance_v1_fault_tolerance_proto = out.File
-	file_fault_tolerance_v1_fault_tolerance_proto_rawDesc = nil
-	file_fault_tolerance_v1_fault_tolerance_proto_goTypes = nil
-	file_fault_tolerance_v1_fault_tolerance_proto_depIdxs = nil
+	File_fault_tolerance_proto = out.File
+	file_fault_tolerance_proto_rawDesc = nil
+	file_fault_tolerance_proto_goTypes = nil
+	file_fault_tolerance_proto_depIdxs = nil
 }
@@ -0,0 +1,242 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"sort"
+	"sync"
+	"testing"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeEtcd is an in-process etcd server which implements the KV and Watch services of the etcd v3 API
+// with the semantics the source relies on: revisions, ranges, watches from a revision and compaction.
+// The embedded etcd server (go.etcd.io/etcd/server/v3/embed) is not used to keep the etcd server and
+// its dependencies out of the module.
+type fakeEtcd struct {
+	etcdserverpb.UnimplementedKVServer
+	etcdserverpb.UnimplementedWatchServer
+
+	revision  int64
+	compacted int64
+	kvs       map[string]*mvccpb.KeyValue
+	// history consists of the events after the compacted revision.
+	history []*mvccpb.Event
+	streams map[*fakeWatchStream]bool
+
+	mux sync.Mutex
+}
+
+type fakeWatcher struct {
+	id       int64
+	key, end []byte
+}
+
+type fakeWatchStream struct {
+	watchers     map[int64]*fakeWatcher
+	nextID       int64
+	responses    chan *etcdserverpb.WatchResponse
+	disconnected chan struct{}
+}
+
+// startFakeEtcd starts the fake server and returns its endpoint.
+func startFakeEtcd(t *testing.T) (*fakeEtcd, string) {
+	t.Helper()
+	f := &fakeEtcd{
+		revision: 1,
+		kvs:      make(map[string]*mvccpb.KeyValue),
+		streams:  make(map[*fakeWatchStream]bool),
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	etcdserverpb.RegisterKVServer(server, f)
+	etcdserverpb.RegisterWatchServer(server, f)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+	return f, lis.Addr().String()
+}
+
+func (f *fakeEtcd) header() *etcdserverpb.ResponseHeader {
+	return &etcdserverpb.ResponseHeader{Revision: f.revision}
+}
+
+func inRange(key, start, end []byte) bool {
+	if len(end) == 0 {
+		return bytes.Equal(key, start)
+	}
+	// The range end \0 means all keys no less than the start.
+	return bytes.Compare(key, start) >= 0 && (bytes.Equal(end, []byte{0}) || bytes.Compare(key, end) < 0)
+}
+
+func (f *fakeEtcd) Range(_ context.Context, req *etcdserverpb.RangeRequest) (*etcdserverpb.RangeResponse, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	resp := &etcdserverpb.RangeResponse{Header: f.header()}
+	for _, kv := range f.kvs {
+		if inRange(kv.Key, req.Key, req.RangeEnd) {
+			resp.Kvs = append(resp.Kvs, kv)
+		}
+	}
+	sort.Slice(resp.Kvs, func(i, j int) bool {
+		return bytes.Compare(resp.Kvs[i].Key, resp.Kvs[j].Key) < 0
+	})
+	resp.Count = int64(len(resp.Kvs))
+	return resp, nil
+}
+
+func (f *fakeEtcd) Watch(stream etcdserverpb.Watch_WatchServer) error {
+	s := &fakeWatchStream{
+		watchers:     make(map[int64]*fakeWatcher),
+		responses:    make(chan *etcdserverpb.WatchResponse, 1024),
+		disconnected: make(chan struct{}),
+	}
+	f.mux.Lock()
+	f.streams[s] = true
+	f.mux.Unlock()
+	defer func() {
+		f.mux.Lock()
+		delete(f.streams, s)
+		f.mux.Unlock()
+	}()
+
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			f.handleWatchRequest(s, req)
+		}
+	}()
+	for {
+		select {
+		case resp := <-s.responses:
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		case err := <-recvErr:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		case <-s.disconnected:
+			return status.Error(codes.Unavailable, "the member is disconnected")
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func (f *fakeEtcd) handleWatchRequest(s *fakeWatchStream, req *etcdserverpb.WatchRequest) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	switch r := req.RequestUnion.(type) {
+	case *etcdserverpb.WatchRequest_CreateRequest:
+		create := r.CreateRequest
+		s.nextID++
+		id := s.nextID
+		s.responses <- &etcdserverpb.WatchResponse{Header: f.header(), WatchId: id, Created: true}
+		if create.StartRevision > 0 && create.StartRevision <= f.compacted {
+			s.responses <- &etcdserverpb.WatchResponse{Header: f.header(), WatchId: id, CompactRevision: f.compacted, Canceled: true}
+			return
+		}
+		w := &fakeWatcher{id: id, key: create.Key, end: create.RangeEnd}
+		s.watchers[id] = w
+		for _, event := range f.history {
+			if create.StartRevision > 0 && event.Kv.ModRevision >= create.StartRevision && inRange(event.Kv.Key, w.key, w.end) {
+				s.responses <- &etcdserverpb.WatchResponse{Header: f.header(), WatchId: id, Events: []*mvccpb.Event{event}}
+			}
+		}
+	case *etcdserverpb.WatchRequest_CancelRequest:
+		delete(s.watchers, r.CancelRequest.WatchId)
+		s.responses <- &etcdserverpb.WatchResponse{Header: f.header(), WatchId: r.CancelRequest.WatchId, Canceled: true}
+	}
+}
+
+// apply records the event of the key at a new revision and notifies the watchers. The lock must be held.
+func (f *fakeEtcd) apply(eventType mvccpb.Event_EventType, key, value string) {
+	f.revision++
+	kv := &mvccpb.KeyValue{Key: []byte(key), Value: []byte(value), ModRevision: f.revision, CreateRevision: f.revision, Version: 1}
+	if prev, exists := f.kvs[key]; exists {
+		kv.CreateRevision = prev.CreateRevision
+		kv.Version = prev.Version + 1
+	}
+	if eventType == mvccpb.DELETE {
+		delete(f.kvs, key)
+		kv = &mvccpb.KeyValue{Key: []byte(key), ModRevision: f.revision}
+	} else {
+		f.kvs[key] = kv
+	}
+	event := &mvccpb.Event{Type: eventType, Kv: kv}
+	f.history = append(f.history, event)
+	for s := range f.streams {
+		for _, w := range s.watchers {
+			if inRange(kv.Key, w.key, w.end) {
+				s.responses <- &etcdserverpb.WatchResponse{Header: f.header(), WatchId: w.id, Events: []*mvccpb.Event{event}}
+			}
+		}
+	}
+}
+
+func (f *fakeEtcd) put(key, value string) int64 {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	f.apply(mvccpb.PUT, key, value)
+	return f.revision
+}
+
+func (f *fakeEtcd) delete(key string) int64 {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	f.apply(mvccpb.DELETE, key, "")
+	return f.revision
+}
+
+// disconnectAndCompact disconnects all watch streams, applies the changes with the lock held so that
+// no watcher reconnects in between, and then compacts the history up to the current revision,
+// so that the watches cannot be resumed.
+func (f *fakeEtcd) disconnectAndCompact(changes func()) int64 {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	for s := range f.streams {
+		close(s.disconnected)
+		delete(f.streams, s)
+	}
+	changes()
+	f.compacted = f.revision
+	f.history = nil
+	return f.revision
+}
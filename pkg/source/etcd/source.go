@@ -0,0 +1,328 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package etcd provides a config source which reads OpenSergo rules from etcd.
+//
+// The objects are stored under keys in the form of <prefix><namespace>/<Kind>/<name>,
+// e.g. /opensergo/default/RateLimitStrategy/rate-limit-foo, and the values are the JSON or YAML manifests
+// of the objects, which are the same manifests applied to Kubernetes. The source talks to etcd with the
+// official v3 client, so it works with any etcd v3 cluster.
+package etcd
+
+import (
+	"context"
+	"crypto/tls"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibaba/sentinel-golang/util"
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	"github.com/opensergo/opensergo-control-plane/pkg/source"
+	"github.com/opensergo/opensergo-control-plane/pkg/source/store"
+	"github.com/pkg/errors"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/atomic"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	DefaultPrefix        = "/opensergo/"
+	DefaultRetryInterval = 3 * time.Second
+	DefaultDialTimeout   = 5 * time.Second
+)
+
+var sourceLog = ctrl.Log.WithName("source").WithName("etcd")
+
+// Options represents the options of the etcd source.
+type Options struct {
+	// Endpoints consists of the client URLs of etcd, e.g. http://127.0.0.1:2379.
+	Endpoints []string
+	// Prefix is the prefix of the keys of OpenSergo objects. Defaults to DefaultPrefix.
+	Prefix string
+	// TLS is the TLS config to talk to etcd. The connections are insecure if it is nil.
+	TLS *tls.Config
+	// Username and Password are the credentials of etcd, which are used if the username is not empty.
+	Username string
+	Password string
+	// DialTimeout is the timeout of connecting to etcd. Defaults to DefaultDialTimeout.
+	DialTimeout time.Duration
+	// RetryInterval is the interval to retry when the watch fails. Defaults to DefaultRetryInterval.
+	RetryInterval time.Duration
+}
+
+// Source is a ConfigSource which reads OpenSergo rules from etcd, and watches the changes of the keys.
+// The etcd revision of the latest change of a (namespace, app, kind) is used as the version of its rules.
+//
+// The objects go through the same caching, defaulting and translation as CRDs. If a value cannot be parsed,
+// the error is reported for the key, and the object previously loaded from the key is kept.
+type Source struct {
+	options Options
+	client  *clientv3.Client
+	store   *store.Store
+
+	// modRevisions represents a map: key -> the mod revision of the key which has been applied
+	modRevisions map[string]int64
+	// keyErrors represents a map: key -> the error of parsing the value of the key
+	keyErrors map[string]error
+	// revision is the latest revision which has been applied.
+	revision int64
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+	started   *atomic.Bool
+
+	mux sync.RWMutex
+}
+
+func NewSource(options Options) (*Source, error) {
+	endpoints := make([]string, 0, len(options.Endpoints))
+	for _, endpoint := range options.Endpoints {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	options.Endpoints = endpoints
+	if len(options.Endpoints) == 0 {
+		return nil, errors.New("no etcd endpoint")
+	}
+	if options.Prefix == "" {
+		options.Prefix = DefaultPrefix
+	}
+	if !strings.HasSuffix(options.Prefix, "/") {
+		options.Prefix += "/"
+	}
+	if options.DialTimeout <= 0 {
+		options.DialTimeout = DefaultDialTimeout
+	}
+	if options.RetryInterval <= 0 {
+		options.RetryInterval = DefaultRetryInterval
+	}
+	// The client connects in background, so that the source can be created before etcd is ready.
+	etcdClient, err := clientv3.New(clientv3.Config{
+		Endpoints:   options.Endpoints,
+		TLS:         options.TLS,
+		Username:    options.Username,
+		Password:    options.Password,
+		DialTimeout: options.DialTimeout,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the etcd client")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Source{
+		options:      options,
+		client:       etcdClient,
+		store:        store.NewStore(sourceLog),
+		modRevisions: make(map[string]int64),
+		keyErrors:    make(map[string]error),
+		ctx:          ctx,
+		ctxCancel:    cancel,
+		started:      atomic.NewBool(false),
+	}, nil
+}
+
+func (s *Source) ComponentName() string {
+	return "OpenSergoEtcdConfigSource"
+}
+
+// Run loads all objects under the prefix, and then watches the changes in background.
+func (s *Source) Run() error {
+	if !s.started.CAS(false, true) {
+		return nil
+	}
+	if err := s.resync(); err != nil {
+		s.started.Store(false)
+		return err
+	}
+	go util.RunWithRecover(s.watch)
+	return nil
+}
+
+func (s *Source) Close() error {
+	s.ctxCancel()
+	return s.client.Close()
+}
+
+func (s *Source) SetEventHandler(handler source.ConfigEventHandler) {
+	s.store.SetEventHandler(handler)
+}
+
+func (s *Source) Subscribe(target model.SubscribeTarget) (*trpb.DataWithVersion, error) {
+	return s.store.Subscribe(target)
+}
+
+// KeyErrors returns the errors of the keys whose values cannot be parsed.
+func (s *Source) KeyErrors() map[string]error {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	keyErrors := make(map[string]error, len(s.keyErrors))
+	for key, err := range s.keyErrors {
+		keyErrors[key] = err
+	}
+	return keyErrors
+}
+
+// watch watches the changes from the latest applied revision. When the watch fails, e.g. the revision
+// has been compacted, all keys are loaded again before watching again.
+func (s *Source) watch() {
+	for {
+		s.mux.RLock()
+		startRevision := s.revision + 1
+		s.mux.RUnlock()
+
+		err := s.watchFrom(startRevision)
+		if s.ctx.Err() != nil {
+			return
+		}
+		sourceLog.Error(err, "Failed to watch OpenSergo objects in etcd, will retry", "prefix", s.options.Prefix)
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(s.options.RetryInterval):
+			}
+			if err = s.resync(); err == nil {
+				break
+			}
+			sourceLog.Error(err, "Failed to load OpenSergo objects from etcd, will retry", "prefix", s.options.Prefix)
+		}
+	}
+}
+
+// watchFrom watches the keys under the prefix from the start revision, and applies the events.
+// It blocks until the context is done or the watch fails.
+func (s *Source) watchFrom(startRevision int64) error {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	// The watch fails rather than hangs if the connected member loses the leader.
+	watchChan := s.client.Watch(clientv3.WithRequireLeader(ctx), s.options.Prefix, clientv3.WithPrefix(), clientv3.WithRev(startRevision))
+	for resp := range watchChan {
+		if resp.CompactRevision > 0 {
+			return errors.Errorf("the revision %d has been compacted to %d", startRevision, resp.CompactRevision)
+		}
+		if err := resp.Err(); err != nil {
+			return err
+		}
+		s.handleWatchResponse(&resp)
+	}
+	return errors.New("the watch is closed")
+}
+
+// resync loads all keys under the prefix, and applies the keys which have changed or been removed.
+func (s *Source) resync() error {
+	resp, err := s.client.Get(s.ctx, s.options.Prefix, clientv3.WithPrefix())
+	if err != nil {
+		return err
+	}
+	// Apply the keys in the order of revisions, so that the versions of targets keep increasing.
+	sort.Slice(resp.Kvs, func(i, j int) bool {
+		return resp.Kvs[i].ModRevision < resp.Kvs[j].ModRevision
+	})
+	revision := resp.Header.Revision
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	present := make(map[string]bool, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		key := string(kv.Key)
+		present[key] = true
+		if kv.ModRevision > s.modRevisions[key] {
+			s.put(key, kv.Value, kv.ModRevision)
+		}
+	}
+	for key := range s.modRevisions {
+		if !present[key] {
+			s.delete(key, revision)
+		}
+	}
+	if revision > s.revision {
+		s.revision = revision
+	}
+	return nil
+}
+
+func (s *Source) handleWatchResponse(resp *clientv3.WatchResponse) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, event := range resp.Events {
+		key := string(event.Kv.Key)
+		revision := event.Kv.ModRevision
+		if event.Type == mvccpb.DELETE {
+			s.delete(key, revision)
+		} else {
+			s.put(key, event.Kv.Value, revision)
+		}
+		if revision > s.revision {
+			s.revision = revision
+		}
+	}
+	if revision := resp.Header.Revision; revision > s.revision {
+		s.revision = revision
+	}
+}
+
+// put applies the value of the key. The lock must be held.
+func (s *Source) put(key string, value []byte, revision int64) {
+	s.modRevisions[key] = revision
+	obj, err := s.decode(key, value)
+	if err != nil {
+		sourceLog.Error(err, "Failed to parse the OpenSergo object, the object previously loaded from the key is kept", "key", key)
+		s.keyErrors[key] = err
+		return
+	}
+	delete(s.keyErrors, key)
+	s.store.Set(key, []client.Object{obj}, revision)
+}
+
+// delete removes the object of the key. The lock must be held.
+func (s *Source) delete(key string, revision int64) {
+	delete(s.modRevisions, key)
+	delete(s.keyErrors, key)
+	s.store.Set(key, nil, revision)
+}
+
+// decode decodes the object of the key, which must match the namespace, kind and name in the key.
+func (s *Source) decode(key string, value []byte) (client.Object, error) {
+	parts := strings.Split(strings.TrimPrefix(key, s.options.Prefix), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, errors.Errorf("invalid key %s, expected %s<namespace>/<Kind>/<name>", key, s.options.Prefix)
+	}
+	namespace, kind, name := parts[0], parts[1], parts[2]
+	obj, err := controller.DecodeObject(value)
+	if err != nil {
+		return nil, err
+	}
+	if obj.GetObjectKind().GroupVersionKind().Kind != kind {
+		return nil, errors.Errorf("the kind %s of the object does not match the key", obj.GetObjectKind().GroupVersionKind().Kind)
+	}
+	if obj.GetName() != name {
+		return nil, errors.Errorf("the name %s of the object does not match the key", obj.GetName())
+	}
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(namespace)
+	} else if obj.GetNamespace() != namespace {
+		return nil, errors.Errorf("the namespace %s of the object does not match the key", obj.GetNamespace())
+	}
+	return obj, nil
+}
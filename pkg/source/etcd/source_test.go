@@ -0,0 +1,181 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	"github.com/opensergo/opensergo-control-plane/pkg/source"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+var testTarget = model.SubscribeTarget{Namespace: "default", AppName: "foo-app", Kind: controller.TimeoutStrategyKind}
+
+func timeoutKey(name string) string {
+	return DefaultPrefix + "default/TimeoutStrategy/" + name
+}
+
+func timeoutManifest(name, timeout string) string {
+	return fmt.Sprintf(`apiVersion: fault-tolerance.opensergo.io/v1alpha1
+kind: TimeoutStrategy
+metadata:
+  name: %s
+  labels:
+    app: foo-app
+spec:
+  timeout: %s
+`, name, timeout)
+}
+
+func namesOf(data *trpb.DataWithVersion) []string {
+	names := make([]string, 0, len(data.GetProvenances()))
+	for _, provenance := range data.GetProvenances() {
+		names = append(names, provenance.Name)
+	}
+	return names
+}
+
+// waitForEvent waits for the event of the test target whose rules are of the given names at the version.
+func waitForEvent(t *testing.T, events <-chan source.ConfigEvent, version int64, names ...string) {
+	t.Helper()
+	if names == nil {
+		names = []string{}
+	}
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case event := <-events:
+			if event.Target != testTarget {
+				continue
+			}
+			got := namesOf(event.DataWithVersion)
+			if event.DataWithVersion.Version == version && reflect.DeepEqual(got, names) {
+				return
+			}
+			t.Logf("skipped the event %s of %v at version %d", event.Type, got, event.DataWithVersion.Version)
+		case <-timeout:
+			t.Fatalf("no event of %v at version %d", names, version)
+		}
+	}
+}
+
+func TestSource(t *testing.T) {
+	fake, endpoint := startFakeEtcd(t)
+	fake.put(timeoutKey("timeout-a"), timeoutManifest("timeout-a", "1s"))
+	initialRevision := fake.put(timeoutKey("timeout-b"), timeoutManifest("timeout-b", "2s"))
+	// The keys out of the prefix are ignored.
+	fake.put("/other/default/TimeoutStrategy/timeout-x", timeoutManifest("timeout-x", "1s"))
+
+	s, err := NewSource(Options{Endpoints: []string{"http://" + endpoint}, RetryInterval: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	events := make(chan source.ConfigEvent, 100)
+	s.SetEventHandler(func(event source.ConfigEvent) {
+		events <- event
+	})
+	if err := s.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	// initial load
+	data, err := s.Subscribe(testTarget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := namesOf(data); !reflect.DeepEqual(got, []string{"timeout-a", "timeout-b"}) || data.Version != initialRevision {
+		t.Fatalf("initial rules = %v at version %d, want [timeout-a timeout-b] at version %d", got, data.Version, initialRevision)
+	}
+
+	// put and delete events
+	revision := fake.put(timeoutKey("timeout-c"), timeoutManifest("timeout-c", "3s"))
+	waitForEvent(t, events, revision, "timeout-a", "timeout-b", "timeout-c")
+	revision = fake.delete(timeoutKey("timeout-a"))
+	waitForEvent(t, events, revision, "timeout-b", "timeout-c")
+
+	// A value which cannot be parsed is reported, and the object previously loaded from the key is kept.
+	fake.put(timeoutKey("timeout-b"), "kind: [")
+	revision = fake.put(timeoutKey("timeout-d"), timeoutManifest("timeout-d", "4s"))
+	waitForEvent(t, events, revision, "timeout-b", "timeout-c", "timeout-d")
+	if keyErrors := s.KeyErrors(); len(keyErrors) != 1 || keyErrors[timeoutKey("timeout-b")] == nil {
+		t.Errorf("key errors = %v, want the error of %s", keyErrors, timeoutKey("timeout-b"))
+	}
+
+	// The watch is restarted after the revision has been compacted, and the missed changes are loaded again.
+	revision = fake.disconnectAndCompact(func() {
+		fake.apply(mvccpb.DELETE, timeoutKey("timeout-c"), "")
+		fake.apply(mvccpb.PUT, timeoutKey("timeout-b"), timeoutManifest("timeout-b", "5s"))
+		fake.apply(mvccpb.PUT, timeoutKey("timeout-e"), timeoutManifest("timeout-e", "5s"))
+	})
+	waitForEvent(t, events, revision, "timeout-b", "timeout-d", "timeout-e")
+	if keyErrors := s.KeyErrors(); len(keyErrors) != 0 {
+		t.Errorf("key errors = %v, want none", keyErrors)
+	}
+
+	// The restarted watch keeps receiving the changes.
+	revision = fake.delete(timeoutKey("timeout-d"))
+	waitForEvent(t, events, revision, "timeout-b", "timeout-e")
+}
+
+func TestNewSourceOptions(t *testing.T) {
+	if _, err := NewSource(Options{Endpoints: []string{" ", ""}}); err == nil {
+		t.Error("NewSource() without endpoints succeeds")
+	}
+	s, err := NewSource(Options{Endpoints: []string{" 127.0.0.1:2379 "}, Prefix: "/rules"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if s.options.Prefix != "/rules/" || !reflect.DeepEqual(s.options.Endpoints, []string{"127.0.0.1:2379"}) {
+		t.Errorf("options = %+v, want the prefix /rules/ and the trimmed endpoint", s.options)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	s := &Source{options: Options{Prefix: DefaultPrefix}}
+	tests := []struct {
+		name    string
+		key     string
+		value   string
+		wantErr bool
+	}{
+		{name: "valid", key: timeoutKey("timeout-a"), value: timeoutManifest("timeout-a", "1s")},
+		{name: "malformed key", key: DefaultPrefix + "default/timeout-a", value: timeoutManifest("timeout-a", "1s"), wantErr: true},
+		{name: "kind mismatch", key: DefaultPrefix + "default/RetryStrategy/timeout-a", value: timeoutManifest("timeout-a", "1s"), wantErr: true},
+		{name: "name mismatch", key: timeoutKey("timeout-b"), value: timeoutManifest("timeout-a", "1s"), wantErr: true},
+		{name: "namespace mismatch", key: timeoutKey("timeout-a"),
+			value: strings.Replace(timeoutManifest("timeout-a", "1s"), "  name: timeout-a\n", "  name: timeout-a\n  namespace: prod\n", 1), wantErr: true},
+		{name: "invalid manifest", key: timeoutKey("timeout-a"), value: "kind: [", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj, err := s.decode(tt.key, []byte(tt.value))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && obj.GetNamespace() == "" {
+				t.Error("the namespace is not set from the key")
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"os"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// decodeFile decodes the OpenSergo objects in the manifest, which may consist of multiple YAML documents.
+func decodeFile(path string) ([]client.Object, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	objs, err := controller.DecodeManifests(f)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(DefaultNamespace)
+		}
+	}
+	return objs, nil
+}
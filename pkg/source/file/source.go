@@ -0,0 +1,202 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package file provides a config source which reads OpenSergo rules from a directory of YAML manifests,
+// which are the same manifests applied to Kubernetes.
+package file
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/alibaba/sentinel-golang/util"
+	"github.com/fsnotify/fsnotify"
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	"github.com/opensergo/opensergo-control-plane/pkg/source"
+	"github.com/opensergo/opensergo-control-plane/pkg/source/store"
+	"github.com/pkg/errors"
+	"go.uber.org/atomic"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// DefaultNamespace is the namespace of the objects in the manifests without namespace.
+const DefaultNamespace = "default"
+
+var sourceLog = ctrl.Log.WithName("source").WithName("file")
+
+// Source is a ConfigSource which reads OpenSergo rules from the YAML manifests in a directory,
+// and watches the changes of the manifests.
+//
+// The objects in the manifests are decoded with the scheme of the Kubernetes operator, and go through
+// the same caching, defaulting and translation as CRDs. If a manifest cannot be parsed, the error is
+// reported for the file, and the objects previously loaded from the file are kept.
+type Source struct {
+	dir     string
+	watcher *fsnotify.Watcher
+	store   *store.Store
+
+	// fileErrors represents a map: file path -> the error of loading the file
+	fileErrors map[string]error
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+	started   *atomic.Bool
+
+	mux sync.RWMutex
+}
+
+// NewSource creates a file source of the given directory, and loads all manifests in the directory.
+func NewSource(dir string) (*Source, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, errors.Errorf("%s is not a directory", dir)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Source{
+		dir:        dir,
+		store:      store.NewStore(sourceLog),
+		fileErrors: make(map[string]error),
+		ctx:        ctx,
+		ctxCancel:  cancel,
+		started:    atomic.NewBool(false),
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() && isManifest(entry.Name()) {
+			s.loadFile(filepath.Join(dir, entry.Name()))
+		}
+	}
+	return s, nil
+}
+
+func (s *Source) ComponentName() string {
+	return "OpenSergoFileConfigSource"
+}
+
+// Run watches the changes of the manifests in background.
+func (s *Source) Run() error {
+	if !s.started.CAS(false, true) {
+		return nil
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err = watcher.Add(s.dir); err != nil {
+		_ = watcher.Close()
+		return err
+	}
+	s.watcher = watcher
+	go util.RunWithRecover(s.watch)
+	return nil
+}
+
+func (s *Source) Close() error {
+	s.ctxCancel()
+	if s.watcher != nil {
+		return s.watcher.Close()
+	}
+	return nil
+}
+
+func (s *Source) SetEventHandler(handler source.ConfigEventHandler) {
+	s.store.SetEventHandler(handler)
+}
+
+func (s *Source) Subscribe(target model.SubscribeTarget) (*trpb.DataWithVersion, error) {
+	return s.store.Subscribe(target)
+}
+
+// FileErrors returns the errors of the manifests which cannot be loaded, keyed by the file path.
+func (s *Source) FileErrors() map[string]error {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	fileErrors := make(map[string]error, len(s.fileErrors))
+	for path, err := range s.fileErrors {
+		fileErrors[path] = err
+	}
+	return fileErrors
+}
+
+func (s *Source) watch() {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case event, ok := <-s.watcher.Events:
+			if !ok {
+				return
+			}
+			if !isManifest(event.Name) {
+				continue
+			}
+			switch {
+			case event.Op&(fsnotify.Create|fsnotify.Write) != 0:
+				s.loadFile(event.Name)
+			case event.Op&(fsnotify.Remove|fsnotify.Rename) != 0:
+				s.removeFile(event.Name)
+			}
+		case err, ok := <-s.watcher.Errors:
+			if !ok {
+				return
+			}
+			sourceLog.Error(err, "Failed to watch the manifests", "dir", s.dir)
+		}
+	}
+}
+
+// loadFile (re)loads the objects of the file, and emits the changes of the affected targets.
+func (s *Source) loadFile(path string) {
+	objs, err := decodeFile(path)
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if err != nil {
+		sourceLog.Error(err, "Failed to load the manifest, the objects previously loaded from it are kept", "file", path)
+		s.fileErrors[path] = err
+		return
+	}
+	delete(s.fileErrors, path)
+	s.store.Set(path, objs, 0)
+}
+
+// removeFile removes the objects of the file, and emits the changes of the affected targets.
+func (s *Source) removeFile(path string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	delete(s.fileErrors, path)
+	s.store.Set(path, nil, 0)
+}
+
+func isManifest(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	"github.com/opensergo/opensergo-control-plane/pkg/source"
+)
+
+var testTarget = model.SubscribeTarget{Namespace: DefaultNamespace, AppName: "foo-app", Kind: controller.TimeoutStrategyKind}
+
+func timeoutManifest(name, timeout string) string {
+	return fmt.Sprintf(`apiVersion: fault-tolerance.opensergo.io/v1alpha1
+kind: TimeoutStrategy
+metadata:
+  name: %s
+  labels:
+    app: foo-app
+spec:
+  timeout: %s
+`, name, timeout)
+}
+
+// writeManifest replaces the file atomically, so that the watcher never loads a partially written file.
+func writeManifest(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	tmp := filepath.Join(dir, "."+name+".tmp")
+	if err := ioutil.WriteFile(tmp, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name)
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func namesOf(data *trpb.DataWithVersion) []string {
+	names := make([]string, 0, len(data.GetProvenances()))
+	for _, provenance := range data.GetProvenances() {
+		names = append(names, provenance.Name)
+	}
+	return names
+}
+
+// waitForEvent waits for the event of the test target whose rules are of the given names,
+// and checks that the versions of the events only go up.
+func waitForEvent(t *testing.T, events <-chan source.ConfigEvent, lastVersion *int64, names ...string) {
+	t.Helper()
+	if names == nil {
+		names = []string{}
+	}
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case event := <-events:
+			if event.Target != testTarget {
+				continue
+			}
+			if version := event.DataWithVersion.Version; version <= *lastVersion {
+				t.Errorf("the version of the event of %v = %d, want greater than %d", namesOf(event.DataWithVersion), version, *lastVersion)
+			} else {
+				*lastVersion = version
+			}
+			got := namesOf(event.DataWithVersion)
+			if reflect.DeepEqual(got, names) {
+				return
+			}
+			t.Logf("skipped the event %s of %v", event.Type, got)
+		case <-timeout:
+			t.Fatalf("no event of %v", names)
+		}
+	}
+}
+
+func TestSource(t *testing.T) {
+	dir := t.TempDir()
+	writeManifest(t, dir, "timeout-a.yaml", timeoutManifest("timeout-a", "1s"))
+	// The multi-document manifest, whose comment-only document is skipped.
+	writeManifest(t, dir, "timeouts.yaml", timeoutManifest("timeout-b", "2s")+"---\n# comment\n---\n"+timeoutManifest("timeout-c", "3s"))
+	// The files other than manifests are ignored.
+	writeManifest(t, dir, "README.md", timeoutManifest("timeout-x", "1s"))
+
+	s, err := NewSource(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	events := make(chan source.ConfigEvent, 100)
+	s.SetEventHandler(func(event source.ConfigEvent) {
+		events <- event
+	})
+	if err := s.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	// initial load
+	data, err := s.Subscribe(testTarget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := namesOf(data); !reflect.DeepEqual(got, []string{"timeout-a", "timeout-b", "timeout-c"}) || len(data.GetData()) != 3 {
+		t.Fatalf("initial rules = %v, want [timeout-a timeout-b timeout-c]", got)
+	}
+	version := data.Version
+
+	// reload through fsnotify
+	writeManifest(t, dir, "timeout-d.yaml", timeoutManifest("timeout-d", "4s"))
+	waitForEvent(t, events, &version, "timeout-a", "timeout-b", "timeout-c", "timeout-d")
+	writeManifest(t, dir, "timeouts.yaml", timeoutManifest("timeout-b", "2s"))
+	waitForEvent(t, events, &version, "timeout-a", "timeout-b", "timeout-d")
+
+	// A manifest which cannot be decoded is reported, and the objects previously loaded from it are kept.
+	bad := writeManifest(t, dir, "timeout-a.yaml", "kind: [")
+	writeManifest(t, dir, "timeout-e.yaml", timeoutManifest("timeout-e", "5s"))
+	waitForEvent(t, events, &version, "timeout-a", "timeout-b", "timeout-d", "timeout-e")
+	if fileErrors := s.FileErrors(); len(fileErrors) != 1 || fileErrors[bad] == nil {
+		t.Errorf("file errors = %v, want the error of %s", fileErrors, bad)
+	}
+
+	// Deleting the file removes its objects and its error.
+	if err := os.Remove(bad); err != nil {
+		t.Fatal(err)
+	}
+	waitForEvent(t, events, &version, "timeout-b", "timeout-d", "timeout-e")
+	if fileErrors := s.FileErrors(); len(fileErrors) != 0 {
+		t.Errorf("file errors = %v, want none", fileErrors)
+	}
+}
+
+func TestNewSourceErrors(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := NewSource(filepath.Join(dir, "missing")); err == nil {
+		t.Error("NewSource() of a missing directory succeeds")
+	}
+	path := writeManifest(t, dir, "timeout-a.yaml", timeoutManifest("timeout-a", "1s"))
+	if _, err := NewSource(path); err == nil {
+		t.Error("NewSource() of a file succeeds")
+	}
+}
+
+func TestDecodeFile(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantNames []string
+		wantErr   bool
+	}{
+		{name: "single document", content: timeoutManifest("timeout-a", "1s"), wantNames: []string{"timeout-a"}},
+		{name: "multiple documents", content: timeoutManifest("timeout-a", "1s") + "---\n" + timeoutManifest("timeout-b", "2s"),
+			wantNames: []string{"timeout-a", "timeout-b"}},
+		{name: "empty documents", content: "---\n# comment\n---\n", wantNames: nil},
+		{name: "invalid document", content: timeoutManifest("timeout-a", "1s") + "---\nkind: [", wantErr: true},
+		{name: "unsupported kind", content: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: foo\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objs, err := decodeFile(writeManifest(t, t.TempDir(), "rules.yaml", tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decodeFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var names []string
+			for _, obj := range objs {
+				names = append(names, obj.GetName())
+				if obj.GetNamespace() != DefaultNamespace {
+					t.Errorf("the namespace of %s = %q, want %q", obj.GetName(), obj.GetNamespace(), DefaultNamespace)
+				}
+			}
+			if !reflect.DeepEqual(names, tt.wantNames) {
+				t.Errorf("decodeFile() = %v, want %v", names, tt.wantNames)
+			}
+		})
+	}
+}
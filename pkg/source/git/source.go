@@ -0,0 +1,343 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package git provides a config source which reads OpenSergo rules from the YAML manifests in a local
+// git repository, for GitOps without a separate sync controller. The repository is expected to be kept
+// up to date by other means, e.g. a periodic `git pull` or a git-sync sidecar.
+package git
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibaba/sentinel-golang/util"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	"github.com/opensergo/opensergo-control-plane/pkg/source"
+	"github.com/opensergo/opensergo-control-plane/pkg/source/store"
+	"github.com/pkg/errors"
+	"go.uber.org/atomic"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	DefaultPollInterval = 10 * time.Second
+	// DefaultNamespace is the namespace of the objects in the manifests without namespace.
+	DefaultNamespace = "default"
+)
+
+var sourceLog = ctrl.Log.WithName("source").WithName("git")
+
+// Options represents the options of the git source.
+type Options struct {
+	// RepoPath is the path of the local git repository.
+	RepoPath string
+	// Branch is the branch where the manifests are read, or a full reference name,
+	// e.g. refs/remotes/origin/main for a repository which is only fetched. Defaults to the HEAD of the repository.
+	Branch string
+	// Dir is the subdirectory of the manifests in the repository, including nested directories. Defaults to the root.
+	Dir string
+	// PollInterval is the interval to check for new commits. Defaults to DefaultPollInterval.
+	PollInterval time.Duration
+}
+
+// Source is a ConfigSource which reads OpenSergo rules from the manifests at the latest commit of
+// a branch, and polls for new commits. Only the manifests which have changed between commits are reloaded,
+// and the SHA of the commit which last changed the rules of a target is attached to the data as the revision.
+//
+// The objects go through the same caching, defaulting and translation as CRDs. If a manifest cannot be parsed,
+// the error is reported for the file, and the objects previously loaded from the file are kept.
+type Source struct {
+	options Options
+	store   *store.Store
+
+	// blobs represents a map: file path -> the hash of the blob which has been loaded
+	blobs map[string]plumbing.Hash
+	// fileErrors represents a map: file path -> the error of loading the file
+	fileErrors map[string]error
+	// commit is the latest commit which has been applied.
+	commit plumbing.Hash
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+	started   *atomic.Bool
+
+	// syncMux serializes the syncs. The applied state is only changed by the sync holding it,
+	// which reads the state without mux.
+	syncMux sync.Mutex
+	mux     sync.RWMutex
+}
+
+// NewSource creates a git source of the repository, and loads the manifests at the latest commit.
+func NewSource(options Options) (*Source, error) {
+	if options.RepoPath == "" {
+		return nil, errors.New("empty path of the git repository")
+	}
+	options.Dir = strings.Trim(filepath.ToSlash(options.Dir), "/")
+	if options.PollInterval <= 0 {
+		options.PollInterval = DefaultPollInterval
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Source{
+		options:    options,
+		store:      store.NewStore(sourceLog),
+		blobs:      make(map[string]plumbing.Hash),
+		fileErrors: make(map[string]error),
+		ctx:        ctx,
+		ctxCancel:  cancel,
+		started:    atomic.NewBool(false),
+	}
+	if err := s.sync(); err != nil {
+		cancel()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Source) ComponentName() string {
+	return "OpenSergoGitConfigSource"
+}
+
+// Run polls for new commits in background.
+func (s *Source) Run() error {
+	if s.started.CAS(false, true) {
+		go util.RunWithRecover(s.poll)
+	}
+	return nil
+}
+
+func (s *Source) Close() error {
+	s.ctxCancel()
+	return nil
+}
+
+func (s *Source) SetEventHandler(handler source.ConfigEventHandler) {
+	s.store.SetEventHandler(handler)
+}
+
+func (s *Source) Subscribe(target model.SubscribeTarget) (*trpb.DataWithVersion, error) {
+	return s.store.Subscribe(target)
+}
+
+// Commit returns the SHA of the latest commit which has been applied.
+func (s *Source) Commit() string {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	return s.commit.String()
+}
+
+// FileErrors returns the errors of the manifests which cannot be loaded, keyed by the path in the repository.
+func (s *Source) FileErrors() map[string]error {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	fileErrors := make(map[string]error, len(s.fileErrors))
+	for path, err := range s.fileErrors {
+		fileErrors[path] = err
+	}
+	return fileErrors
+}
+
+func (s *Source) poll() {
+	ticker := time.NewTicker(s.options.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.sync(); err != nil {
+				sourceLog.Error(err, "Failed to read the git repository, will retry", "repo", s.options.RepoPath)
+			}
+		}
+	}
+}
+
+// snapshot represents the manifests at a commit which differ from the applied ones.
+type snapshot struct {
+	commit plumbing.Hash
+	// files consists of the manifests whose blobs have changed, keyed by the path in the repository.
+	files map[string]*snapshotFile
+	// removed consists of the paths of the manifests which have been removed.
+	removed []string
+	total   int
+}
+
+type snapshotFile struct {
+	blob plumbing.Hash
+	objs []client.Object
+	err  error
+}
+
+// sync reads the manifests at the latest commit of the branch if the commit has changed, and applies them.
+// The repository is read and the manifests are decoded without the lock, so that the readers of the state
+// are not blocked by a slow repository, and only the resulting snapshot is swapped in with the lock held.
+func (s *Source) sync() error {
+	s.syncMux.Lock()
+	defer s.syncMux.Unlock()
+
+	snap, err := s.read()
+	if err != nil || snap == nil {
+		return err
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	revision := snap.commit.String()
+	for path, f := range snap.files {
+		s.blobs[path] = f.blob
+		if f.err != nil {
+			sourceLog.Error(f.err, "Failed to load the manifest, the objects previously loaded from it are kept",
+				"file", path, "commit", revision)
+			s.fileErrors[path] = f.err
+			continue
+		}
+		delete(s.fileErrors, path)
+		s.store.SetWithRevision(path, f.objs, 0, revision)
+	}
+	for _, path := range snap.removed {
+		delete(s.blobs, path)
+		delete(s.fileErrors, path)
+		s.store.SetWithRevision(path, nil, 0, revision)
+	}
+	s.commit = snap.commit
+	sourceLog.Info("Applied the manifests of the git commit", "commit", revision, "files", snap.total)
+	return nil
+}
+
+// read reads the manifests at the latest commit of the branch which differ from the applied ones,
+// or returns nil if the commit has not changed. The sync lock must be held, so that the applied state
+// is only changed by the caller.
+func (s *Source) read() (*snapshot, error) {
+	// The repository is opened every time, so that the objects written by other processes are visible.
+	repo, err := gogit.PlainOpen(s.options.RepoPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open the git repository %s", s.options.RepoPath)
+	}
+	hash, err := s.resolve(repo)
+	if err != nil {
+		return nil, err
+	}
+	if hash == s.commit {
+		return nil, nil
+	}
+	files, err := s.manifestsAt(repo, hash)
+	if err != nil {
+		return nil, err
+	}
+	snap := &snapshot{commit: hash, files: make(map[string]*snapshotFile), total: len(files)}
+	for path, f := range files {
+		if blob, exists := s.blobs[path]; exists && blob == f.Hash {
+			continue
+		}
+		objs, err := decodeFile(f)
+		snap.files[path] = &snapshotFile{blob: f.Hash, objs: objs, err: err}
+	}
+	for path := range s.blobs {
+		if _, exists := files[path]; !exists {
+			snap.removed = append(snap.removed, path)
+		}
+	}
+	return snap, nil
+}
+
+// resolve returns the latest commit of the branch.
+func (s *Source) resolve(repo *gogit.Repository) (plumbing.Hash, error) {
+	var ref *plumbing.Reference
+	var err error
+	switch {
+	case s.options.Branch == "":
+		ref, err = repo.Head()
+	case strings.HasPrefix(s.options.Branch, "refs/"):
+		ref, err = repo.Reference(plumbing.ReferenceName(s.options.Branch), true)
+	default:
+		ref, err = repo.Reference(plumbing.NewBranchReferenceName(s.options.Branch), true)
+	}
+	if err != nil {
+		return plumbing.ZeroHash, errors.Wrapf(err, "failed to resolve the branch %q", s.options.Branch)
+	}
+	return ref.Hash(), nil
+}
+
+// manifestsAt returns the manifests under the directory at the commit, keyed by the path in the repository.
+func (s *Source) manifestsAt(repo *gogit.Repository, hash plumbing.Hash) (map[string]*object.File, error) {
+	commit, err := repo.CommitObject(hash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the commit %s", hash)
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]*object.File)
+	if s.options.Dir != "" {
+		tree, err = tree.Tree(s.options.Dir)
+		if err == object.ErrDirectoryNotFound {
+			// The directory has been removed, which means no rule.
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = tree.Files().ForEach(func(f *object.File) error {
+		if isManifest(f.Name) {
+			files[f.Name] = f
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// decodeFile decodes the OpenSergo objects in the manifest, which may consist of multiple YAML documents.
+func decodeFile(f *object.File) ([]client.Object, error) {
+	reader, err := f.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	objs, err := controller.DecodeManifests(reader)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(DefaultNamespace)
+		}
+	}
+	return objs, nil
+}
+
+func isManifest(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/osfs"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	"github.com/opensergo/opensergo-control-plane/pkg/source"
+)
+
+var testTarget = model.SubscribeTarget{Namespace: "default", AppName: "foo-app", Kind: controller.TimeoutStrategyKind}
+
+func timeoutManifest(name, timeout string) string {
+	return fmt.Sprintf(`apiVersion: fault-tolerance.opensergo.io/v1alpha1
+kind: TimeoutStrategy
+metadata:
+  name: %s
+  labels:
+    app: foo-app
+spec:
+  timeout: %s
+`, name, timeout)
+}
+
+// testRepo is a bare repository, whose commits are made through a separate worktree,
+// as a git server or a mirror which is only pushed to.
+type testRepo struct {
+	t        *testing.T
+	path     string
+	worktree string
+	repo     *gogit.Repository
+}
+
+func newTestRepo(t *testing.T) *testRepo {
+	t.Helper()
+	path := t.TempDir()
+	if _, err := gogit.PlainInit(path, true); err != nil {
+		t.Fatal(err)
+	}
+	worktree := t.TempDir()
+	storage := filesystem.NewStorage(osfs.New(path), cache.NewObjectLRUDefault())
+	repo, err := gogit.Open(storage, osfs.New(worktree))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testRepo{t: t, path: path, worktree: worktree, repo: repo}
+}
+
+// commit writes the files, where the empty contents mean removing the files, and commits all changes.
+func (r *testRepo) commit(files map[string]string) string {
+	r.t.Helper()
+	for name, content := range files {
+		path := filepath.Join(r.worktree, filepath.FromSlash(name))
+		if content == "" {
+			if err := os.Remove(path); err != nil {
+				r.t.Fatal(err)
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			r.t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			r.t.Fatal(err)
+		}
+	}
+	w, err := r.repo.Worktree()
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	if err = w.AddWithOptions(&gogit.AddOptions{All: true}); err != nil {
+		r.t.Fatal(err)
+	}
+	hash, err := w.Commit("update rules", &gogit.CommitOptions{
+		All:    true,
+		Author: &object.Signature{Name: "test", Email: "test@opensergo.io", When: time.Now()},
+	})
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	return hash.String()
+}
+
+func namesOf(data *trpb.DataWithVersion) []string {
+	names := make([]string, 0, len(data.GetProvenances()))
+	for _, provenance := range data.GetProvenances() {
+		names = append(names, provenance.Name)
+	}
+	return names
+}
+
+func TestSourceWithBareRepo(t *testing.T) {
+	repo := newTestRepo(t)
+	first := repo.commit(map[string]string{
+		"rules/timeout-a.yaml": timeoutManifest("timeout-a", "1s"),
+		"rules/timeout-b.yml":  timeoutManifest("timeout-b", "2s"),
+		"rules/README.md":      "not a manifest",
+		"other/timeout-x.yaml": timeoutManifest("timeout-x", "1s"),
+	})
+
+	s, err := NewSource(Options{RepoPath: repo.path, Branch: "master", Dir: "/rules/", PollInterval: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	events := make(chan source.ConfigEvent, 100)
+	s.SetEventHandler(func(event source.ConfigEvent) {
+		events <- event
+	})
+
+	data, err := s.Subscribe(testTarget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := namesOf(data); !reflect.DeepEqual(got, []string{"timeout-a", "timeout-b"}) || data.Revision != first || s.Commit() != first {
+		t.Fatalf("initial rules = %v at %s, commit %s, want [timeout-a timeout-b] at %s", got, data.Revision, s.Commit(), first)
+	}
+	if err := s.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		files          map[string]string
+		wantNames      []string
+		wantFileErrors []string
+	}{
+		{
+			name: "add, change and remove manifests",
+			files: map[string]string{
+				"rules/timeout-a.yaml":        "",
+				"rules/timeout-b.yml":         timeoutManifest("timeout-b", "3s"),
+				"rules/nested/timeout-c.json": `{"apiVersion":"fault-tolerance.opensergo.io/v1alpha1","kind":"TimeoutStrategy","metadata":{"name":"timeout-c","labels":{"app":"foo-app"}},"spec":{"timeout":"1s"}}`,
+			},
+			wantNames: []string{"timeout-b", "timeout-c"},
+		},
+		{
+			name: "keep the objects of an unparsable manifest",
+			files: map[string]string{
+				"rules/timeout-b.yml":  "kind: [",
+				"rules/timeout-d.yaml": timeoutManifest("timeout-d", "1s"),
+			},
+			wantNames:      []string{"timeout-b", "timeout-c", "timeout-d"},
+			wantFileErrors: []string{"timeout-b.yml"},
+		},
+		{
+			name: "fix the manifest",
+			files: map[string]string{
+				"rules/timeout-b.yml": timeoutManifest("timeout-b", "5s"),
+			},
+			wantNames: []string{"timeout-b", "timeout-c", "timeout-d"},
+		},
+		{
+			name: "ignore the changes out of the directory",
+			files: map[string]string{
+				"other/timeout-y.yaml": timeoutManifest("timeout-y", "1s"),
+				"rules/timeout-d.yaml": "",
+			},
+			wantNames: []string{"timeout-b", "timeout-c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commit := repo.commit(tt.files)
+			waitForEvent(t, events, commit, tt.wantNames)
+			fileErrors := s.FileErrors()
+			var gotFileErrors []string
+			for path := range fileErrors {
+				gotFileErrors = append(gotFileErrors, path)
+			}
+			if !reflect.DeepEqual(gotFileErrors, tt.wantFileErrors) {
+				t.Errorf("file errors = %v, want the errors of %v", fileErrors, tt.wantFileErrors)
+			}
+			if s.Commit() != commit {
+				t.Errorf("commit = %s, want %s", s.Commit(), commit)
+			}
+		})
+	}
+}
+
+// waitForEvent waits for the event of the test target whose rules are of the given names at the commit.
+func waitForEvent(t *testing.T, events <-chan source.ConfigEvent, commit string, names []string) {
+	t.Helper()
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case event := <-events:
+			got := namesOf(event.DataWithVersion)
+			if event.Target == testTarget && event.DataWithVersion.Revision == commit && reflect.DeepEqual(got, names) {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("no event of %v at %s", names, commit)
+		}
+	}
+}
+
+func TestNewSourceErrors(t *testing.T) {
+	repo := newTestRepo(t)
+	repo.commit(map[string]string{"timeout-a.yaml": timeoutManifest("timeout-a", "1s")})
+	tests := []struct {
+		name    string
+		options Options
+	}{
+		{name: "no repository path", options: Options{}},
+		{name: "not a repository", options: Options{RepoPath: t.TempDir()}},
+		{name: "unknown branch", options: Options{RepoPath: repo.path, Branch: "unknown"}},
+		{name: "unknown reference", options: Options{RepoPath: repo.path, Branch: "refs/remotes/origin/master"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewSource(tt.options); err == nil {
+				t.Error("NewSource() succeeds")
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	"github.com/opensergo/opensergo-control-plane/pkg/util"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// MemorySource is a ConfigSource which keeps rules in memory, which is useful for local development
+// and for embedding the control plane in other programs.
+type MemorySource struct {
+	// rules represents a map: target -> (rule name -> rule)
+	rules    map[model.SubscribeTarget]map[string]*anypb.Any
+	versions map[model.SubscribeTarget]int64
+
+	subscribed map[model.SubscribeTarget]bool
+	emitter    *EventEmitter
+
+	mux sync.RWMutex
+}
+
+func NewMemorySource() *MemorySource {
+	return &MemorySource{
+		rules:      make(map[model.SubscribeTarget]map[string]*anypb.Any),
+		versions:   make(map[model.SubscribeTarget]int64),
+		subscribed: make(map[model.SubscribeTarget]bool),
+		emitter:    NewEventEmitter(),
+	}
+}
+
+func (s *MemorySource) ComponentName() string {
+	return "OpenSergoMemoryConfigSource"
+}
+
+func (s *MemorySource) Run() error {
+	return nil
+}
+
+func (s *MemorySource) Close() error {
+	return nil
+}
+
+func (s *MemorySource) SetEventHandler(handler ConfigEventHandler) {
+	s.emitter.SetHandler(handler)
+}
+
+func (s *MemorySource) Subscribe(target model.SubscribeTarget) (*trpb.DataWithVersion, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.subscribed[target] = true
+	data := s.dataWithVersion(target)
+	s.emitter.Observe(target, data)
+	return data, nil
+}
+
+// PutRule adds or replaces the rule with the given name of the (namespace, app, kind).
+func (s *MemorySource) PutRule(namespace, app, kind, name string, rule proto.Message) error {
+	packed, err := util.MessageToAnyWithError(rule)
+	if err != nil {
+		return err
+	}
+	target := model.SubscribeTarget{Namespace: namespace, AppName: app, Kind: kind}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	rules, exists := s.rules[target]
+	if !exists {
+		rules = make(map[string]*anypb.Any)
+		s.rules[target] = rules
+	}
+	rules[name] = packed
+	s.notify(target)
+	return nil
+}
+
+// DeleteRule removes the rule with the given name of the (namespace, app, kind).
+func (s *MemorySource) DeleteRule(namespace, app, kind, name string) {
+	target := model.SubscribeTarget{Namespace: namespace, AppName: app, Kind: kind}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	rules := s.rules[target]
+	if _, exists := rules[name]; !exists {
+		return
+	}
+	delete(rules, name)
+	if len(rules) == 0 {
+		delete(s.rules, target)
+	}
+	s.notify(target)
+}
+
+// notify bumps the version of the target and emits the latest rules if the target has been subscribed.
+// The lock must be held, so that the events of the same target are emitted in order.
+func (s *MemorySource) notify(target model.SubscribeTarget) {
+	s.versions[target]++
+	if s.subscribed[target] {
+		s.emitter.Emit(target, s.dataWithVersion(target))
+	}
+}
+
+// dataWithVersion returns the rules of the target ordered by name. The lock must be held.
+func (s *MemorySource) dataWithVersion(target model.SubscribeTarget) *trpb.DataWithVersion {
+	rules := s.rules[target]
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	data := &trpb.DataWithVersion{Version: s.versions[target]}
+	for _, name := range names {
+		data.Data = append(data.Data, rules[name])
+		data.Provenances = append(data.Provenances, &trpb.RuleProvenance{
+			Namespace: target.Namespace,
+			Name:      name,
+		})
+	}
+	return data
+}
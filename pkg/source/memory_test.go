@@ -0,0 +1,108 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/opensergo/opensergo-control-plane/pkg/proto/fault_tolerance/v1"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func namesOf(data *trpb.DataWithVersion) []string {
+	names := make([]string, 0, len(data.GetProvenances()))
+	for _, provenance := range data.GetProvenances() {
+		names = append(names, provenance.Name)
+	}
+	return names
+}
+
+func TestMemorySource(t *testing.T) {
+	s := NewMemorySource()
+	var events []ConfigEvent
+	s.SetEventHandler(func(event ConfigEvent) {
+		events = append(events, event)
+	})
+	put := func(name string, timeoutMillis int64) {
+		t.Helper()
+		if err := s.PutRule(testTarget.Namespace, testTarget.AppName, testTarget.Kind, name,
+			&pb.TimeoutStrategy{Name: name, TimeoutMillis: timeoutMillis}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The changes of the targets not subscribed are not emitted.
+	put("timeout-b", 1000)
+	if len(events) != 0 {
+		t.Fatalf("events before subscription = %v, want none", events)
+	}
+	data, err := s.Subscribe(testTarget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := namesOf(data); !reflect.DeepEqual(got, []string{"timeout-b"}) || data.Version != 1 {
+		t.Fatalf("initial rules = %v at version %d, want [timeout-b] at version 1", got, data.Version)
+	}
+	rule := &pb.TimeoutStrategy{}
+	if err := data.Data[0].UnmarshalTo(rule); err != nil || rule.TimeoutMillis != 1000 {
+		t.Errorf("rule = %v, %v, want the timeout of 1000ms", rule, err)
+	}
+
+	steps := []struct {
+		name      string
+		change    func()
+		wantType  ConfigEventType
+		wantNames []string
+	}{
+		{name: "add", change: func() { put("timeout-a", 500) }, wantType: ConfigEventUpdate, wantNames: []string{"timeout-a", "timeout-b"}},
+		{name: "replace", change: func() { put("timeout-a", 800) }, wantType: ConfigEventUpdate, wantNames: []string{"timeout-a", "timeout-b"}},
+		{name: "delete", change: func() {
+			s.DeleteRule(testTarget.Namespace, testTarget.AppName, testTarget.Kind, "timeout-b")
+		}, wantType: ConfigEventUpdate, wantNames: []string{"timeout-a"}},
+		{name: "delete the last", change: func() {
+			s.DeleteRule(testTarget.Namespace, testTarget.AppName, testTarget.Kind, "timeout-a")
+		}, wantType: ConfigEventDelete, wantNames: []string{}},
+		{name: "add again", change: func() { put("timeout-c", 500) }, wantType: ConfigEventAdd, wantNames: []string{"timeout-c"}},
+	}
+	version := data.Version
+	for _, step := range steps {
+		events = nil
+		step.change()
+		if len(events) != 1 {
+			t.Fatalf("%s: events = %v, want one event", step.name, events)
+		}
+		event := events[0]
+		if got := namesOf(event.DataWithVersion); event.Type != step.wantType || !reflect.DeepEqual(got, step.wantNames) {
+			t.Errorf("%s: event = %s of %v, want %s of %v", step.name, event.Type, got, step.wantType, step.wantNames)
+		}
+		if event.DataWithVersion.Version != version+1 {
+			t.Errorf("%s: version = %d, want %d", step.name, event.DataWithVersion.Version, version+1)
+		}
+		version = event.DataWithVersion.Version
+	}
+	want := &pb.TimeoutStrategy{Name: "timeout-c", TimeoutMillis: 500}
+	if err := events[0].DataWithVersion.Data[0].UnmarshalTo(rule); err != nil || !proto.Equal(rule, want) {
+		t.Errorf("rule = %v, %v, want %v", rule, err, want)
+	}
+
+	// Deleting a missing rule changes nothing.
+	events = nil
+	s.DeleteRule(testTarget.Namespace, testTarget.AppName, testTarget.Kind, "timeout-x")
+	if len(events) != 0 {
+		t.Errorf("events of deleting a missing rule = %v, want none", events)
+	}
+}
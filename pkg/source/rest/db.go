@@ -0,0 +1,89 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/binary"
+	"strings"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	// objectsBucket holds the JSON manifests of the objects: <namespace>/<Kind>/<name> -> manifest
+	objectsBucket = []byte("objects")
+	// metaBucket holds the metadata of the database, e.g. the latest revision.
+	metaBucket  = []byte("meta")
+	revisionKey = []byte("revision")
+)
+
+// objectKey returns the key of the object in the database.
+func objectKey(namespace, kind, name string) string {
+	return namespace + "/" + shortKind(kind) + "/" + name
+}
+
+// shortKind returns the Kind part of a kind in the form of group/version/Kind.
+func shortKind(kind controller.CRDKind) string {
+	return kind[strings.LastIndex(kind, "/")+1:]
+}
+
+func initBuckets(tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists(objectsBucket); err != nil {
+		return err
+	}
+	_, err := tx.CreateBucketIfNotExists(metaBucket)
+	return err
+}
+
+// currentRevision returns the latest revision of the database, which is increased by every write.
+func currentRevision(tx *bolt.Tx) int64 {
+	data := tx.Bucket(metaBucket).Get(revisionKey)
+	if len(data) != 8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(data))
+}
+
+// nextRevision increases the revision of the database and returns the new revision.
+func nextRevision(tx *bolt.Tx) (int64, error) {
+	revision := currentRevision(tx) + 1
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, uint64(revision))
+	if err := tx.Bucket(metaBucket).Put(revisionKey, data); err != nil {
+		return 0, err
+	}
+	return revision, nil
+}
+
+// getObject reads the object of the key, which returns nil if the key does not exist.
+func getObject(tx *bolt.Tx, key string) (client.Object, error) {
+	data := tx.Bucket(objectsBucket).Get([]byte(key))
+	if data == nil {
+		return nil, nil
+	}
+	return decodeObject(key, data)
+}
+
+// decodeObject decodes the stored manifest of the key.
+func decodeObject(key string, data []byte) (client.Object, error) {
+	obj, err := controller.DecodeObject(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode the stored object %s", key)
+	}
+	return obj, nil
+}
@@ -0,0 +1,324 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/alibaba/sentinel-golang/util"
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	k8sApiError "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxBodySize is the maximum size of the manifest in a request.
+const maxBodySize = 1 << 20
+
+// server serves the HTTP API of the REST source.
+type server struct {
+	source     *Source
+	port       int
+	certDir    string
+	tokens     [][]byte
+	httpServer *http.Server
+}
+
+func newServer(source *Source, options Options) *server {
+	s := &server{
+		source:  source,
+		port:    options.Port,
+		certDir: options.CertDir,
+	}
+	for _, token := range options.Tokens {
+		s.tokens = append(s.tokens, []byte(token))
+	}
+	s.httpServer = &http.Server{Handler: s}
+	return s
+}
+
+// run listens on the port, and serves the HTTP API in background.
+func (s *server) run() error {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
+	if err != nil {
+		return err
+	}
+	go util.RunWithRecover(func() {
+		sourceLog.Info("Starting OpenSergo REST API server", "port", s.port, "tls", s.certDir != "")
+		var err error
+		if s.certDir != "" {
+			err = s.httpServer.ServeTLS(listener, filepath.Join(s.certDir, "tls.crt"), filepath.Join(s.certDir, "tls.key"))
+		} else {
+			err = s.httpServer.Serve(listener)
+		}
+		if err != nil && err != http.ErrServerClosed {
+			sourceLog.Error(err, "problem running OpenSergo REST API server")
+		}
+	})
+	return nil
+}
+
+func (s *server) close() error {
+	return s.httpServer.Close()
+}
+
+// requestInfo represents the target of a request, which is parsed from the path.
+type requestInfo struct {
+	kind      controller.CRDKind
+	resource  schema.GroupResource
+	namespace string
+	name      string
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authenticate(r) {
+		writeError(w, k8sApiError.NewUnauthorized("a valid bearer token is required"))
+		return
+	}
+	info, err := parsePath(r.URL.Path)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	switch {
+	case info.name == "" && r.Method == http.MethodGet:
+		s.list(w, info)
+	case info.name == "" && r.Method == http.MethodPost && info.namespace != "":
+		s.create(w, r, info)
+	case info.name != "" && r.Method == http.MethodGet:
+		s.get(w, info)
+	case info.name != "" && r.Method == http.MethodPut:
+		s.update(w, r, info)
+	case info.name != "" && r.Method == http.MethodDelete:
+		s.delete(w, r, info)
+	default:
+		writeError(w, k8sApiError.NewMethodNotSupported(info.resource, r.Method))
+	}
+}
+
+// authenticate checks the bearer token of the request in constant time.
+func (s *server) authenticate(r *http.Request) bool {
+	auth := r.Header.Get("Authorization")
+	const prefix = "Bearer "
+	if len(auth) <= len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return false
+	}
+	token := []byte(strings.TrimSpace(auth[len(prefix):]))
+	matched := false
+	for _, t := range s.tokens {
+		if subtle.ConstantTimeCompare(token, t) == 1 {
+			matched = true
+		}
+	}
+	return matched
+}
+
+func (s *server) list(w http.ResponseWriter, info *requestInfo) {
+	objs, revision, err := s.source.List(info.kind, info.namespace)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	crdMetadata, _ := controller.GetCrdMetadata(info.kind)
+	list := crdMetadata.ListGenerator()()
+	items := make([]runtime.Object, 0, len(objs))
+	for _, obj := range objs {
+		items = append(items, obj)
+	}
+	if err = meta.SetList(list, items); err != nil {
+		writeError(w, err)
+		return
+	}
+	gvk := schema.FromAPIVersionAndKind(info.kind[:strings.LastIndex(info.kind, "/")], shortKind(info.kind)+"List")
+	list.GetObjectKind().SetGroupVersionKind(gvk)
+	list.SetResourceVersion(strconv.FormatInt(revision, 10))
+	writeJSON(w, http.StatusOK, list)
+}
+
+func (s *server) get(w http.ResponseWriter, info *requestInfo) {
+	obj, err := s.source.Get(info.kind, info.namespace, info.name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, obj)
+}
+
+func (s *server) create(w http.ResponseWriter, r *http.Request, info *requestInfo) {
+	obj, err := decodeBody(r, info)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	created, err := s.source.Create(obj)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, created)
+}
+
+func (s *server) update(w http.ResponseWriter, r *http.Request, info *requestInfo) {
+	obj, err := decodeBody(r, info)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if obj.GetName() != info.name {
+		writeError(w, k8sApiError.NewBadRequest(fmt.Sprintf("the name %s of the object does not match the path", obj.GetName())))
+		return
+	}
+	updated, err := s.source.Update(obj)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, updated)
+}
+
+func (s *server) delete(w http.ResponseWriter, r *http.Request, info *requestInfo) {
+	err := s.source.Delete(info.kind, info.namespace, info.name, r.URL.Query().Get("resourceVersion"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, &metav1.Status{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Status"},
+		Status:   metav1.StatusSuccess,
+		Details: &metav1.StatusDetails{
+			Name:  info.name,
+			Group: info.resource.Group,
+			Kind:  info.resource.Resource,
+		},
+	})
+}
+
+// parsePath parses the paths in the form of /apis/<group>/<version>/namespaces/<namespace>/<resource>[/<name>],
+// or /apis/<group>/<version>/<resource> for the objects in all namespaces.
+func parsePath(path string) (*requestInfo, error) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) < 4 || parts[0] != "apis" {
+		return nil, notFoundPath(path)
+	}
+	group, version := parts[1], parts[2]
+	info := &requestInfo{}
+	var resource string
+	switch {
+	case len(parts) == 4:
+		resource = parts[3]
+	case (len(parts) == 6 || len(parts) == 7) && parts[3] == "namespaces" && parts[4] != "":
+		info.namespace = parts[4]
+		resource = parts[5]
+		if len(parts) == 7 {
+			info.name = parts[6]
+		}
+	default:
+		return nil, notFoundPath(path)
+	}
+	info.resource = schema.GroupResource{Group: group, Resource: resource}
+	kind, exists := kindOfResource(schema.GroupVersionResource{Group: group, Version: version, Resource: resource})
+	if !exists {
+		return nil, notFoundPath(path)
+	}
+	info.kind = kind
+	return info, nil
+}
+
+func notFoundPath(path string) error {
+	return &k8sApiError.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusNotFound,
+		Reason:  metav1.StatusReasonNotFound,
+		Message: "the server could not find the requested resource " + path,
+	}}
+}
+
+// kindOfResource finds the registered kind of the resource, whose name is the lowercase plural of the Kind.
+func kindOfResource(gvr schema.GroupVersionResource) (controller.CRDKind, bool) {
+	for _, kind := range controller.RegisteredKinds() {
+		gv, err := schema.ParseGroupVersion(kind[:strings.LastIndex(kind, "/")])
+		if err != nil || gv.Group != gvr.Group || gv.Version != gvr.Version {
+			continue
+		}
+		plural, _ := meta.UnsafeGuessKindToResource(gv.WithKind(shortKind(kind)))
+		if plural.Resource == gvr.Resource {
+			return kind, true
+		}
+	}
+	return "", false
+}
+
+// groupResourceOf returns the group and resource of the kind in the form of group/version/Kind.
+func groupResourceOf(kind controller.CRDKind) schema.GroupResource {
+	gvk := schema.FromAPIVersionAndKind(kind[:strings.LastIndex(kind, "/")], shortKind(kind))
+	plural, _ := meta.UnsafeGuessKindToResource(gvk)
+	return plural.GroupResource()
+}
+
+// decodeBody decodes the object in the request, which must be of the kind and namespace in the path.
+func decodeBody(r *http.Request, info *requestInfo) (client.Object, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err != nil {
+		return nil, k8sApiError.NewBadRequest(err.Error())
+	}
+	if len(data) > maxBodySize {
+		return nil, k8sApiError.NewRequestEntityTooLargeError(fmt.Sprintf("the manifest exceeds %d bytes", maxBodySize))
+	}
+	obj, err := controller.DecodeObject(data)
+	if err != nil {
+		return nil, k8sApiError.NewBadRequest(err.Error())
+	}
+	if controller.KindOf(obj) != info.kind {
+		return nil, k8sApiError.NewBadRequest(fmt.Sprintf("the kind %s of the object does not match the path", controller.KindOf(obj)))
+	}
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(info.namespace)
+	} else if obj.GetNamespace() != info.namespace {
+		return nil, k8sApiError.NewBadRequest(fmt.Sprintf("the namespace %s of the object does not match the path", obj.GetNamespace()))
+	}
+	return obj, nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	apiStatus, ok := err.(k8sApiError.APIStatus)
+	if !ok {
+		sourceLog.Error(err, "Failed to handle the request of OpenSergo REST API")
+		apiStatus = k8sApiError.NewInternalError(err)
+	}
+	status := apiStatus.Status()
+	status.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Status"}
+	writeJSON(w, int(status.Code), &status)
+}
+
+func writeJSON(w http.ResponseWriter, code int, obj interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(obj); err != nil {
+		sourceLog.Error(err, "Failed to write the response of OpenSergo REST API")
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	testCollectionPath = "/apis/fault-tolerance.opensergo.io/v1alpha1/namespaces/default/ratelimitstrategies"
+	testObjectPath     = testCollectionPath + "/rate-limit-foo"
+)
+
+func testManifest(resourceVersion string, threshold int) string {
+	manifest := `apiVersion: fault-tolerance.opensergo.io/v1alpha1
+kind: RateLimitStrategy
+metadata:
+  name: rate-limit-foo
+  labels:
+    app: foo-app
+`
+	if resourceVersion != "" {
+		manifest += "  resourceVersion: \"" + resourceVersion + "\"\n"
+	}
+	return manifest + fmt.Sprintf("spec:\n  threshold: %d\n  statDurationSeconds: 1\n", threshold)
+}
+
+// serve sends the request to the HTTP API with the token, and returns the status code and the decoded body.
+func serve(t *testing.T, s *Source, method, path, token, body string) (int, map[string]interface{}) {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", token)
+	}
+	recorder := httptest.NewRecorder()
+	s.server.ServeHTTP(recorder, req)
+	var result map[string]interface{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &result); err != nil {
+		t.Fatalf("failed to decode the response %q: %v", recorder.Body.String(), err)
+	}
+	return recorder.Code, result
+}
+
+func resourceVersionOf(t *testing.T, obj map[string]interface{}) string {
+	t.Helper()
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	resourceVersion, _ := metadata["resourceVersion"].(string)
+	if resourceVersion == "" {
+		t.Fatalf("no resourceVersion in %v", obj)
+	}
+	return resourceVersion
+}
+
+func TestServerAuthentication(t *testing.T) {
+	s := newTestSource(t)
+	tests := []struct {
+		name     string
+		token    string
+		wantCode int
+	}{
+		{name: "valid token", token: "Bearer " + testToken, wantCode: http.StatusOK},
+		{name: "case-insensitive scheme", token: "bearer " + testToken, wantCode: http.StatusOK},
+		{name: "no token", token: "", wantCode: http.StatusUnauthorized},
+		{name: "wrong token", token: "Bearer wrong-token", wantCode: http.StatusUnauthorized},
+		{name: "prefix of the token", token: "Bearer " + testToken[:4], wantCode: http.StatusUnauthorized},
+		{name: "empty token", token: "Bearer ", wantCode: http.StatusUnauthorized},
+		{name: "basic auth", token: "Basic " + testToken, wantCode: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, method := range []string{http.MethodGet, http.MethodPost} {
+				code, result := serve(t, s, method, testCollectionPath, tt.token, testManifest("", 1))
+				if tt.wantCode == http.StatusOK {
+					// The authenticated requests pass through to the handlers.
+					if code == http.StatusUnauthorized {
+						t.Errorf("%s: code = %d, %v", method, code, result)
+					}
+				} else if code != tt.wantCode || result["reason"] != string(metav1.StatusReasonUnauthorized) {
+					t.Errorf("%s: code = %d, %v, want %d", method, code, result, tt.wantCode)
+				}
+			}
+		})
+	}
+}
+
+func TestServerResourceVersionConflicts(t *testing.T) {
+	s := newTestSource(t)
+	token := "Bearer " + testToken
+
+	code, created := serve(t, s, http.MethodPost, testCollectionPath, token, testManifest("", 1))
+	if code != http.StatusCreated {
+		t.Fatalf("create: code = %d, %v", code, created)
+	}
+	staleVersion := resourceVersionOf(t, created)
+	code, updated := serve(t, s, http.MethodPut, testObjectPath, token, testManifest(staleVersion, 2))
+	if code != http.StatusOK {
+		t.Fatalf("update: code = %d, %v", code, updated)
+	}
+	latestVersion := resourceVersionOf(t, updated)
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		wantCode int
+	}{
+		{name: "update with a stale resourceVersion", method: http.MethodPut, path: testObjectPath,
+			body: testManifest(staleVersion, 3), wantCode: http.StatusConflict},
+		{name: "delete with a stale resourceVersion", method: http.MethodDelete, path: testObjectPath + "?resourceVersion=" + staleVersion,
+			wantCode: http.StatusConflict},
+		{name: "create an existing object", method: http.MethodPost, path: testCollectionPath,
+			body: testManifest("", 3), wantCode: http.StatusConflict},
+		{name: "update with the latest resourceVersion", method: http.MethodPut, path: testObjectPath,
+			body: testManifest(latestVersion, 3), wantCode: http.StatusOK},
+		{name: "delete with the superseded resourceVersion", method: http.MethodDelete, path: testObjectPath + "?resourceVersion=" + latestVersion,
+			wantCode: http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, result := serve(t, s, tt.method, tt.path, token, tt.body)
+			if code != tt.wantCode {
+				t.Fatalf("code = %d, %v, want %d", code, result, tt.wantCode)
+			}
+			if code == http.StatusConflict && result["kind"] != "Status" {
+				t.Errorf("result = %v, want a Status", result)
+			}
+		})
+	}
+
+	code, obj := serve(t, s, http.MethodGet, testObjectPath, token, "")
+	if code != http.StatusOK {
+		t.Fatalf("get: code = %d, %v", code, obj)
+	}
+	if code, result := serve(t, s, http.MethodDelete, testObjectPath+"?resourceVersion="+resourceVersionOf(t, obj), token, ""); code != http.StatusOK {
+		t.Errorf("delete with the latest resourceVersion: code = %d, %v", code, result)
+	}
+}
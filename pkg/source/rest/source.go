@@ -0,0 +1,364 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rest provides a config source which manages OpenSergo rules through an HTTP API, for the
+// standalone mode without Kubernetes. The objects are persisted in an embedded bbolt database.
+//
+// The API accepts the same JSON or YAML manifests as the CRDs, and follows the paths and semantics of the
+// Kubernetes API, e.g. /apis/fault-tolerance.opensergo.io/v1alpha1/namespaces/default/ratelimitstrategies/foo.
+// The resourceVersion of the objects is used for optimistic concurrency: an update or deletion with
+// a resourceVersion is rejected with 409 Conflict if the object has been changed since then.
+package rest
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	"github.com/opensergo/opensergo-control-plane/pkg/source"
+	"github.com/opensergo/opensergo-control-plane/pkg/source/store"
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/atomic"
+	k8sApiError "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	DefaultPort = 10247
+	// DefaultNamespace is the namespace of the objects created without namespace.
+	DefaultNamespace = "default"
+)
+
+var sourceLog = ctrl.Log.WithName("source").WithName("rest")
+
+// Options represents the options of the REST source.
+type Options struct {
+	// Port is the port of the HTTP API. Defaults to DefaultPort.
+	Port int
+	// DBPath is the path of the bbolt database file, which is created if absent.
+	DBPath string
+	// Tokens consists of the bearer tokens accepted by the HTTP API. At least one token is required.
+	Tokens []string
+	// CertDir is the directory that contains tls.crt and tls.key, which is required unless Insecure is set.
+	CertDir string
+	// Insecure allows to serve the API over plain HTTP if CertDir is empty, where the bearer tokens
+	// are sent in clear text. It is only meant for local development or a trusted network.
+	Insecure bool
+}
+
+// Source is a ConfigSource whose OpenSergo rules are managed through the HTTP API and persisted in bbolt.
+// The objects go through the same validation, caching, defaulting and translation as CRDs,
+// and the revision of the latest write to a (namespace, app, kind) is used as the version of its rules.
+type Source struct {
+	db     *bolt.DB
+	store  *store.Store
+	server *server
+
+	started *atomic.Bool
+
+	// writeMux serializes the writes, so that the changes are applied to the store in the order of revisions.
+	writeMux sync.Mutex
+}
+
+// NewSource opens the database and loads all persisted objects.
+func NewSource(options Options) (*Source, error) {
+	if options.DBPath == "" {
+		return nil, errors.New("empty path of the database")
+	}
+	tokens := make([]string, 0, len(options.Tokens))
+	for _, token := range options.Tokens {
+		if token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, errors.New("no token for the authentication of the HTTP API")
+	}
+	options.Tokens = tokens
+	if options.CertDir == "" {
+		if !options.Insecure {
+			return nil, errors.New("no certificate directory for the HTTPS API, Insecure must be set to serve plain HTTP")
+		}
+		sourceLog.Info("WARNING: the HTTP API is served over plain HTTP, the bearer tokens are sent in clear text")
+	}
+	if options.Port <= 0 {
+		options.Port = DefaultPort
+	}
+
+	db, err := bolt.Open(options.DBPath, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open the database %s", options.DBPath)
+	}
+	if err = db.Update(initBuckets); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	s := &Source{
+		db:      db,
+		store:   store.NewStore(sourceLog),
+		started: atomic.NewBool(false),
+	}
+	s.server = newServer(s, options)
+	if err = s.load(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Source) ComponentName() string {
+	return "OpenSergoRESTConfigSource"
+}
+
+// Run starts the HTTP API in background.
+func (s *Source) Run() error {
+	if !s.started.CAS(false, true) {
+		return nil
+	}
+	if err := s.server.run(); err != nil {
+		s.started.Store(false)
+		return err
+	}
+	return nil
+}
+
+func (s *Source) Close() error {
+	serverErr := s.server.close()
+	if err := s.db.Close(); err != nil {
+		return err
+	}
+	return serverErr
+}
+
+func (s *Source) SetEventHandler(handler source.ConfigEventHandler) {
+	s.store.SetEventHandler(handler)
+}
+
+func (s *Source) Subscribe(target model.SubscribeTarget) (*trpb.DataWithVersion, error) {
+	return s.store.Subscribe(target)
+}
+
+// load loads all persisted objects into the store. The versions of all targets start from the latest
+// revision of the database, so that the versions never go back after a restart even if objects have been deleted.
+func (s *Source) load() error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		revision := currentRevision(tx)
+		return tx.Bucket(objectsBucket).ForEach(func(k, v []byte) error {
+			obj, err := decodeObject(string(k), v)
+			if err != nil {
+				// e.g. the kind is no longer registered
+				sourceLog.Error(err, "Skipped the stored OpenSergo object which cannot be decoded")
+				return nil
+			}
+			s.store.Set(string(k), []client.Object{obj}, revision)
+			return nil
+		})
+	})
+}
+
+// Get returns the object of the kind in the form of group/version/Kind.
+func (s *Source) Get(kind controller.CRDKind, namespace, name string) (client.Object, error) {
+	var obj client.Object
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		obj, err = getObject(tx, objectKey(namespace, kind, name))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, k8sApiError.NewNotFound(groupResourceOf(kind), name)
+	}
+	return obj, nil
+}
+
+// List returns the objects of the kind in the namespace in the order of names, or the objects in all
+// namespaces if the namespace is empty. The latest revision of the database is returned as well.
+func (s *Source) List(kind controller.CRDKind, namespace string) ([]client.Object, int64, error) {
+	var objs []client.Object
+	var revision int64
+	err := s.db.View(func(tx *bolt.Tx) error {
+		revision = currentRevision(tx)
+		return tx.Bucket(objectsBucket).ForEach(func(k, v []byte) error {
+			obj, err := decodeObject(string(k), v)
+			if err != nil {
+				sourceLog.Error(err, "Skipped the stored OpenSergo object which cannot be decoded")
+				return nil
+			}
+			if controller.KindOf(obj) != kind || (namespace != "" && obj.GetNamespace() != namespace) {
+				return nil
+			}
+			objs = append(objs, obj)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	sort.Slice(objs, func(i, j int) bool {
+		if objs[i].GetNamespace() != objs[j].GetNamespace() {
+			return objs[i].GetNamespace() < objs[j].GetNamespace()
+		}
+		return objs[i].GetName() < objs[j].GetName()
+	})
+	return objs, revision, nil
+}
+
+// Create fills in the defaults of a new object, validates it and persists it. The object without namespace is created in DefaultNamespace.
+func (s *Source) Create(obj client.Object) (client.Object, error) {
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(DefaultNamespace)
+	}
+	kind := controller.KindOf(obj)
+	if err := admit(kind, obj); err != nil {
+		return nil, err
+	}
+	key := objectKey(obj.GetNamespace(), kind, obj.GetName())
+	return s.write(key, func(tx *bolt.Tx, prev client.Object, revision int64) (client.Object, error) {
+		if prev != nil {
+			return nil, k8sApiError.NewAlreadyExists(groupResourceOf(kind), obj.GetName())
+		}
+		obj.SetUID(uuid.NewUUID())
+		obj.SetGeneration(1)
+		obj.SetCreationTimestamp(metav1.Now())
+		obj.SetResourceVersion(strconv.FormatInt(revision, 10))
+		return obj, putObject(tx, key, obj)
+	})
+}
+
+// Update fills in the defaults of an object, validates it and replaces the existing object. If the resourceVersion of the object is not empty,
+// it must match the resourceVersion of the existing object.
+func (s *Source) Update(obj client.Object) (client.Object, error) {
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(DefaultNamespace)
+	}
+	kind := controller.KindOf(obj)
+	if err := admit(kind, obj); err != nil {
+		return nil, err
+	}
+	key := objectKey(obj.GetNamespace(), kind, obj.GetName())
+	return s.write(key, func(tx *bolt.Tx, prev client.Object, revision int64) (client.Object, error) {
+		if prev == nil {
+			return nil, k8sApiError.NewNotFound(groupResourceOf(kind), obj.GetName())
+		}
+		if err := checkResourceVersion(kind, prev, obj.GetResourceVersion()); err != nil {
+			return nil, err
+		}
+		obj.SetUID(prev.GetUID())
+		obj.SetGeneration(prev.GetGeneration() + 1)
+		obj.SetCreationTimestamp(prev.GetCreationTimestamp())
+		obj.SetResourceVersion(strconv.FormatInt(revision, 10))
+		return obj, putObject(tx, key, obj)
+	})
+}
+
+// Delete removes an existing object. If the resourceVersion is not empty, it must match the
+// resourceVersion of the existing object.
+func (s *Source) Delete(kind controller.CRDKind, namespace, name, resourceVersion string) error {
+	key := objectKey(namespace, kind, name)
+	_, err := s.write(key, func(tx *bolt.Tx, prev client.Object, _ int64) (client.Object, error) {
+		if prev == nil {
+			return nil, k8sApiError.NewNotFound(groupResourceOf(kind), name)
+		}
+		if err := checkResourceVersion(kind, prev, resourceVersion); err != nil {
+			return nil, err
+		}
+		return nil, tx.Bucket(objectsBucket).Delete([]byte(key))
+	})
+	return err
+}
+
+// write applies the change of the key in a transaction with a new revision, and applies the result
+// to the store once the transaction is committed. A nil result means the key has been deleted.
+func (s *Source) write(key string, apply func(tx *bolt.Tx, prev client.Object, revision int64) (client.Object, error)) (client.Object, error) {
+	s.writeMux.Lock()
+	defer s.writeMux.Unlock()
+
+	var result client.Object
+	var revision int64
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		prev, err := getObject(tx, key)
+		if err != nil {
+			return err
+		}
+		if revision, err = nextRevision(tx); err != nil {
+			return err
+		}
+		result, err = apply(tx, prev, revision)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		s.store.Set(key, nil, revision)
+	} else {
+		s.store.Set(key, []client.Object{result}, revision)
+	}
+	return result, nil
+}
+
+func putObject(tx *bolt.Tx, key string, obj client.Object) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(objectsBucket).Put([]byte(key), data)
+}
+
+// admit fills in the defaults of the object and checks it with the translator of the kind,
+// as the defaulting and validating webhooks do.
+func admit(kind controller.CRDKind, obj client.Object) error {
+	crdMetadata, exists := controller.GetCrdMetadata(kind)
+	if !exists {
+		return k8sApiError.NewBadRequest("CRD not supported: " + kind)
+	}
+	if crdMetadata.Defaulter() != nil {
+		crdMetadata.Defaulter().Default(obj)
+	}
+	var errs field.ErrorList
+	if _, err := controller.TranslateObject(kind, obj); err != nil {
+		var invalidErr *controller.InvalidObjectError
+		if errors.As(err, &invalidErr) {
+			errs = invalidErr.Errs
+		} else {
+			errs = field.ErrorList{field.Invalid(field.NewPath("spec"), nil, err.Error())}
+		}
+	}
+	if len(errs) > 0 {
+		gvk := obj.GetObjectKind().GroupVersionKind()
+		return k8sApiError.NewInvalid(schema.GroupKind{Group: gvk.Group, Kind: gvk.Kind}, obj.GetName(), errs)
+	}
+	return nil
+}
+
+func checkResourceVersion(kind controller.CRDKind, prev client.Object, resourceVersion string) error {
+	if resourceVersion == "" || resourceVersion == prev.GetResourceVersion() {
+		return nil
+	}
+	return k8sApiError.NewConflict(groupResourceOf(kind), prev.GetName(),
+		errors.Errorf("the object has been modified, the latest resourceVersion is %s", prev.GetResourceVersion()))
+}
@@ -0,0 +1,208 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"path/filepath"
+	"testing"
+
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	k8sApiError "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testToken = "test-token"
+
+func newTestSource(t *testing.T) *Source {
+	t.Helper()
+	s, err := NewSource(Options{
+		DBPath:   filepath.Join(t.TempDir(), "opensergo.db"),
+		Tokens:   []string{testToken},
+		Insecure: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = s.Close()
+	})
+	return s
+}
+
+func newTestRateLimitStrategy(name string, threshold int64) *crdv1alpha1.RateLimitStrategy {
+	return &crdv1alpha1.RateLimitStrategy{
+		TypeMeta: metav1.TypeMeta{APIVersion: "fault-tolerance.opensergo.io/v1alpha1", Kind: "RateLimitStrategy"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"app": "foo-app"},
+		},
+		Spec: crdv1alpha1.RateLimitStrategySpec{
+			Threshold:           threshold,
+			StatDurationSeconds: 1,
+		},
+	}
+}
+
+func TestNewSourceOptions(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "opensergo.db")
+	tests := []struct {
+		name    string
+		options Options
+		wantErr bool
+	}{
+		{name: "insecure", options: Options{DBPath: dbPath, Tokens: []string{testToken}, Insecure: true}},
+		{name: "TLS", options: Options{DBPath: dbPath, Tokens: []string{testToken}, CertDir: t.TempDir()}},
+		{name: "neither TLS nor insecure", options: Options{DBPath: dbPath, Tokens: []string{testToken}}, wantErr: true},
+		{name: "no token", options: Options{DBPath: dbPath, Tokens: []string{""}, Insecure: true}, wantErr: true},
+		{name: "no database", options: Options{Tokens: []string{testToken}, Insecure: true}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewSource(tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewSource() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				_ = s.Close()
+			}
+		})
+	}
+}
+
+func TestCreateAndUpdateFillInDefaults(t *testing.T) {
+	s := newTestSource(t)
+
+	created, err := s.Create(newTestRateLimitStrategy("rate-limit-foo", 10))
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	rls := created.(*crdv1alpha1.RateLimitStrategy)
+	if rls.Namespace != DefaultNamespace || rls.Spec.MetricType != crdv1alpha1.RequestAmountMetricType || rls.Spec.LimitMode != crdv1alpha1.LocalLimitMode {
+		t.Errorf("created object = %+v, want the defaults filled in", rls)
+	}
+
+	obj := newTestRateLimitStrategy("rate-limit-foo", 20)
+	obj.Spec.ControlBehavior = crdv1alpha1.WarmUpControlBehavior
+	obj.Spec.WarmUp = &crdv1alpha1.RateLimitWarmUp{WarmUpPeriod: "60s"}
+	updated, err := s.Update(obj)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	rls = updated.(*crdv1alpha1.RateLimitStrategy)
+	if rls.Spec.MetricType != crdv1alpha1.RequestAmountMetricType || rls.Spec.WarmUp.ColdFactor != crdv1alpha1.DefaultColdFactor ||
+		rls.Spec.WarmUp.WarmUpPeriod != "1min" || rls.Generation != 2 {
+		t.Errorf("updated object = %+v, want the defaults filled in", rls)
+	}
+
+	stored, err := s.Get(controller.RateLimitStrategyKind, DefaultNamespace, "rate-limit-foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := stored.(*crdv1alpha1.RateLimitStrategy).Spec; got.Threshold != 20 || got.LimitMode != crdv1alpha1.LocalLimitMode {
+		t.Errorf("stored spec = %+v", got)
+	}
+}
+
+func TestWriteErrors(t *testing.T) {
+	s := newTestSource(t)
+	created, err := s.Create(newTestRateLimitStrategy("rate-limit-foo", 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	staleVersion := created.GetResourceVersion()
+	latest, err := s.Update(newTestRateLimitStrategy("rate-limit-foo", 20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	latestVersion := latest.GetResourceVersion()
+
+	withResourceVersion := func(version string) *crdv1alpha1.RateLimitStrategy {
+		obj := newTestRateLimitStrategy("rate-limit-foo", 30)
+		obj.ResourceVersion = version
+		return obj
+	}
+	invalid := newTestRateLimitStrategy("rate-limit-bar", 10)
+	invalid.Spec.MetricType = "Unknown"
+
+	tests := []struct {
+		name  string
+		write func() error
+		check func(error) bool
+	}{
+		{
+			name: "create an existing object",
+			write: func() error {
+				_, err := s.Create(newTestRateLimitStrategy("rate-limit-foo", 10))
+				return err
+			},
+			check: k8sApiError.IsAlreadyExists,
+		},
+		{
+			name: "create an invalid object",
+			write: func() error {
+				_, err := s.Create(invalid)
+				return err
+			},
+			check: k8sApiError.IsInvalid,
+		},
+		{
+			name: "update an absent object",
+			write: func() error {
+				_, err := s.Update(newTestRateLimitStrategy("rate-limit-absent", 10))
+				return err
+			},
+			check: k8sApiError.IsNotFound,
+		},
+		{
+			name: "update with a stale resourceVersion",
+			write: func() error {
+				_, err := s.Update(withResourceVersion(staleVersion))
+				return err
+			},
+			check: k8sApiError.IsConflict,
+		},
+		{
+			name: "delete with a stale resourceVersion",
+			write: func() error {
+				return s.Delete(controller.RateLimitStrategyKind, DefaultNamespace, "rate-limit-foo", staleVersion)
+			},
+			check: k8sApiError.IsConflict,
+		},
+		{
+			name: "delete an absent object",
+			write: func() error {
+				return s.Delete(controller.RateLimitStrategyKind, DefaultNamespace, "rate-limit-absent", "")
+			},
+			check: k8sApiError.IsNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.write(); !tt.check(err) {
+				t.Errorf("error = %v", err)
+			}
+		})
+	}
+
+	// The writes with the latest resourceVersion succeed.
+	updated, err := s.Update(withResourceVersion(latestVersion))
+	if err != nil {
+		t.Fatalf("Update() with the latest resourceVersion error = %v", err)
+	}
+	if err = s.Delete(controller.RateLimitStrategyKind, DefaultNamespace, "rate-limit-foo", updated.GetResourceVersion()); err != nil {
+		t.Fatalf("Delete() with the latest resourceVersion error = %v", err)
+	}
+}
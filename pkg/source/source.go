@@ -0,0 +1,146 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package source defines the sources of OpenSergo rules, which the control plane pushes to the connected instances.
+package source
+
+import (
+	"sync"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+)
+
+// ConfigEventType represents the type of the change of the rules of a (namespace, app, kind).
+type ConfigEventType int
+
+const (
+	// ConfigEventAdd means the target has rules for the first time.
+	ConfigEventAdd ConfigEventType = iota
+	// ConfigEventUpdate means the rules of the target have changed.
+	ConfigEventUpdate
+	// ConfigEventDelete means all rules of the target have been removed.
+	ConfigEventDelete
+)
+
+func (t ConfigEventType) String() string {
+	switch t {
+	case ConfigEventAdd:
+		return "Add"
+	case ConfigEventUpdate:
+		return "Update"
+	case ConfigEventDelete:
+		return "Delete"
+	default:
+		return "Undefined"
+	}
+}
+
+// ConfigEvent represents a change of the rules of a (namespace, app, kind).
+type ConfigEvent struct {
+	Type   ConfigEventType
+	Target model.SubscribeTarget
+	// DataWithVersion carries all rules of the target after the change.
+	// For ConfigEventDelete, it carries no rules but the new version.
+	DataWithVersion *trpb.DataWithVersion
+}
+
+// ConfigEventHandler handles the config events emitted by a ConfigSource.
+type ConfigEventHandler func(event ConfigEvent)
+
+// ConfigSource is the source of OpenSergo rules, e.g. the CRDs in Kubernetes.
+type ConfigSource interface {
+	ComponentName() string
+	// Run starts the source in background.
+	Run() error
+	Close() error
+
+	// SetEventHandler sets the handler of the config events, which should be called before Run.
+	SetEventHandler(handler ConfigEventHandler)
+	// Subscribe starts watching the rules of the target and returns the current rules of the target.
+	// The later changes of the rules are emitted as config events.
+	Subscribe(target model.SubscribeTarget) (*trpb.DataWithVersion, error)
+}
+
+// DeliveryStatusAware is implemented by the sources which reflect the delivery status of rules,
+// e.g. in the status of CRDs.
+type DeliveryStatusAware interface {
+	SetDeliveryStatusProvider(provider model.DeliveryStatusProvider)
+	// UpdateStatus is called when the delivery status of the rules of the given (namespace, app, kind) changes.
+	UpdateStatus(namespace, app, kind string)
+}
+
+// EventEmitter emits the config events of sources. The type of each event is decided by
+// whether the target had rules before, so sources only need to provide the latest rules.
+type EventEmitter struct {
+	handler ConfigEventHandler
+	// present consists of the targets which have rules.
+	present map[model.SubscribeTarget]bool
+
+	mux sync.RWMutex
+}
+
+func NewEventEmitter() *EventEmitter {
+	return &EventEmitter{present: make(map[model.SubscribeTarget]bool)}
+}
+
+func (e *EventEmitter) SetHandler(handler ConfigEventHandler) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	e.handler = handler
+}
+
+// Observe records whether the target has rules without emitting any event,
+// e.g. when the rules are returned to a new subscriber directly.
+func (e *EventEmitter) Observe(target model.SubscribeTarget, data *trpb.DataWithVersion) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	e.observe(target, data)
+}
+
+func (e *EventEmitter) observe(target model.SubscribeTarget, data *trpb.DataWithVersion) (existed bool) {
+	existed = e.present[target]
+	if len(data.GetData()) > 0 {
+		e.present[target] = true
+	} else {
+		delete(e.present, target)
+	}
+	return existed
+}
+
+// Emit emits the event of the latest rules of the target.
+// Nothing is emitted if the target has never had rules.
+func (e *EventEmitter) Emit(target model.SubscribeTarget, data *trpb.DataWithVersion) {
+	e.mux.Lock()
+	existed := e.observe(target, data)
+	handler := e.handler
+	e.mux.Unlock()
+
+	hasRules := len(data.GetData()) > 0
+	if handler == nil || (!existed && !hasRules) {
+		return
+	}
+	event := ConfigEvent{Target: target, DataWithVersion: data}
+	switch {
+	case !existed:
+		event.Type = ConfigEventAdd
+	case hasRules:
+		event.Type = ConfigEventUpdate
+	default:
+		event.Type = ConfigEventDelete
+	}
+	handler(event)
+}
@@ -0,0 +1,115 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"testing"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var testTarget = model.SubscribeTarget{Namespace: "default", AppName: "foo-app", Kind: "fault-tolerance.opensergo.io/v1alpha1/TimeoutStrategy"}
+
+func dataOf(version int64, rules int) *trpb.DataWithVersion {
+	data := &trpb.DataWithVersion{Version: version}
+	for i := 0; i < rules; i++ {
+		data.Data = append(data.Data, &anypb.Any{})
+	}
+	return data
+}
+
+func TestEventEmitter(t *testing.T) {
+	noEvent := ConfigEventType(-1)
+	tests := []struct {
+		name string
+		// observed is the number of the rules observed before the emission, or negative for none.
+		observed int
+		rules    int
+		want     ConfigEventType
+	}{
+		{name: "first rules", observed: -1, rules: 1, want: ConfigEventAdd},
+		{name: "no rules ever", observed: -1, rules: 0, want: noEvent},
+		{name: "observed without rules", observed: 0, rules: 2, want: ConfigEventAdd},
+		{name: "observed with rules", observed: 1, rules: 2, want: ConfigEventUpdate},
+		{name: "all rules removed", observed: 2, rules: 0, want: ConfigEventDelete},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []ConfigEvent
+			e := NewEventEmitter()
+			e.SetHandler(func(event ConfigEvent) {
+				events = append(events, event)
+			})
+			if tt.observed >= 0 {
+				e.Observe(testTarget, dataOf(1, tt.observed))
+			}
+			data := dataOf(2, tt.rules)
+			e.Emit(testTarget, data)
+
+			if tt.want == noEvent {
+				if len(events) != 0 {
+					t.Errorf("events = %v, want none", events)
+				}
+				return
+			}
+			if len(events) != 1 {
+				t.Fatalf("events = %v, want one %s event", events, tt.want)
+			}
+			if events[0].Type != tt.want || events[0].Target != testTarget || events[0].DataWithVersion != data {
+				t.Errorf("event = %+v, want the %s event of the emitted data", events[0], tt.want)
+			}
+		})
+	}
+}
+
+func TestEventEmitterSequence(t *testing.T) {
+	var got []ConfigEventType
+	e := NewEventEmitter()
+	// The events emitted before the handler is set are dropped, but the state of the target is recorded.
+	e.Emit(testTarget, dataOf(1, 1))
+	e.SetHandler(func(event ConfigEvent) {
+		got = append(got, event.Type)
+	})
+	e.Emit(testTarget, dataOf(2, 2))
+	e.Emit(testTarget, dataOf(3, 0))
+	e.Emit(testTarget, dataOf(4, 0))
+	e.Emit(testTarget, dataOf(5, 1))
+
+	want := []ConfigEventType{ConfigEventUpdate, ConfigEventDelete, ConfigEventAdd}
+	if len(got) != len(want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("events = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestConfigEventTypeString(t *testing.T) {
+	for eventType, want := range map[ConfigEventType]string{
+		ConfigEventAdd:       "Add",
+		ConfigEventUpdate:    "Update",
+		ConfigEventDelete:    "Delete",
+		ConfigEventType(100): "Undefined",
+	} {
+		if got := eventType.String(); got != want {
+			t.Errorf("ConfigEventType(%d).String() = %q, want %q", eventType, got, want)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package store provides the pipeline shared by the config sources which read OpenSergo objects
+// outside Kubernetes: the objects are cached and translated in the same way as CRDs, and the changes
+// of the subscribed targets are emitted as config events.
+package store
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	"github.com/opensergo/opensergo-control-plane/pkg/source"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Store caches the OpenSergo objects of a source, which are grouped by the key of the source
+// (e.g. the file or the etcd key which the objects are read from).
+type Store struct {
+	logger  logr.Logger
+	emitter *source.EventEmitter
+
+	// objects represents a map: source key -> objects read from the key
+	objects map[string][]client.Object
+	caches  map[controller.CRDKind]*controller.CRDCache
+	// versions consists of the versions of the targets given by the source, which take precedence
+	// over the versions of the caches.
+	versions map[model.SubscribeTarget]int64
+	// revisions consists of the revisions of the sources where the latest changes of the targets come from.
+	revisions  map[model.SubscribeTarget]string
+	subscribed map[model.SubscribeTarget]bool
+
+	mux sync.RWMutex
+}
+
+func NewStore(logger logr.Logger) *Store {
+	return &Store{
+		logger:     logger,
+		emitter:    source.NewEventEmitter(),
+		objects:    make(map[string][]client.Object),
+		caches:     make(map[controller.CRDKind]*controller.CRDCache),
+		versions:   make(map[model.SubscribeTarget]int64),
+		revisions:  make(map[model.SubscribeTarget]string),
+		subscribed: make(map[model.SubscribeTarget]bool),
+	}
+}
+
+func (s *Store) SetEventHandler(handler source.ConfigEventHandler) {
+	s.emitter.SetHandler(handler)
+}
+
+// Subscribe marks the target as subscribed and returns the current rules of the target.
+func (s *Store) Subscribe(target model.SubscribeTarget) (*trpb.DataWithVersion, error) {
+	if _, supported := controller.GetCrdMetadata(target.Kind); !supported {
+		return nil, errors.New("CRD not supported: " + target.Kind)
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.subscribed[target] = true
+	data := s.dataWithVersion(target)
+	s.emitter.Observe(target, data)
+	return data, nil
+}
+
+// Set replaces the objects read from the key, and emits the changes of the affected targets.
+// Empty objects mean the key has been removed. If the version is positive, it becomes the version
+// of the affected targets, otherwise the versions of the targets are maintained by the store.
+func (s *Store) Set(key string, objs []client.Object, version int64) {
+	s.SetWithRevision(key, objs, version, "")
+}
+
+// SetWithRevision is the same as Set, and the non-empty revision is attached to the data of the affected
+// targets, e.g. the git commit where the changes come from.
+func (s *Store) SetWithRevision(key string, objs []client.Object, version int64, revision string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	affected := make(map[model.SubscribeTarget]bool)
+	for _, obj := range s.objects[key] {
+		kind := controller.KindOf(obj)
+		n := model.NamespacedApp{Namespace: obj.GetNamespace(), App: obj.GetLabels()["app"]}
+		cache := s.cacheOf(kind)
+		cache.DeleteByNamespaceApp(n, obj.GetName())
+		cache.DeleteByNamespacedName(types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()})
+		affected[model.SubscribeTarget{Namespace: n.Namespace, AppName: n.App, Kind: kind}] = true
+	}
+	for _, obj := range objs {
+		kind := controller.KindOf(obj)
+		n := model.NamespacedApp{Namespace: obj.GetNamespace(), App: obj.GetLabels()["app"]}
+		cache := s.cacheOf(kind)
+		cache.SetByNamespaceApp(n, obj)
+		cache.SetByNamespacedName(types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, obj)
+		affected[model.SubscribeTarget{Namespace: n.Namespace, AppName: n.App, Kind: kind}] = true
+	}
+	if len(objs) > 0 {
+		s.objects[key] = objs
+	} else {
+		delete(s.objects, key)
+	}
+
+	for target := range affected {
+		if version > 0 {
+			s.versions[target] = version
+		}
+		if revision != "" {
+			s.revisions[target] = revision
+		}
+		// The events are emitted with the lock held, so that the events of the same target are in order.
+		if s.subscribed[target] {
+			s.emitter.Emit(target, s.dataWithVersion(target))
+		}
+	}
+}
+
+func (s *Store) cacheOf(kind controller.CRDKind) *controller.CRDCache {
+	cache, exists := s.caches[kind]
+	if !exists {
+		cache = controller.NewCRDCache(kind)
+		s.caches[kind] = cache
+	}
+	return cache
+}
+
+// dataWithVersion translates the cached objects of the target in the order of names. The lock must be held.
+func (s *Store) dataWithVersion(target model.SubscribeTarget) *trpb.DataWithVersion {
+	objs, version := s.cacheOf(target.Kind).GetByNamespaceApp(target.NamespacedApp())
+	if v, exists := s.versions[target]; exists {
+		version = v
+	}
+	sorted := make([]client.Object, len(objs))
+	copy(sorted, objs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].GetName() < sorted[j].GetName()
+	})
+
+	data := &trpb.DataWithVersion{Version: version, Revision: s.revisions[target]}
+	for _, obj := range sorted {
+		rule, err := controller.TranslateObject(target.Kind, obj)
+		if err != nil {
+			s.logger.Error(err, "Excluded the OpenSergo rule which is invalid or cannot be translated", "kind", target.Kind,
+				"namespace", obj.GetNamespace(), "name", obj.GetName())
+			continue
+		}
+		if rule == nil {
+			continue
+		}
+		data.Data = append(data.Data, rule)
+		data.Provenances = append(data.Provenances, &trpb.RuleProvenance{
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+		})
+	}
+	return data
+}
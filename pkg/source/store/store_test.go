@@ -0,0 +1,178 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-logr/logr"
+	crdv1alpha1 "github.com/opensergo/opensergo-control-plane/pkg/api/v1alpha1"
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+	"github.com/opensergo/opensergo-control-plane/pkg/source"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var cbTarget = model.SubscribeTarget{Namespace: "default", AppName: "foo-app", Kind: controller.CircuitBreakerStrategyKind}
+
+func circuitBreaker(name string, minRequestAmount int32) *crdv1alpha1.CircuitBreakerStrategy {
+	return &crdv1alpha1.CircuitBreakerStrategy{
+		TypeMeta: metav1.TypeMeta{APIVersion: "fault-tolerance.opensergo.io/v1alpha1", Kind: "CircuitBreakerStrategy"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cbTarget.Namespace,
+			Name:      name,
+			Labels:    map[string]string{"app": cbTarget.AppName},
+		},
+		Spec: crdv1alpha1.CircuitBreakerStrategySpec{
+			Strategy:         "ErrorRequestCount",
+			TriggerCount:     5,
+			StatDuration:     "1s",
+			RecoveryTimeout:  "5s",
+			MinRequestAmount: minRequestAmount,
+		},
+	}
+}
+
+func namesOf(data *trpb.DataWithVersion) []string {
+	names := make([]string, 0, len(data.GetProvenances()))
+	for _, provenance := range data.GetProvenances() {
+		names = append(names, provenance.Name)
+	}
+	return names
+}
+
+// cbStep sets the objects of the key, and expects the event of the rules of the given names,
+// or no event if the names are nil.
+type cbStep struct {
+	key       string
+	objs      []client.Object
+	version   int64
+	wantNames []string
+}
+
+func TestStore(t *testing.T) {
+	untranslatable := circuitBreaker("cbs-x", 10)
+	untranslatable.Spec.StatDuration = "1x"
+
+	tests := []struct {
+		name  string
+		steps []cbStep
+	}{
+		{
+			name: "versions maintained by the store",
+			steps: []cbStep{
+				{key: "a.yaml", objs: []client.Object{circuitBreaker("cbs-a", 10)}, wantNames: []string{"cbs-a"}},
+				{key: "b.yaml", objs: []client.Object{circuitBreaker("cbs-b", 10)}, wantNames: []string{"cbs-a", "cbs-b"}},
+				{key: "a.yaml", objs: []client.Object{circuitBreaker("cbs-a", 20)}, wantNames: []string{"cbs-a", "cbs-b"}},
+				{key: "b.yaml", wantNames: []string{"cbs-a"}},
+			},
+		},
+		{
+			name: "versions given by the source",
+			steps: []cbStep{
+				{key: "a", objs: []client.Object{circuitBreaker("cbs-a", 10)}, version: 10, wantNames: []string{"cbs-a"}},
+				{key: "b", objs: []client.Object{circuitBreaker("cbs-b", 10)}, version: 12, wantNames: []string{"cbs-a", "cbs-b"}},
+				{key: "a", version: 15, wantNames: []string{"cbs-b"}},
+			},
+		},
+		{
+			name: "deletion pushes empty data",
+			steps: []cbStep{
+				{key: "a.yaml", objs: []client.Object{circuitBreaker("cbs-a", 10), circuitBreaker("cbs-b", 10)}, wantNames: []string{"cbs-a", "cbs-b"}},
+				{key: "a.yaml", wantNames: []string{}},
+			},
+		},
+		{
+			name: "invalid and untranslatable objects excluded",
+			steps: []cbStep{
+				{key: "a.yaml", objs: []client.Object{circuitBreaker("cbs-b", 0), untranslatable}},
+				{key: "b.yaml", objs: []client.Object{circuitBreaker("cbs-a", 10)}, wantNames: []string{"cbs-a"}},
+				{key: "a.yaml", objs: []client.Object{circuitBreaker("cbs-b", 10), untranslatable}, wantNames: []string{"cbs-a", "cbs-b"}},
+			},
+		},
+		{
+			name: "sorted by name",
+			steps: []cbStep{
+				{key: "a.yaml", objs: []client.Object{circuitBreaker("cbs-c", 10), circuitBreaker("cbs-a", 10)}, wantNames: []string{"cbs-a", "cbs-c"}},
+				{key: "b.yaml", objs: []client.Object{circuitBreaker("cbs-b", 10)}, wantNames: []string{"cbs-a", "cbs-b", "cbs-c"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStore(logr.Discard())
+			var events []source.ConfigEvent
+			s.SetEventHandler(func(event source.ConfigEvent) {
+				events = append(events, event)
+			})
+			data, err := s.Subscribe(cbTarget)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(data.GetData()) != 0 {
+				t.Fatalf("initial rules = %v, want none", namesOf(data))
+			}
+			lastVersion := data.Version
+			for i, step := range tt.steps {
+				events = nil
+				s.Set(step.key, step.objs, step.version)
+				if step.wantNames == nil {
+					if len(events) != 0 {
+						t.Errorf("step %d: events = %v, want none", i, events)
+					}
+					continue
+				}
+				if len(events) != 1 || events[0].Target != cbTarget {
+					t.Fatalf("step %d: events = %v, want one event of the target", i, events)
+				}
+				event := events[0]
+				if got := namesOf(event.DataWithVersion); !reflect.DeepEqual(got, step.wantNames) ||
+					len(event.DataWithVersion.GetData()) != len(step.wantNames) {
+					t.Errorf("step %d: rules = %v, want %v", i, got, step.wantNames)
+				}
+				if len(step.wantNames) == 0 && event.Type != source.ConfigEventDelete {
+					t.Errorf("step %d: event type = %s, want Delete", i, event.Type)
+				}
+				if step.version > 0 && event.DataWithVersion.Version != step.version {
+					t.Errorf("step %d: version = %d, want %d", i, event.DataWithVersion.Version, step.version)
+				}
+				if event.DataWithVersion.Version <= lastVersion {
+					t.Errorf("step %d: version = %d, want greater than %d", i, event.DataWithVersion.Version, lastVersion)
+				}
+				lastVersion = event.DataWithVersion.Version
+			}
+
+			// A new subscriber gets the same rules as the last event.
+			data, err = s.Subscribe(cbTarget)
+			if err != nil {
+				t.Fatal(err)
+			}
+			last := tt.steps[len(tt.steps)-1].wantNames
+			if got := namesOf(data); !reflect.DeepEqual(got, last) || data.Version != lastVersion {
+				t.Errorf("rules = %v at version %d, want %v at version %d", got, data.Version, last, lastVersion)
+			}
+		})
+	}
+}
+
+func TestStoreSubscribeUnsupportedKind(t *testing.T) {
+	s := NewStore(logr.Discard())
+	if _, err := s.Subscribe(model.SubscribeTarget{Namespace: "default", AppName: "foo-app", Kind: "example.com/v1/Foo"}); err == nil {
+		t.Error("Subscribe() of an unsupported kind succeeds")
+	}
+}
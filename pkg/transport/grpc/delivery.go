@@ -0,0 +1,194 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"sync"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/model"
+	trpb "github.com/opensergo/opensergo-control-plane/pkg/proto/transport/v1"
+)
+
+type deliveryKey struct {
+	namespace string
+	app       string
+	kind      string
+}
+
+type deliveryState struct {
+	version int64
+	acked   bool
+	nacked  bool
+}
+
+// DeliveryTracker tracks the rules pushed to each client and the ACK/NACK responses of clients.
+type DeliveryTracker struct {
+	// states represents a map: (namespace, app, kind) -> (client identifier -> delivery state)
+	states map[deliveryKey]map[model.ClientIdentifier]*deliveryState
+	// versions represents the version of the latest push for each (namespace, app, kind)
+	versions map[deliveryKey]int64
+	// lastNackMessages represents the message of the last NACK for each (namespace, app, kind)
+	lastNackMessages map[deliveryKey]string
+
+	changedHandler model.DeliveryChangedHandler
+
+	updateMux sync.RWMutex
+}
+
+func NewDeliveryTracker() *DeliveryTracker {
+	return &DeliveryTracker{
+		states:           make(map[deliveryKey]map[model.ClientIdentifier]*deliveryState),
+		versions:         make(map[deliveryKey]int64),
+		lastNackMessages: make(map[deliveryKey]string),
+	}
+}
+
+// SetChangedHandler sets the handler which is called when the delivery status changes.
+func (t *DeliveryTracker) SetChangedHandler(handler model.DeliveryChangedHandler) {
+	t.updateMux.Lock()
+	defer t.updateMux.Unlock()
+
+	t.changedHandler = handler
+}
+
+// RecordPush records the rules of the given version have been pushed to the client.
+func (t *DeliveryTracker) RecordPush(namespace, app, kind string, identifier model.ClientIdentifier, version int64) {
+	key := deliveryKey{namespace: namespace, app: app, kind: kind}
+
+	t.updateMux.Lock()
+	clients := t.states[key]
+	if clients == nil {
+		clients = make(map[model.ClientIdentifier]*deliveryState)
+		t.states[key] = clients
+	}
+	state := clients[identifier]
+	if state == nil || state.version != version {
+		clients[identifier] = &deliveryState{version: version}
+	}
+	if t.versions[key] != version {
+		t.versions[key] = version
+		delete(t.lastNackMessages, key)
+	}
+	handler := t.changedHandler
+	t.updateMux.Unlock()
+
+	t.notify(handler, key)
+}
+
+// RecordResponse records the ACK/NACK response of the client. If the target of the response is absent,
+// the response applies to all pending pushes of the client.
+func (t *DeliveryTracker) RecordResponse(identifier model.ClientIdentifier, response *trpb.SubscribeRequest) {
+	acked := response.GetResponseAck() == ACKFlag
+	message := response.GetStatus().GetMessage()
+	target := response.GetTarget()
+
+	t.updateMux.Lock()
+	var changed []deliveryKey
+	for key, clients := range t.states {
+		state := clients[identifier]
+		if state == nil {
+			continue
+		}
+		if target != nil && !matchesTarget(key, target) {
+			continue
+		}
+		if target == nil && (state.acked || state.nacked) {
+			continue
+		}
+		state.acked = acked
+		state.nacked = !acked
+		if !acked && state.version == t.versions[key] {
+			t.lastNackMessages[key] = message
+		}
+		changed = append(changed, key)
+	}
+	handler := t.changedHandler
+	t.updateMux.Unlock()
+
+	t.notify(handler, changed...)
+}
+
+// RemoveClient removes all delivery states of the client, e.g. when the client disconnects.
+func (t *DeliveryTracker) RemoveClient(identifier model.ClientIdentifier) {
+	t.updateMux.Lock()
+	var changed []deliveryKey
+	for key, clients := range t.states {
+		if _, exists := clients[identifier]; !exists {
+			continue
+		}
+		delete(clients, identifier)
+		if len(clients) == 0 {
+			delete(t.states, key)
+			delete(t.versions, key)
+			delete(t.lastNackMessages, key)
+		}
+		changed = append(changed, key)
+	}
+	handler := t.changedHandler
+	t.updateMux.Unlock()
+
+	t.notify(handler, changed...)
+}
+
+// Status returns the delivery status of the rules of the given (namespace, app, kind).
+// Only the clients which have received the latest version are counted as ACKed or NACKed.
+func (t *DeliveryTracker) Status(namespace, app, kind string) model.DeliveryStatus {
+	key := deliveryKey{namespace: namespace, app: app, kind: kind}
+
+	t.updateMux.RLock()
+	defer t.updateMux.RUnlock()
+
+	clients := t.states[key]
+	status := model.DeliveryStatus{
+		ConnectedInstances: int32(len(clients)),
+		Version:            t.versions[key],
+		LastNackMessage:    t.lastNackMessages[key],
+	}
+	for _, state := range clients {
+		if state.version != status.Version {
+			continue
+		}
+		if state.acked {
+			status.AckedInstances++
+		} else if state.nacked {
+			status.NackedInstances++
+		}
+	}
+	return status
+}
+
+func (t *DeliveryTracker) notify(handler model.DeliveryChangedHandler, keys ...deliveryKey) {
+	if handler == nil {
+		return
+	}
+	for _, key := range keys {
+		handler(key.namespace, key.app, key.kind)
+	}
+}
+
+func matchesTarget(key deliveryKey, target *trpb.SubscribeRequestTarget) bool {
+	if key.namespace != target.GetNamespace() || key.app != target.GetApp() {
+		return false
+	}
+	if len(target.GetKinds()) == 0 {
+		return true
+	}
+	for _, kind := range target.GetKinds() {
+		if kind == key.kind {
+			return true
+		}
+	}
+	return false
+}
@@ -37,6 +37,7 @@ type Server struct {
 	grpcServer      *grpc.Server
 
 	connectionManager *ConnectionManager
+	deliveryTracker   *DeliveryTracker
 
 	port    uint32
 	started *atomic.Bool
@@ -44,12 +45,14 @@ type Server struct {
 
 func NewServer(port uint32, subscribeHandlers []model.SubscribeRequestHandler) *Server {
 	connectionManager := NewConnectionManager()
+	deliveryTracker := NewDeliveryTracker()
 	return &Server{
-		transportServer:   newTransportServer(connectionManager, subscribeHandlers),
+		transportServer:   newTransportServer(connectionManager, deliveryTracker, subscribeHandlers),
 		port:              port,
 		grpcServer:        grpc.NewServer(),
 		started:           atomic.NewBool(false),
 		connectionManager: connectionManager,
+		deliveryTracker:   deliveryTracker,
 	}
 }
 
@@ -57,6 +60,11 @@ func (s *Server) ConnectionManager() *ConnectionManager {
 	return s.connectionManager
 }
 
+// DeliveryTracker returns the tracker of the rules pushed to clients and their ACK/NACK responses.
+func (s *Server) DeliveryTracker() *DeliveryTracker {
+	return s.deliveryTracker
+}
+
 func (s *Server) ComponentName() string {
 	return "OpenSergoUniversalTransportServer"
 }
@@ -82,6 +90,7 @@ type TransportServer struct {
 	trpb.OpenSergoUniversalTransportServiceServer
 
 	connectionManager *ConnectionManager
+	deliveryTracker   *DeliveryTracker
 
 	subscribeHandlers []model.SubscribeRequestHandler
 }
@@ -103,19 +112,23 @@ func (s *TransportServer) SubscribeConfig(stream trpb.OpenSergoUniversalTranspor
 		if err == io.EOF {
 			// Stream EOF
 			_ = s.connectionManager.RemoveByIdentifier(clientIdentifier)
+			s.deliveryTracker.RemoveClient(clientIdentifier)
 			return nil
 		}
 		if err != nil {
 			// remove stream
 			_ = s.connectionManager.RemoveByIdentifier(clientIdentifier)
+			s.deliveryTracker.RemoveClient(clientIdentifier)
 			return err
 		}
 
 		if recvData.ResponseAck == ACKFlag {
 			// This indicates the received data is a response of push-success.
+			s.deliveryTracker.RecordResponse(clientIdentifier, recvData)
 			continue
 		} else if recvData.ResponseAck == NACKFlag {
 			// This indicates the received data is a response of push-failure.
+			s.deliveryTracker.RecordResponse(clientIdentifier, recvData)
 			if recvData.Status.Code == CheckFormatError {
 				// TODO: handle here (cannot retry)
 				log.Println("Client response CheckFormatError")
@@ -155,9 +168,10 @@ func (s *TransportServer) SubscribeConfig(stream trpb.OpenSergoUniversalTranspor
 	}
 }
 
-func newTransportServer(connectionManager *ConnectionManager, subscribeHandlers []model.SubscribeRequestHandler) *TransportServer {
+func newTransportServer(connectionManager *ConnectionManager, deliveryTracker *DeliveryTracker, subscribeHandlers []model.SubscribeRequestHandler) *TransportServer {
 	return &TransportServer{
 		connectionManager: connectionManager,
+		deliveryTracker:   deliveryTracker,
 		subscribeHandlers: subscribeHandlers,
 	}
 }
@@ -0,0 +1,68 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// DefaultingHandler fills in the defaults of OpenSergo CRD objects and normalizes their fields
+// with the defaulters of the registered kinds, so that the stored objects match what clients receive.
+type DefaultingHandler struct {
+	decoder *admission.Decoder
+}
+
+// NewDefaultingHandler creates a defaulting handler which decodes objects with the given scheme.
+func NewDefaultingHandler(scheme *runtime.Scheme) (*DefaultingHandler, error) {
+	decoder, err := admission.NewDecoder(scheme)
+	if err != nil {
+		return nil, err
+	}
+	return &DefaultingHandler{decoder: decoder}, nil
+}
+
+// InjectDecoder injects the decoder, which implements admission.DecoderInjector.
+func (h *DefaultingHandler) InjectDecoder(decoder *admission.Decoder) error {
+	h.decoder = decoder
+	return nil
+}
+
+func (h *DefaultingHandler) Handle(_ context.Context, req admission.Request) admission.Response {
+	if req.Operation == admissionv1.Delete {
+		return admission.Allowed("")
+	}
+	crdMetadata, exists := controller.GetCrdMetadata(kindOf(req))
+	if !exists || crdMetadata.Defaulter() == nil {
+		return admission.Allowed("no defaulter of the kind")
+	}
+	obj := crdMetadata.Generator()()
+	if err := h.decoder.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	crdMetadata.Defaulter().Default(obj)
+	marshaled, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
@@ -0,0 +1,103 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+
+	"github.com/alibaba/sentinel-golang/util"
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	"go.uber.org/atomic"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+const (
+	// ValidatingPath is the path of the validating webhook of all OpenSergo CRDs.
+	ValidatingPath = "/validate-opensergo-io"
+	// DefaultingPath is the path of the defaulting (mutating) webhook of all OpenSergo CRDs.
+	DefaultingPath = "/mutate-opensergo-io"
+
+	DefaultPort = 9443
+)
+
+var serverLog = ctrl.Log.WithName("webhook")
+
+// ServerOptions represents the options of the admission webhook server.
+type ServerOptions struct {
+	// Port is the port of the webhook server. Defaults to DefaultPort.
+	Port int
+	// CertDir is the directory that contains tls.crt and tls.key of the webhook server.
+	CertDir string
+}
+
+// Server represents the admission webhook server of OpenSergo CRDs.
+type Server struct {
+	server *webhook.Server
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+	started   *atomic.Bool
+}
+
+func NewServer(options ServerOptions) (*Server, error) {
+	if options.Port <= 0 {
+		options.Port = DefaultPort
+	}
+	server := &webhook.Server{
+		Port:    options.Port,
+		CertDir: options.CertDir,
+	}
+	validatingHandler, err := NewValidatingHandler(controller.Scheme())
+	if err != nil {
+		return nil, err
+	}
+	server.Register(ValidatingPath, &webhook.Admission{Handler: validatingHandler})
+	defaultingHandler, err := NewDefaultingHandler(controller.Scheme())
+	if err != nil {
+		return nil, err
+	}
+	server.Register(DefaultingPath, &webhook.Admission{Handler: defaultingHandler})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Server{
+		server:    server,
+		ctx:       ctx,
+		ctxCancel: cancel,
+		started:   atomic.NewBool(false),
+	}, nil
+}
+
+func (s *Server) ComponentName() string {
+	return "OpenSergoAdmissionWebhookServer"
+}
+
+// Run starts the webhook server in background.
+func (s *Server) Run() error {
+	if s.started.CAS(false, true) {
+		go util.RunWithRecover(func() {
+			serverLog.Info("Starting OpenSergo admission webhook server", "port", s.server.Port)
+			if err := s.server.StartStandalone(s.ctx, controller.Scheme()); err != nil {
+				serverLog.Error(err, "problem running OpenSergo admission webhook server")
+			}
+		})
+	}
+	return nil
+}
+
+func (s *Server) Close() error {
+	s.ctxCancel()
+	return nil
+}
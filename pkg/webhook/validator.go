@@ -0,0 +1,91 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	admissionv1 "k8s.io/api/admission/v1"
+	k8sApiError "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// ValidatingHandler validates OpenSergo CRD objects with the translators of the registered kinds,
+// and rejects invalid objects with field-level messages.
+type ValidatingHandler struct {
+	decoder *admission.Decoder
+}
+
+// NewValidatingHandler creates a validating handler which decodes objects with the given scheme.
+func NewValidatingHandler(scheme *runtime.Scheme) (*ValidatingHandler, error) {
+	decoder, err := admission.NewDecoder(scheme)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatingHandler{decoder: decoder}, nil
+}
+
+// InjectDecoder injects the decoder, which implements admission.DecoderInjector.
+func (h *ValidatingHandler) InjectDecoder(decoder *admission.Decoder) error {
+	h.decoder = decoder
+	return nil
+}
+
+func (h *ValidatingHandler) Handle(_ context.Context, req admission.Request) admission.Response {
+	if req.Operation == admissionv1.Delete {
+		return admission.Allowed("")
+	}
+	crdMetadata, exists := controller.GetCrdMetadata(kindOf(req))
+	if !exists {
+		return admission.Allowed("not an OpenSergo CRD")
+	}
+	obj := crdMetadata.Generator()()
+	if err := h.decoder.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	translator := crdMetadata.Translator()
+	errs := translator.Validate(obj)
+	if len(errs) == 0 {
+		if _, err := translator.Translate(obj); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("spec"), nil, err.Error()))
+		}
+	}
+	if len(errs) > 0 {
+		return invalidResponse(req, errs)
+	}
+	return admission.Allowed("")
+}
+
+// kindOf returns the kind of the object in the request in the form of group/version/Kind.
+func kindOf(req admission.Request) controller.CRDKind {
+	return req.Kind.Group + "/" + req.Kind.Version + "/" + req.Kind.Kind
+}
+
+// invalidResponse denies the request with the field-level errors as the causes of the status.
+func invalidResponse(req admission.Request, errs field.ErrorList) admission.Response {
+	statusErr := k8sApiError.NewInvalid(schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}, req.Name, errs)
+	return admission.Response{
+		AdmissionResponse: admissionv1.AdmissionResponse{
+			Allowed: false,
+			Result:  &statusErr.ErrStatus,
+		},
+	}
+}
@@ -0,0 +1,539 @@
+// Copyright 2022, OpenSergo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/opensergo/opensergo-control-plane/pkg/controller"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/yaml"
+)
+
+// newTestRequest builds the admission request of creating an object of the kind with the given spec.
+func newTestRequest(t *testing.T, kind controller.CRDKind, spec string) admission.Request {
+	t.Helper()
+	parts := strings.SplitN(kind, "/", 3)
+	gvk := metav1.GroupVersionKind{Group: parts[0], Version: parts[1], Kind: parts[2]}
+	obj := map[string]interface{}{
+		"apiVersion": gvk.Group + "/" + gvk.Version,
+		"kind":       gvk.Kind,
+		"metadata": map[string]interface{}{
+			"namespace": "default",
+			"name":      "test",
+			"labels":    map[string]string{"app": "foo-app"},
+		},
+		"spec": json.RawMessage(spec),
+	}
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Kind:      gvk,
+			Namespace: "default",
+			Name:      "test",
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	}
+}
+
+func newTestValidatingHandler(t *testing.T) *ValidatingHandler {
+	t.Helper()
+	h, err := NewValidatingHandler(controller.Scheme())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func newTestDefaultingHandler(t *testing.T) *DefaultingHandler {
+	t.Helper()
+	h, err := NewDefaultingHandler(controller.Scheme())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestValidatingHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		kind       controller.CRDKind
+		spec       string
+		allowed    bool
+		causeField string
+	}{
+		{
+			name:    "valid FaultToleranceRule",
+			kind:    controller.FaultToleranceRuleKind,
+			spec:    `{"targets":[{"targetResourceName":"/foo","sources":[{"app":{"exact":"bar"}}]}],"strategies":[{"name":"rls","kind":"RateLimitStrategy"}],"action":{"name":"fallback","kind":"FallbackAction"}}`,
+			allowed: true,
+		},
+		{
+			name:       "FaultToleranceRule with a source without matchers",
+			kind:       controller.FaultToleranceRuleKind,
+			spec:       `{"targets":[{"targetResourceName":"/foo","sources":[{}]}],"strategies":[{"name":"rls","kind":"RateLimitStrategy"}]}`,
+			causeField: "spec.targets[0].sources[0]",
+		},
+		{
+			name:       "FaultToleranceRule referring to an action as a strategy",
+			kind:       controller.FaultToleranceRuleKind,
+			spec:       `{"targets":[{"targetResourceName":"/foo"}],"strategies":[{"name":"fallback","kind":"FallbackAction"}]}`,
+			causeField: "spec.strategies[0].kind",
+		},
+		{
+			name:    "valid RateLimitStrategy",
+			kind:    controller.RateLimitStrategyKind,
+			spec:    `{"metricType":"RequestAmount","limitMode":"Local","threshold":10,"statDurationSeconds":1,"controlBehavior":"WarmUp","warmUp":{"warmUpPeriod":"10s","coldFactor":3}}`,
+			allowed: true,
+		},
+		{
+			name:       "RateLimitStrategy with a negative threshold",
+			kind:       controller.RateLimitStrategyKind,
+			spec:       `{"metricType":"RequestAmount","limitMode":"Local","threshold":-1,"statDurationSeconds":1}`,
+			causeField: "spec.threshold",
+		},
+		{
+			name:       "queueing RateLimitStrategy without maxQueueingTime",
+			kind:       controller.RateLimitStrategyKind,
+			spec:       `{"metricType":"RequestAmount","limitMode":"Local","threshold":10,"statDurationSeconds":1,"controlBehavior":"Queueing"}`,
+			causeField: "spec.maxQueueingTime",
+		},
+		{
+			name:    "valid ThrottlingStrategy",
+			kind:    controller.ThrottlingStrategyKind,
+			spec:    `{"minIntervalOfRequests":"100ms","queueTimeout":"1s"}`,
+			allowed: true,
+		},
+		{
+			name:       "ThrottlingStrategy whose interval exceeds the queue timeout",
+			kind:       controller.ThrottlingStrategyKind,
+			spec:       `{"minIntervalOfRequests":"2s","queueTimeout":"1s"}`,
+			causeField: "spec.minIntervalOfRequests",
+		},
+		{
+			name:    "valid ConcurrencyLimitStrategy",
+			kind:    controller.ConcurrencyLimitStrategyKind,
+			spec:    `{"maxConcurrency":8,"limitMode":"Local"}`,
+			allowed: true,
+		},
+		{
+			name:       "adaptive ConcurrencyLimitStrategy with a static maxConcurrency",
+			kind:       controller.ConcurrencyLimitStrategyKind,
+			spec:       `{"maxConcurrency":8,"limitMode":"Local","adaptive":{"algorithm":"Gradient","minConcurrency":1,"maxConcurrency":16,"smoothing":"0.2","probeInterval":"1s"}}`,
+			causeField: "spec.maxConcurrency",
+		},
+		{
+			name:    "valid CircuitBreakerStrategy",
+			kind:    controller.CircuitBreakerStrategyKind,
+			spec:    `{"strategy":"SlowRequestRatio","triggerRatio":"60%","statDuration":"30s","recoveryTimeout":"5s","minRequestAmount":5,"slowConditions":{"maxAllowedRt":"500ms"}}`,
+			allowed: true,
+		},
+		{
+			name:       "CircuitBreakerStrategy without minRequestAmount",
+			kind:       controller.CircuitBreakerStrategyKind,
+			spec:       `{"strategy":"SlowRequestRatio","triggerRatio":"60%","statDuration":"30s","recoveryTimeout":"5s","slowConditions":{"maxAllowedRt":"500ms"}}`,
+			causeField: "spec.minRequestAmount",
+		},
+		{
+			name:    "valid ParamFlowStrategy",
+			kind:    controller.ParamFlowStrategyKind,
+			spec:    `{"paramSource":"Header","paramKey":"X-User","limitMode":"Local","threshold":10,"statDurationSeconds":1,"exceptions":[{"value":"vip","threshold":100}]}`,
+			allowed: true,
+		},
+		{
+			name:       "ParamFlowStrategy without paramKey",
+			kind:       controller.ParamFlowStrategyKind,
+			spec:       `{"paramSource":"Header","limitMode":"Local","threshold":10,"statDurationSeconds":1}`,
+			causeField: "spec.paramKey",
+		},
+		{
+			name:    "valid SystemAdaptiveStrategy",
+			kind:    controller.SystemAdaptiveStrategyKind,
+			spec:    `{"maxCpuUsage":"80%","maxAvgRt":"200ms"}`,
+			allowed: true,
+		},
+		{
+			name:       "SystemAdaptiveStrategy without thresholds",
+			kind:       controller.SystemAdaptiveStrategyKind,
+			spec:       `{}`,
+			causeField: "spec",
+		},
+		{
+			name:    "valid RetryStrategy",
+			kind:    controller.RetryStrategyKind,
+			spec:    `{"maxAttempts":3,"perTryTimeout":"1s","backoff":{"baseInterval":"100ms","maxInterval":"1s","multiplier":"2"},"budget":{"ratio":"20%"}}`,
+			allowed: true,
+		},
+		{
+			name:       "RetryStrategy whose maxInterval is less than baseInterval",
+			kind:       controller.RetryStrategyKind,
+			spec:       `{"maxAttempts":3,"backoff":{"baseInterval":"1s","maxInterval":"100ms"}}`,
+			causeField: "spec.backoff.maxInterval",
+		},
+		{
+			name:    "valid TimeoutStrategy",
+			kind:    controller.TimeoutStrategyKind,
+			spec:    `{"timeout":"3s"}`,
+			allowed: true,
+		},
+		{
+			name:       "TimeoutStrategy with a zero timeout",
+			kind:       controller.TimeoutStrategyKind,
+			spec:       `{"timeout":"0s"}`,
+			causeField: "spec.timeout",
+		},
+		{
+			name:    "valid FallbackAction",
+			kind:    controller.FallbackActionKind,
+			spec:    `{"httpResponse":{"statusCode":429,"body":"too many requests"}}`,
+			allowed: true,
+		},
+		{
+			name:       "FallbackAction with two responses",
+			kind:       controller.FallbackActionKind,
+			spec:       `{"httpResponse":{"statusCode":429},"grpcResponse":{"code":"UNAVAILABLE"}}`,
+			causeField: "spec",
+		},
+		{
+			name:    "valid TrafficRouter",
+			kind:    controller.TrafficRouterKind,
+			spec:    `{"hosts":["foo-app"],"http":[{"route":[{"destination":{"host":"foo-app","subset":"v1"},"weight":80},{"destination":{"host":"foo-app","subset":"v2"},"weight":20}]}]}`,
+			allowed: true,
+		},
+		{
+			name:       "TrafficRouter whose weights do not sum to 100",
+			kind:       controller.TrafficRouterKind,
+			spec:       `{"hosts":["foo-app"],"http":[{"route":[{"destination":{"host":"foo-app","subset":"v1"},"weight":80},{"destination":{"host":"foo-app","subset":"v2"},"weight":10}]}]}`,
+			causeField: "spec.http[0].route",
+		},
+	}
+	h := newTestValidatingHandler(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := h.Handle(context.Background(), newTestRequest(t, tt.kind, tt.spec))
+			if resp.Allowed != tt.allowed {
+				t.Fatalf("allowed = %v, want %v, result: %+v", resp.Allowed, tt.allowed, resp.Result)
+			}
+			if tt.allowed {
+				return
+			}
+			if resp.Result == nil || resp.Result.Reason != metav1.StatusReasonInvalid || resp.Result.Details == nil {
+				t.Fatalf("result = %+v, want an Invalid status with details", resp.Result)
+			}
+			var fields []string
+			for _, cause := range resp.Result.Details.Causes {
+				fields = append(fields, cause.Field)
+				if cause.Field == tt.causeField {
+					return
+				}
+			}
+			t.Errorf("causes = %v, want a cause of %s", fields, tt.causeField)
+		})
+	}
+}
+
+func TestValidatingHandlerIgnoredRequests(t *testing.T) {
+	h := newTestValidatingHandler(t)
+
+	deletion := newTestRequest(t, controller.RateLimitStrategyKind, `{"threshold":-1}`)
+	deletion.Operation = admissionv1.Delete
+	if resp := h.Handle(context.Background(), deletion); !resp.Allowed {
+		t.Errorf("deletion is denied: %+v", resp.Result)
+	}
+
+	unknown := newTestRequest(t, "example.com/v1/Foo", `{}`)
+	if resp := h.Handle(context.Background(), unknown); !resp.Allowed {
+		t.Errorf("object of an unknown kind is denied: %+v", resp.Result)
+	}
+
+	malformed := newTestRequest(t, controller.RateLimitStrategyKind, `{"threshold":"ten"}`)
+	if resp := h.Handle(context.Background(), malformed); resp.Allowed || resp.Result.Code != 400 {
+		t.Errorf("malformed object: allowed = %v, result = %+v, want a bad request", resp.Allowed, resp.Result)
+	}
+}
+
+func TestDefaultingHandler(t *testing.T) {
+	tests := []struct {
+		name string
+		kind controller.CRDKind
+		spec string
+		// patches are the expected patch operations of the spec, in the form of path -> value.
+		patches map[string]interface{}
+	}{
+		{
+			name: "RateLimitStrategy",
+			kind: controller.RateLimitStrategyKind,
+			spec: `{"threshold":10,"statDurationSeconds":1,"controlBehavior":"WarmUp","warmUp":{"warmUpPeriod":"60s"}}`,
+			patches: map[string]interface{}{
+				"/spec/metricType":          "RequestAmount",
+				"/spec/limitMode":           "Local",
+				"/spec/warmUp/coldFactor":   float64(3),
+				"/spec/warmUp/warmUpPeriod": "1min",
+			},
+		},
+		{
+			name: "RateLimitStrategy with defaults",
+			kind: controller.RateLimitStrategyKind,
+			spec: `{"metricType":"RequestAmount","limitMode":"Global","threshold":10,"statDurationSeconds":1}`,
+		},
+		{
+			name: "ThrottlingStrategy",
+			kind: controller.ThrottlingStrategyKind,
+			spec: `{"minIntervalOfRequests":"1000ms","queueTimeout":"120s"}`,
+			patches: map[string]interface{}{
+				"/spec/minIntervalOfRequests": "1s",
+				"/spec/queueTimeout":          "2min",
+			},
+		},
+		{
+			name: "ConcurrencyLimitStrategy",
+			kind: controller.ConcurrencyLimitStrategyKind,
+			spec: `{"adaptive":{"minConcurrency":1,"maxConcurrency":16,"smoothing":"0.2","probeInterval":"3600s"}}`,
+			patches: map[string]interface{}{
+				"/spec/limitMode":              "Local",
+				"/spec/adaptive/algorithm":     "Gradient",
+				"/spec/adaptive/probeInterval": "1h",
+			},
+		},
+		{
+			name: "CircuitBreakerStrategy",
+			kind: controller.CircuitBreakerStrategyKind,
+			spec: `{"strategy":"ErrorRequestRatio","triggerRatio":"0.5","statDuration":"30000ms","recoveryTimeout":"5s","minRequestAmount":5,"slowConditions":{"maxAllowedRt":"1000ms"},"errorConditions":{"grpcCodes":["unavailable"]}}`,
+			patches: map[string]interface{}{
+				"/spec/triggerRatio":                "50%",
+				"/spec/statDuration":                "30s",
+				"/spec/slowConditions/maxAllowedRt": "1s",
+				"/spec/errorConditions/grpcCodes/0": "UNAVAILABLE",
+			},
+		},
+		{
+			name: "ParamFlowStrategy",
+			kind: controller.ParamFlowStrategyKind,
+			spec: `{"paramSource":"Header","paramKey":"X-User","threshold":10,"statDurationSeconds":1}`,
+			patches: map[string]interface{}{
+				"/spec/limitMode": "Local",
+			},
+		},
+		{
+			name: "SystemAdaptiveStrategy",
+			kind: controller.SystemAdaptiveStrategyKind,
+			spec: `{"maxCpuUsage":"0.07","maxAvgRt":"1000ms"}`,
+			patches: map[string]interface{}{
+				"/spec/maxCpuUsage": "7%",
+				"/spec/maxAvgRt":    "1s",
+			},
+		},
+		{
+			name: "RetryStrategy",
+			kind: controller.RetryStrategyKind,
+			spec: `{"maxAttempts":3,"perTryTimeout":"60000ms","backoff":{"baseInterval":"1000ms"},"retryOn":{"grpcCodes":["aborted"]},"budget":{"ratio":"0.2"}}`,
+			patches: map[string]interface{}{
+				"/spec/perTryTimeout":        "1min",
+				"/spec/backoff/baseInterval": "1s",
+				"/spec/retryOn/grpcCodes/0":  "ABORTED",
+				"/spec/budget/ratio":         "20%",
+			},
+		},
+		{
+			name: "TimeoutStrategy",
+			kind: controller.TimeoutStrategyKind,
+			spec: `{"timeout":"86400s"}`,
+			patches: map[string]interface{}{
+				"/spec/timeout": "1d",
+			},
+		},
+		{
+			name: "FallbackAction",
+			kind: controller.FallbackActionKind,
+			spec: `{"grpcResponse":{"code":"resource_exhausted"}}`,
+			patches: map[string]interface{}{
+				"/spec/grpcResponse/code": "RESOURCE_EXHAUSTED",
+			},
+		},
+		{
+			name: "FaultToleranceRule without a defaulter",
+			kind: controller.FaultToleranceRuleKind,
+			spec: `{"targets":[{"targetResourceName":"/foo"}],"strategies":[{"name":"rls","kind":"RateLimitStrategy"}]}`,
+		},
+		{
+			name: "TrafficRouter without a defaulter",
+			kind: controller.TrafficRouterKind,
+			spec: `{"hosts":["foo-app"]}`,
+		},
+	}
+	h := newTestDefaultingHandler(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := h.Handle(context.Background(), newTestRequest(t, tt.kind, tt.spec))
+			if !resp.Allowed {
+				t.Fatalf("request is denied: %+v", resp.Result)
+			}
+			patches := make(map[string]interface{})
+			for _, patch := range resp.Patches {
+				// The other patches come from the zero values of metadata and status.
+				if !strings.HasPrefix(patch.Path, "/spec") {
+					continue
+				}
+				if patch.Operation != "add" && patch.Operation != "replace" {
+					t.Errorf("unexpected patch %s %s", patch.Operation, patch.Path)
+				}
+				patches[patch.Path] = patch.Value
+			}
+			if len(patches) == 0 && len(tt.patches) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(patches, tt.patches) {
+				t.Errorf("patches = %v, want %v", patches, tt.patches)
+			}
+		})
+	}
+}
+
+func TestDefaultingHandlerIgnoresDeletion(t *testing.T) {
+	h := newTestDefaultingHandler(t)
+	req := newTestRequest(t, controller.RateLimitStrategyKind, `{}`)
+	req.Operation = admissionv1.Delete
+	resp := h.Handle(context.Background(), req)
+	if !resp.Allowed || len(resp.Patches) != 0 {
+		t.Errorf("deletion: allowed = %v, patches = %v, want allowed without patches", resp.Allowed, resp.Patches)
+	}
+}
+
+// schemaPattern returns the pattern of the property in the spec schema of the CRD manifest.
+func schemaPattern(t *testing.T, crdFile string, properties ...string) *regexp.Regexp {
+	t.Helper()
+	data, err := ioutil.ReadFile(filepath.Join("..", "..", "k8s", "crd", "bases", crdFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var crd struct {
+		Spec struct {
+			Versions []struct {
+				Schema struct {
+					OpenAPIV3Schema map[string]interface{} `json:"openAPIV3Schema"`
+				} `json:"schema"`
+			} `json:"versions"`
+		} `json:"spec"`
+	}
+	if err := yaml.Unmarshal(data, &crd); err != nil || len(crd.Spec.Versions) == 0 {
+		t.Fatalf("failed to parse %s: %v", crdFile, err)
+	}
+	schema := crd.Spec.Versions[0].Schema.OpenAPIV3Schema
+	for _, property := range append([]string{"spec"}, properties...) {
+		props, _ := schema["properties"].(map[string]interface{})
+		if schema, _ = props[property].(map[string]interface{}); schema == nil {
+			t.Fatalf("no property %s in the schema of %s", property, crdFile)
+		}
+	}
+	pattern, _ := schema["pattern"].(string)
+	if pattern == "" {
+		t.Fatalf("no pattern of %v in the schema of %s", properties, crdFile)
+	}
+	return regexp.MustCompile(pattern)
+}
+
+// The ratios are normalized by the defaulting webhook before the CRD schema is checked,
+// so every ratio accepted by the validating webhook must match the pattern of the schema after defaulting.
+func TestDefaultedRatiosMatchSchema(t *testing.T) {
+	fields := []struct {
+		kind       controller.CRDKind
+		crdFile    string
+		properties []string
+		spec       func(ratio string) string
+	}{
+		{
+			kind:       controller.CircuitBreakerStrategyKind,
+			crdFile:    "fault-tolerance.opensergo.io_circuitbreakerstrategies.yaml",
+			properties: []string{"triggerRatio"},
+			spec: func(ratio string) string {
+				return `{"strategy":"ErrorRequestRatio","triggerRatio":"` + ratio + `","statDuration":"30s","recoveryTimeout":"5s","minRequestAmount":5}`
+			},
+		},
+		{
+			kind:       controller.RetryStrategyKind,
+			crdFile:    "fault-tolerance.opensergo.io_retrystrategies.yaml",
+			properties: []string{"budget", "ratio"},
+			spec: func(ratio string) string {
+				return `{"maxAttempts":3,"budget":{"ratio":"` + ratio + `"}}`
+			},
+		},
+		{
+			kind:       controller.SystemAdaptiveStrategyKind,
+			crdFile:    "fault-tolerance.opensergo.io_systemadaptivestrategies.yaml",
+			properties: []string{"maxCpuUsage"},
+			spec: func(ratio string) string {
+				return `{"maxCpuUsage":"` + ratio + `"}`
+			},
+		},
+	}
+	ratios := []struct {
+		value   string
+		allowed bool
+	}{
+		{value: "0", allowed: true},
+		{value: "0.07", allowed: true},
+		{value: "0.5", allowed: true},
+		{value: "1", allowed: true},
+		{value: "55%", allowed: true},
+		{value: "55.0%", allowed: true},
+		{value: "100%", allowed: true},
+		{value: "0.555"},
+		{value: "55.5%"},
+		{value: "0.001"},
+	}
+	defaulting, validating := newTestDefaultingHandler(t), newTestValidatingHandler(t)
+	for _, f := range fields {
+		pattern := schemaPattern(t, f.crdFile, f.properties...)
+		path := "/spec/" + strings.Join(f.properties, "/")
+		for _, ratio := range ratios {
+			t.Run(f.kind+"/"+ratio.value, func(t *testing.T) {
+				req := newTestRequest(t, f.kind, f.spec(ratio.value))
+				resp := validating.Handle(context.Background(), req)
+				if resp.Allowed != ratio.allowed {
+					t.Fatalf("allowed = %v, want %v, result: %+v", resp.Allowed, ratio.allowed, resp.Result)
+				}
+				if !ratio.allowed {
+					if resp.Result == nil || !strings.Contains(resp.Result.Message, "whole percentage") {
+						t.Errorf("result = %+v, want the message of whole percentages", resp.Result)
+					}
+					return
+				}
+				defaulted := ratio.value
+				for _, patch := range defaulting.Handle(context.Background(), req).Patches {
+					if patch.Path == path {
+						defaulted, _ = patch.Value.(string)
+					}
+				}
+				if !pattern.MatchString(defaulted) {
+					t.Errorf("the defaulted ratio %q does not match the schema pattern %s", defaulted, pattern)
+				}
+			})
+		}
+	}
+}